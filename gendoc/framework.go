@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cloud "github.com/terraform-providers/terraform-provider-ksyun/ksyun"
+)
+
+// frameworkSchemas returns the data sources and resources of the framework provider,
+// they're converted into schema.Resource so genDoc walks them like the ones of the sdk provider.
+func frameworkSchemas(provider *schema.Provider) (dataSources, resources map[string]*schema.Resource) {
+	server := providerserver.NewProtocol5(cloud.NewFrameworkProvider(provider))()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		message("[FAIL!]get framework provider schema failed: %s", err)
+		os.Exit(1)
+	}
+	for _, d := range resp.Diagnostics {
+		message("[FAIL!]get framework provider schema failed: %s: %s", d.Summary, d.Detail)
+		os.Exit(1)
+	}
+
+	dataSources = make(map[string]*schema.Resource)
+	for name := range cloud.FrameworkDataSources() {
+		dataSources[name] = frameworkResource(resp.DataSourceSchemas[name].Block)
+	}
+	resources = make(map[string]*schema.Resource)
+	for name := range cloud.FrameworkResources() {
+		resources[name] = frameworkResource(resp.ResourceSchemas[name].Block)
+	}
+	return dataSources, resources
+}
+
+// frameworkResource converts the protocol schema block, only the fields read by genDoc are set.
+func frameworkResource(block *tfprotov5.SchemaBlock) *schema.Resource {
+	r := &schema.Resource{Schema: make(map[string]*schema.Schema)}
+	if block.Deprecated {
+		r.DeprecationMessage = "deprecated"
+	}
+	for _, a := range block.Attributes {
+		s := &schema.Schema{
+			Type:        frameworkValueType(a.Type),
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+		}
+		if a.Deprecated {
+			s.Deprecated = "deprecated"
+		}
+		r.Schema[a.Name] = s
+	}
+	for _, b := range block.BlockTypes {
+		s := &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    b.MinItems == 0,
+			Required:    b.MinItems > 0,
+			Description: b.Block.Description,
+			Elem:        frameworkResource(b.Block),
+		}
+		if b.Nesting == tfprotov5.SchemaNestedBlockNestingModeSet {
+			s.Type = schema.TypeSet
+		}
+		r.Schema[b.TypeName] = s
+	}
+	return r
+}
+
+func frameworkValueType(t tftypes.Type) schema.ValueType {
+	switch {
+	case t.Is(tftypes.List{}):
+		return schema.TypeList
+	case t.Is(tftypes.Set{}):
+		return schema.TypeSet
+	case t.Is(tftypes.Map{}):
+		return schema.TypeMap
+	case t.Is(tftypes.Bool):
+		return schema.TypeBool
+	case t.Is(tftypes.Number):
+		return schema.TypeFloat
+	default:
+		return schema.TypeString
+	}
+}
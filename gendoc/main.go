@@ -15,7 +15,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/hashicorp/hcl/v2/hclwrite"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cloud "github.com/terraform-providers/terraform-provider-ksyun/ksyun"
 )
 
@@ -34,7 +34,15 @@ var (
 )
 
 func main() {
-	provider := cloud.Provider().(*schema.Provider)
+	provider := cloud.Provider()
+	// the data sources and resources written on the plugin framework are served next to the sdk ones
+	dataSources, resources := frameworkSchemas(provider)
+	for k, v := range provider.DataSourcesMap {
+		dataSources[k] = v
+	}
+	for k, v := range provider.ResourcesMap {
+		resources[k] = v
+	}
 	vProvider := runtime.FuncForPC(reflect.ValueOf(cloud.Provider).Pointer())
 
 	filename, _ := vProvider.FileLine(0)
@@ -47,12 +55,12 @@ func main() {
 	for _, product := range products {
 		// document for DataSources
 		for _, dataSource := range product.DataSources {
-			genDoc(product.Name, "data_source", filePath, dataSource, provider.DataSourcesMap[dataSource])
+			genDoc(product.Name, "data_source", filePath, dataSource, dataSources[dataSource])
 		}
 
 		// document for Resources
 		for _, resource := range product.Resources {
-			genDoc(product.Name, "resource", filePath, resource, provider.ResourcesMap[resource])
+			genDoc(product.Name, "resource", filePath, resource, resources[resource])
 		}
 	}
 	message("num of docs: %d", totalDone)
@@ -1,6 +1,12 @@
 package ksyun
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
 	"github.com/KscSDK/ksc-sdk-go/service/bws"
 	"github.com/KscSDK/ksc-sdk-go/service/cen"
 	"github.com/KscSDK/ksc-sdk-go/service/clickhouse"
@@ -20,6 +26,7 @@ import (
 	"github.com/KscSDK/ksc-sdk-go/service/krds"
 	"github.com/KscSDK/ksc-sdk-go/service/mongodb"
 	"github.com/KscSDK/ksc-sdk-go/service/monitor"
+	"github.com/KscSDK/ksc-sdk-go/service/monitorv2"
 	"github.com/KscSDK/ksc-sdk-go/service/monitorv4"
 	"github.com/KscSDK/ksc-sdk-go/service/pdns"
 	"github.com/KscSDK/ksc-sdk-go/service/rabbitmq"
@@ -29,9 +36,15 @@ import (
 	"github.com/KscSDK/ksc-sdk-go/service/tag"
 	"github.com/KscSDK/ksc-sdk-go/service/tagv2"
 	"github.com/KscSDK/ksc-sdk-go/service/vpc"
+	awsclient "github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	klog "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/klog/v20200731"
 	kmr "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/kmr/v20210902" // 别名导入kmr SDK
+	"github.com/kingsoftcloud/sdk-go/v2/ksyun/common"
+	ksyunhttp "github.com/kingsoftcloud/sdk-go/v2/ksyun/common/http"
 	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
 type KsyunClient struct {
@@ -64,12 +77,19 @@ type KsyunClient struct {
 	kpfsconn       *kpfs.Kpfs             `json:"kpfsconn,omitempty"`
 	monitorconn    *monitor.Monitor       `json:"monitorconn,omitempty"`
 	monitorv4conn  *monitorv4.Monitorv4   `json:"monitor_4_conn,omitempty"`
+	monitorv2conn  *monitorv2.Monitorv2   `json:"monitor_2_conn,omitempty"`
 	cenconn        *cen.Cen               `json:"cenconn,omitempty"`
 	clickhouseconn *clickhouse.Clickhouse `json:"clickhouseconn,omitempty"`
 	kmrconn        *kmr.Client            `json:"kmrconn,omitempty"`
 	klogconn       *klog.Client           `json:"klogconn,omitempty"`
 
-	config *Config
+	config      *Config
+	credentials *credentials.Credentials
+	// transport is the http transport of the sdk clients, with the proxy and keep-alive settings of the provider
+	transport http.RoundTripper
+	// regionClients are the clients of the other regions created by WithRegion
+	regionClients map[string]*KsyunClient
+	regionMutex   sync.Mutex
 }
 
 func (client *KsyunClient) GetVpcClient() *vpc.Vpc {
@@ -87,3 +107,63 @@ func (client *KsyunClient) GetEipClient() *eip.Eip {
 func (client *KsyunClient) GetIamClient() *iam.Iam {
 	return client.iamconn
 }
+
+// WithRegion returns a client of the region with the same configuration,
+// it's used by the resources that operate across the regions, such as ksyun_image_copy.
+// The client is created once for each region.
+func (client *KsyunClient) WithRegion(region string) (*KsyunClient, error) {
+	if region == "" || region == client.region {
+		return client, nil
+	}
+	client.regionMutex.Lock()
+	defer client.regionMutex.Unlock()
+	if regionClient, ok := client.regionClients[region]; ok {
+		return regionClient, nil
+	}
+	config := *client.config
+	config.Region = region
+	regionClient, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+	if client.regionClients == nil {
+		client.regionClients = make(map[string]*KsyunClient)
+	}
+	client.regionClients[region] = regionClient
+	return regionClient, nil
+}
+
+// sendActionRequest sends the action that isn't wrapped by the ksc sdk of the service yet by the client of the service,
+// the method is the http method that the sdk uses for the actions of the service.
+// The options are applied before sending, such as setting the content type of the body.
+func sendActionRequest(conn *awsclient.Client, method, action string, req map[string]interface{}, options ...request.Option) (resp *map[string]interface{}, err error) {
+	op := &request.Operation{
+		Name:       action,
+		HTTPMethod: method,
+		HTTPPath:   "/",
+	}
+	resp = &map[string]interface{}{}
+	r := conn.NewRequest(op, &req, resp)
+	r.ApplyOptions(options...)
+	logger.Debug(logger.ReqFormat, action, req)
+	err = r.Send()
+	return resp, err
+}
+
+// sendSdkRequest sends the request by the client of the kingsoftcloud sdk in the same way as the sdk,
+// it's used by the apis which aren't in the sdk yet.
+func sendSdkRequest(cli *common.Client, request ksyunhttp.Request, response ksyunhttp.Response) error {
+	request.SetContext(context.Background())
+	request.SetContentType("application/json")
+	statusCode, msg, err := cli.SendV2(request, response)
+	if err != nil {
+		return fmt.Errorf("[KsyunSDKError] [HttpCode:0 Err:%s] Request failed", err)
+	}
+	if statusCode < 200 || statusCode > 299 {
+		return fmt.Errorf("[KsyunSDKError] [HttpCode:%d Err:Request failed] %s", statusCode, msg)
+	}
+	if err = json.Unmarshal([]byte(msg), response); err != nil {
+		return fmt.Errorf("[KsyunSDKError] [HttpCode:%d Err:%s] %s", statusCode, err.Error(), msg)
+	}
+	return nil
+}
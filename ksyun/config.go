@@ -1,11 +1,14 @@
 package ksyun
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	klog "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/klog/v20200731"
 	kmr "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/kmr/v20210902"
 	"github.com/kingsoftcloud/sdk-go/v2/ksyun/common"
 	"github.com/kingsoftcloud/sdk-go/v2/ksyun/common/profile"
+	"log"
 	"net"
 	"net/http"
 	"net/url"
@@ -33,18 +36,22 @@ import (
 	"github.com/KscSDK/ksc-sdk-go/service/krds"
 	"github.com/KscSDK/ksc-sdk-go/service/mongodb"
 	"github.com/KscSDK/ksc-sdk-go/service/monitor"
+	"github.com/KscSDK/ksc-sdk-go/service/monitorv2"
 	"github.com/KscSDK/ksc-sdk-go/service/monitorv4"
 	"github.com/KscSDK/ksc-sdk-go/service/pdns"
 	"github.com/KscSDK/ksc-sdk-go/service/rabbitmq"
 	"github.com/KscSDK/ksc-sdk-go/service/sks"
 	"github.com/KscSDK/ksc-sdk-go/service/slb"
 	"github.com/KscSDK/ksc-sdk-go/service/sqlserver"
+	"github.com/KscSDK/ksc-sdk-go/service/sts"
 	"github.com/KscSDK/ksc-sdk-go/service/tag"
 	"github.com/KscSDK/ksc-sdk-go/service/tagv2"
 	"github.com/KscSDK/ksc-sdk-go/service/vpc"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/network"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
 // Config is the configuration of ksyun meta data
@@ -61,6 +68,19 @@ type Config struct {
 	MaxRetries    int
 	HttpProxy     string
 	UseSSL        bool
+	// Endpoints overrides the host of each service, the key is the service name in the endpoints block
+	Endpoints map[string]string
+	// DefaultTags are merged into the tags of every taggable resource
+	DefaultTags map[string]string
+	AssumeRole  *AssumeRoleConfig
+	RetryPolicy *network.RetryPolicy
+	RateLimit   *network.RateLimitConfig
+	// Transport wraps the http transport of the sdk clients, e.g. recording the requests in testing
+	Transport func(http.RoundTripper) http.RoundTripper
+
+	// the limiter and the temporary credentials are created once, the copies of the config share them
+	rateLimiter           *network.RateLimiter
+	assumeRoleCredentials *credentials.Credentials
 }
 
 // Client will returns a client with connections for all product
@@ -71,7 +91,8 @@ func (c *Config) Client() (*KsyunClient, error) {
 	client.region = c.Region
 	cli := ksc.NewClient(c.AccessKey, c.SecretKey)
 
-	registerClient(cli, c)
+	client.transport = c.httpTransport()
+	registerClient(cli, c, client.transport)
 	// 重试去掉
 	var MaxRetries = c.MaxRetries
 	cli.Config.MaxRetries = &MaxRetries
@@ -86,38 +107,53 @@ func (c *Config) Client() (*KsyunClient, error) {
 		CustomerDomainIgnoreService: c.IgnoreService,
 	}
 
+	if c.AssumeRole != nil {
+		if c.assumeRoleCredentials == nil {
+			// sts client keeps signing with the static credentials of the caller
+			stsconn := sts.SdkNew(cli, cfg, c.serviceUrlInfo("sts", url))
+			creds := newAssumeRoleCredentials(stsconn, c.AssumeRole)
+			if _, err = creds.Get(); err != nil {
+				return nil, err
+			}
+			c.assumeRoleCredentials = creds
+		}
+		client.credentials = c.assumeRoleCredentials
+		cli.Config.Credentials = client.credentials
+	}
+
 	client.dryRun = c.DryRun
-	client.vpcconn = vpc.SdkNew(cli, cfg, url)
-	client.eipconn = eip.SdkNew(cli, cfg, url)
-	client.slbconn = slb.SdkNew(cli, cfg, url)
-	client.kecconn = kec.SdkNew(cli, cfg, url)
-	client.sqlserverconn = sqlserver.SdkNew(cli, cfg, url)
-	client.krdsconn = krds.SdkNew(cli, cfg, url)
-	client.kcmconn = kcm.SdkNew(cli, cfg, url)
-	client.sksconn = sks.SdkNew(cli, cfg, url)
-	client.kcsv1conn = kcsv1.SdkNew(cli, cfg, url)
-	client.kcsv2conn = kcsv2.SdkNew(cli, cfg, url)
-	client.epcconn = epc.SdkNew(cli, cfg, url)
-	client.ebsconn = ebs.SdkNew(cli, cfg, url)
-	client.mongodbconn = mongodb.SdkNew(cli, cfg, url)
-	client.iamconn = iam.SdkNew(cli, cfg, url)
-	client.rabbitmqconn = rabbitmq.SdkNew(cli, cfg, url)
-	client.bwsconn = bws.SdkNew(cli, cfg, url)
-	client.tagconn = tagv2.SdkNew(cli, cfg, url)
-	client.tagv1conn = tag.SdkNew(cli, cfg, url)
-	client.kceconn = kce.SdkNew(cli, cfg, url)
-	client.kcev2conn = kcev2.SdkNew(cli, cfg, url)
-	client.knadconn = knad.SdkNew(cli, cfg, url)
-	client.pdnsconn = pdns.SdkNew(cli, cfg, url)
-	client.kcrsconn = kcrs.SdkNew(cli, cfg, url)
-	client.kpfsconn = kpfs.SdkNew(cli, cfg, url)
-	if client.klogconn, err = klogSdkNew(c); err != nil {
+	client.vpcconn = vpc.SdkNew(cli, cfg, c.serviceUrlInfo("vpc", url))
+	client.eipconn = eip.SdkNew(cli, cfg, c.serviceUrlInfo("eip", url))
+	client.slbconn = slb.SdkNew(cli, cfg, c.serviceUrlInfo("slb", url))
+	client.kecconn = kec.SdkNew(cli, cfg, c.serviceUrlInfo("kec", url))
+	client.sqlserverconn = sqlserver.SdkNew(cli, cfg, c.serviceUrlInfo("sqlserver", url))
+	client.krdsconn = krds.SdkNew(cli, cfg, c.serviceUrlInfo("krds", url))
+	client.kcmconn = kcm.SdkNew(cli, cfg, c.serviceUrlInfo("kcm", url))
+	client.sksconn = sks.SdkNew(cli, cfg, c.serviceUrlInfo("sks", url))
+	client.kcsv1conn = kcsv1.SdkNew(cli, cfg, c.serviceUrlInfo("kcs", url))
+	client.kcsv2conn = kcsv2.SdkNew(cli, cfg, c.serviceUrlInfo("kcs", url))
+	client.epcconn = epc.SdkNew(cli, cfg, c.serviceUrlInfo("epc", url))
+	client.ebsconn = ebs.SdkNew(cli, cfg, c.serviceUrlInfo("ebs", url))
+	client.mongodbconn = mongodb.SdkNew(cli, cfg, c.serviceUrlInfo("mongodb", url))
+	client.iamconn = iam.SdkNew(cli, cfg, c.serviceUrlInfo("iam", url))
+	client.rabbitmqconn = rabbitmq.SdkNew(cli, cfg, c.serviceUrlInfo("rabbitmq", url))
+	client.bwsconn = bws.SdkNew(cli, cfg, c.serviceUrlInfo("bws", url))
+	client.tagconn = tagv2.SdkNew(cli, cfg, c.serviceUrlInfo("tag", url))
+	client.tagv1conn = tag.SdkNew(cli, cfg, c.serviceUrlInfo("tag", url))
+	client.kceconn = kce.SdkNew(cli, cfg, c.serviceUrlInfo("kce", url))
+	client.kcev2conn = kcev2.SdkNew(cli, cfg, c.serviceUrlInfo("kce", url))
+	client.knadconn = knad.SdkNew(cli, cfg, c.serviceUrlInfo("knad", url))
+	client.pdnsconn = pdns.SdkNew(cli, cfg, c.serviceUrlInfo("pdns", url))
+	client.kcrsconn = kcrs.SdkNew(cli, cfg, c.serviceUrlInfo("kcrs", url))
+	client.kpfsconn = kpfs.SdkNew(cli, cfg, c.serviceUrlInfo("kpfs", url))
+	if client.klogconn, err = klogSdkNew(c, client.credentials, client.transport); err != nil {
 		return nil, err
 	}
-	client.clickhouseconn = clickhouse.SdkNew(cli, cfg, url)
-	client.monitorconn = monitor.SdkNew(cli, cfg, url)
-	client.monitorv4conn = monitorv4.SdkNew(cli, cfg, url)
-	client.cenconn = cen.SdkNew(cli, cfg, url)
+	client.clickhouseconn = clickhouse.SdkNew(cli, cfg, c.serviceUrlInfo("clickhouse", url))
+	client.monitorconn = monitor.SdkNew(cli, cfg, c.serviceUrlInfo("monitor", url))
+	client.monitorv4conn = monitorv4.SdkNew(cli, cfg, c.serviceUrlInfo("monitor", url))
+	client.monitorv2conn = monitorv2.SdkNew(cli, cfg, c.serviceUrlInfo("monitor", url))
+	client.cenconn = cen.SdkNew(cli, cfg, c.serviceUrlInfo("cen", url))
 
 	// 懒加载ks3-client 所以不在此初始
 	return &client, nil
@@ -142,7 +178,16 @@ func (client *KsyunClient) WithKs3Client(do func(*ks3.Client) (interface{}, erro
 	defer goSdkMutex.Unlock()
 	// Initialize the KS3 client if necessary
 	if client.ks3conn == nil {
-		ks3conn, err := ks3.New(client.config.Endpoint, client.config.AccessKey, client.config.SecretKey)
+		var options []ks3.ClientOption
+		if client.credentials != nil {
+			options = append(options, ks3.SetCredentialsProvider(&ks3CredentialsProvider{creds: client.credentials}))
+		}
+		// the sdk builds its own transport unless a client is passed, so the client is only passed
+		// to send the requests through the rate limiter or the transport hook of the config
+		if client.config.rateLimiter != nil || client.config.Transport != nil {
+			options = append(options, client.config.ks3HTTPClient())
+		}
+		ks3conn, err := ks3.New(client.config.serviceEndpoint("ks3"), client.config.AccessKey, client.config.SecretKey, options...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the KS3 client: %#v", err)
 		}
@@ -151,13 +196,116 @@ func (client *KsyunClient) WithKs3Client(do func(*ks3.Client) (interface{}, erro
 	return do(client.ks3conn)
 }
 
-func registerClient(cli *session.Session, c *Config) {
+// ks3HTTPClient sets the http client of the ks3 sdk, it's applied after the other options to read the final sdk config.
+// The transport is built by the sdk config in the same way as the sdk, then wrapped by the transport hook
+// and the rate limiter of the config.
+func (c *Config) ks3HTTPClient() ks3.ClientOption {
+	return func(client *ks3.Client) {
+		var transport http.RoundTripper = ks3Transport(client.Config)
+		if c.Transport != nil {
+			transport = c.Transport(transport)
+		}
+		httpClient := &http.Client{
+			Transport: c.rateLimitedTransport("ks3", transport),
+			Timeout:   client.Config.HTTPTimeout.LongTimeout,
+		}
+		if client.Config.RedirectEnabled {
+			httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+				if len(via) >= 10 {
+					return fmt.Errorf("stopped after 10 redirects")
+				}
+				// the redirected request keeps the signature of the previous one
+				if req.Header.Get("Authorization") == "" {
+					req.Header.Set("Authorization", via[len(via)-1].Header.Get("Authorization"))
+				}
+				return nil
+			}
+		} else {
+			httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			}
+		}
+		ks3.HTTPClient(httpClient)(client)
+	}
+}
+
+// ks3Transport returns the transport built in the same way as the ks3 sdk, which isn't exported by the sdk.
+// The connections time out on each read and write, and on the idle time between them.
+func ks3Transport(config *ks3.Config) *http.Transport {
+	timeout := config.HTTPTimeout
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			d := net.Dialer{
+				Timeout:   timeout.ConnectTimeout,
+				KeepAlive: 30 * time.Second,
+				LocalAddr: config.LocalAddr,
+			}
+			conn, err := d.DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			return newKs3TimeoutConn(conn, timeout.ReadWriteTimeout, timeout.LongTimeout), nil
+		},
+		MaxIdleConns:          config.HTTPMaxConns.MaxIdleConns,
+		MaxIdleConnsPerHost:   config.HTTPMaxConns.MaxIdleConnsPerHost,
+		IdleConnTimeout:       timeout.IdleConnTimeout,
+		ResponseHeaderTimeout: timeout.HeaderTimeout,
+	}
+	if config.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	if config.IsUseProxy {
+		if config.ProxyFromEnvironment {
+			transport.Proxy = http.ProxyFromEnvironment
+		} else if proxyUrl, err := url.Parse(config.ProxyHost); err != nil {
+			log.Printf("[WARN] ignore the invalid proxy host of the ks3 client: %s", err)
+		} else {
+			if config.IsAuthProxy {
+				if config.ProxyPassword != "" {
+					proxyUrl.User = url.UserPassword(config.ProxyUser, config.ProxyPassword)
+				} else {
+					proxyUrl.User = url.User(config.ProxyUser)
+				}
+			}
+			transport.Proxy = http.ProxyURL(proxyUrl)
+		}
+	}
+	return transport
+}
+
+// ks3TimeoutConn sets the deadline of each read and write, the next read waits for the long timeout.
+type ks3TimeoutConn struct {
+	net.Conn
+	timeout     time.Duration
+	longTimeout time.Duration
+}
+
+func newKs3TimeoutConn(conn net.Conn, timeout, longTimeout time.Duration) *ks3TimeoutConn {
+	_ = conn.SetReadDeadline(time.Now().Add(longTimeout))
+	return &ks3TimeoutConn{Conn: conn, timeout: timeout, longTimeout: longTimeout}
+}
+
+func (c *ks3TimeoutConn) Read(b []byte) (n int, err error) {
+	_ = c.SetReadDeadline(time.Now().Add(c.timeout))
+	n, err = c.Conn.Read(b)
+	_ = c.SetReadDeadline(time.Now().Add(c.longTimeout))
+	return n, err
+}
+
+func (c *ks3TimeoutConn) Write(b []byte) (n int, err error) {
+	_ = c.SetWriteDeadline(time.Now().Add(c.timeout))
+	n, err = c.Conn.Write(b)
+	_ = c.SetReadDeadline(time.Now().Add(c.longTimeout))
+	return n, err
+}
+
+func registerClient(cli *session.Session, c *Config, transport http.RoundTripper) {
 
 	// register http client
-	httpClient := getKsyunClient(c)
+	httpClient := getKsyunClient(transport)
 	cli.Config.WithHTTPClient(httpClient)
 
-	cli.Config.Retryer = network.GetKsyunRetryer(c.MaxRetries)
+	cli.Config.Retryer = network.NewKsyunRetryer(c.MaxRetries, c.RetryPolicy)
 
 	cli.Handlers.CompleteAttempt.PushBackNamed(network.NetErrorHandler)
 
@@ -165,9 +313,55 @@ func registerClient(cli *session.Session, c *Config) {
 	// cli.Handlers.CompleteAttempt.PushBackNamed(network.OutputResetError)
 
 	cli.Handlers.Sign.PushBackNamed(network.HandleRequestBody)
+
+	// trace each api attempt to the file of KSYUN_TRACE_FILE
+	if tracer, err := logger.TracerFromEnv(); err != nil {
+		log.Printf("[WARN] api tracing is disabled: %s", err)
+	} else if tracer != nil {
+		cli.Handlers.CompleteAttempt.PushBackNamed(network.TraceHandler(tracer))
+	}
+
+	if c.RateLimit != nil {
+		if c.rateLimiter == nil {
+			c.rateLimiter = network.NewRateLimiter(c.RateLimit)
+			registerRateLimiter(c.rateLimiter)
+		}
+		cli.Handlers.Send.PushFrontNamed(c.rateLimiter.Handler())
+	}
+}
+
+// rateLimitedTransport makes the requests of the service wait for the rate limiter of the config,
+// it's used by the sdk clients that don't send by the aws session, such as ks3, klog and kmr.
+func (c *Config) rateLimitedTransport(service string, transport http.RoundTripper) http.RoundTripper {
+	if c.rateLimiter == nil {
+		return transport
+	}
+	return c.rateLimiter.Transport(service, transport)
+}
+
+var rateLimiters []*network.RateLimiter
+var rateLimitersMutex = sync.Mutex{}
+
+func registerRateLimiter(limiter *network.RateLimiter) {
+	rateLimitersMutex.Lock()
+	defer rateLimitersMutex.Unlock()
+	rateLimiters = append(rateLimiters, limiter)
+}
+
+// LogRateLimitMetrics logs the statistics of rate limited requests, it's called at the end of the run.
+// The limiter is registered once for each config, the copies of the config share it.
+func LogRateLimitMetrics() {
+	rateLimitersMutex.Lock()
+	defer rateLimitersMutex.Unlock()
+	for _, limiter := range rateLimiters {
+		for _, line := range limiter.Summary() {
+			log.Printf("[INFO] rate limit metrics: %s", line)
+		}
+	}
 }
 
-func getKsyunClient(c *Config) *http.Client {
+// httpTransport returns the http transport shared by the sdk clients
+func (c *Config) httpTransport() http.RoundTripper {
 	tp := &http.Transport{
 		Proxy: func(r *http.Request) (*url.URL, error) {
 			if c.HttpProxy != "" {
@@ -191,19 +385,34 @@ func getKsyunClient(c *Config) *http.Client {
 		DisableKeepAlives:     !c.HttpKeepAlive,
 	}
 
+	var transport http.RoundTripper = tp
+	if c.Transport != nil {
+		transport = c.Transport(tp)
+	}
+	return transport
+}
+
+func getKsyunClient(transport http.RoundTripper) *http.Client {
 	httpClient := &http.Client{
 		Timeout:   3 * time.Minute, // a completed request, includes tcp connect, received response, elapsed time.
-		Transport: tp,
+		Transport: transport,
 	}
 	return httpClient
 }
 
-func klogSdkNew(c *Config) (*klog.Client, error) {
+func klogSdkNew(c *Config, creds *credentials.Credentials, transport http.RoundTripper) (*klog.Client, error) {
 	cpf := profile.NewClientProfile()
 	cpf.HttpProfile.ReqMethod = "POST"
 	cpf.HttpProfile.ReqTimeout = 20
-	cpf.HttpProfile.Endpoint = c.Endpoint
-	return klog.NewClient(common.NewCredential(c.AccessKey, c.SecretKey), c.Region, cpf)
+	c.setHttpProfileEndpoint("klog", cpf)
+	klogconn, err := klog.NewClient(common.NewCredential(c.AccessKey, c.SecretKey), c.Region, cpf)
+	if err != nil {
+		return nil, err
+	}
+	transport = c.rateLimitedTransport("klog", transport)
+	klogconn.WithHttpTransport(transport)
+	withTemporaryCredentials(&klogconn.Client, creds, transport)
+	return klogconn, nil
 }
 
 func (client *KsyunClient) WithKmrClient(do func(*kmr.Client) (interface{}, error)) (interface{}, error) {
@@ -213,11 +422,14 @@ func (client *KsyunClient) WithKmrClient(do func(*kmr.Client) (interface{}, erro
 	if client.kmrconn == nil {
 		credential := common.NewCredential(client.config.AccessKey, client.config.SecretKey)
 		cpf := profile.NewClientProfile()
-		cpf.HttpProfile.Endpoint = client.config.Endpoint
+		client.config.setHttpProfileEndpoint("kmr", cpf)
 		kmrconn, err := kmr.NewClient(credential, client.config.Region, cpf)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the KMR client: %#v", err)
 		}
+		transport := client.config.rateLimitedTransport("kmr", client.transport)
+		kmrconn.WithHttpTransport(transport)
+		withTemporaryCredentials(&kmrconn.Client, client.credentials, transport)
 		client.kmrconn = kmrconn
 	}
 	return do(client.kmrconn)
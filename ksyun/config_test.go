@@ -0,0 +1,199 @@
+package ksyun
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	klog "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/klog/v20200731"
+	kmr "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/kmr/v20210902"
+	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/network"
+)
+
+func TestConfigClientRateLimiter(t *testing.T) {
+	registered := len(rateLimiters)
+	config := &Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		Region:    "cn-beijing-6",
+		RateLimit: &network.RateLimitConfig{QPS: 10},
+	}
+	if _, err := config.Client(); err != nil {
+		t.Fatal(err)
+	}
+	limiter := config.rateLimiter
+	if limiter == nil {
+		t.Fatal("expected the rate limiter to be created")
+	}
+
+	// the clients of the same config, and of its copies, share the limiter
+	if _, err := config.Client(); err != nil {
+		t.Fatal(err)
+	}
+	copied := *config
+	copied.Region = "cn-shanghai-2"
+	if _, err := copied.Client(); err != nil {
+		t.Fatal(err)
+	}
+	if config.rateLimiter != limiter || copied.rateLimiter != limiter {
+		t.Error("expected one rate limiter shared by the clients")
+	}
+	if n := len(rateLimiters) - registered; n != 1 {
+		t.Errorf("expected the rate limiter registered once for the metrics, got %d", n)
+	}
+}
+
+func TestKsyunClientWithRegion(t *testing.T) {
+	var assumeCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		atomic.AddInt32(&assumeCount, 1)
+		_, _ = w.Write([]byte(`{"RequestId":"r1","AssumeRoleResult":{"Credentials":{` +
+			`"AccessKeyId":"tmp-ak","SecretAccessKey":"tmp-sk","SecurityToken":"tmp-token"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AccessKey:     "ak",
+		SecretKey:     "sk",
+		Region:        "cn-beijing-6",
+		Domain:        strings.TrimPrefix(server.URL, "http://"),
+		IgnoreService: true,
+		AssumeRole:    &AssumeRoleConfig{RoleKrn: "krn:ksc:iam::123456:role/terraform"},
+		RateLimit:     &network.RateLimitConfig{QPS: 10},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same, err := client.WithRegion("cn-beijing-6"); err != nil || same != client {
+		t.Errorf("expected the client itself for its own region, got %v", err)
+	}
+	shanghai, err := client.WithRegion("cn-shanghai-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shanghai.region != "cn-shanghai-2" {
+		t.Errorf("expected region cn-shanghai-2, got %s", shanghai.region)
+	}
+	if again, _ := client.WithRegion("cn-shanghai-2"); again != shanghai {
+		t.Error("expected the client of the region to be reused")
+	}
+	if shanghai.credentials != client.credentials || shanghai.config.rateLimiter != config.rateLimiter {
+		t.Error("expected the region client to share the credentials and the rate limiter")
+	}
+	if n := atomic.LoadInt32(&assumeCount); n != 1 {
+		t.Errorf("expected assume role once, got %d", n)
+	}
+}
+
+func TestConfigTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"RequestId":"r1"}`))
+	}))
+	defer server.Close()
+
+	// every sdk client sends the requests through the transport of the config
+	var sent int32
+	host := strings.TrimPrefix(server.URL, "http://")
+	config := &Config{
+		AccessKey:     "ak",
+		SecretKey:     "sk",
+		Region:        "cn-beijing-6",
+		Domain:        host,
+		Endpoint:      host,
+		IgnoreService: true,
+		Transport: func(base http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&sent, 1)
+				return base.RoundTrip(r)
+			})
+		},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for service, send := range map[string]func(){
+		"vpc": func() {
+			_, _ = client.vpcconn.DescribeVpcs(&map[string]interface{}{})
+		},
+		"klog": func() {
+			_, _ = client.klogconn.ListProjectsSend(klog.NewListProjectsRequest())
+		},
+		"kmr": func() {
+			_, _ = client.WithKmrClient(func(conn *kmr.Client) (interface{}, error) {
+				return conn.ListClustersSend(kmr.NewListClustersRequest())
+			})
+		},
+		"ks3": func() {
+			_, _ = client.WithKs3Client(func(conn *ks3.Client) (interface{}, error) {
+				return conn.ListBuckets()
+			})
+		},
+	} {
+		atomic.StoreInt32(&sent, 0)
+		send()
+		if atomic.LoadInt32(&sent) == 0 {
+			t.Errorf("the %s request is not sent through the transport of the config", service)
+		}
+	}
+}
+
+func TestKsyunClientWithKs3Client(t *testing.T) {
+	ks3Client := func(config *Config) *ks3.Client {
+		client, err := config.Client()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn, err := client.WithKs3Client(func(conn *ks3.Client) (interface{}, error) {
+			return conn, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn.(*ks3.Client)
+	}
+
+	// the sdk builds its own transport without the rate limiter
+	conn := ks3Client(&Config{AccessKey: "ak", SecretKey: "sk", Region: "cn-beijing-6"})
+	if conn.HTTPClient != nil {
+		t.Error("expected the ks3 sdk to build its own client without the rate limiter")
+	}
+
+	conn = ks3Client(&Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		Region:    "cn-beijing-6",
+		RateLimit: &network.RateLimitConfig{QPS: 10},
+	})
+	if conn.HTTPClient == nil {
+		t.Fatal("expected the ks3 client sending through the rate limiter")
+	}
+	if conn.HTTPClient.Timeout != conn.Config.HTTPTimeout.LongTimeout {
+		t.Errorf("expected the request timeout %s, got %s", conn.Config.HTTPTimeout.LongTimeout, conn.HTTPClient.Timeout)
+	}
+	if _, ok := conn.HTTPClient.Transport.(*http.Transport); ok {
+		t.Error("expected the transport wrapped by the rate limiter")
+	}
+
+	transport := ks3Transport(conn.Config)
+	if transport.ResponseHeaderTimeout != conn.Config.HTTPTimeout.HeaderTimeout {
+		t.Errorf("expected the response header timeout %s, got %s",
+			conn.Config.HTTPTimeout.HeaderTimeout, transport.ResponseHeaderTimeout)
+	}
+	if transport.IdleConnTimeout != conn.Config.HTTPTimeout.IdleConnTimeout {
+		t.Errorf("expected the idle connection timeout %s, got %s",
+			conn.Config.HTTPTimeout.IdleConnTimeout, transport.IdleConnTimeout)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,225 @@
+package ksyun
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KscSDK/ksc-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/kingsoftcloud/sdk-go/v2/ksyun/common"
+	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
+)
+
+const (
+	assumeRoleProviderName = "KsyunAssumeRoleProvider"
+
+	defaultAssumeRoleSessionName     = "terraform"
+	defaultAssumeRoleDurationSeconds = 3600
+
+	// assumeRoleExpiryWindow makes the temporary credentials expire earlier than
+	// the real expiration, so that they are refreshed before a request is signed
+	// with a credential that becomes invalid on the wire.
+	assumeRoleExpiryWindow = 5 * time.Minute
+
+	securityTokenHeader = "X-Amz-Security-Token"
+)
+
+// AssumeRoleConfig is the configuration of provider assume_role block
+type AssumeRoleConfig struct {
+	RoleKrn         string
+	RoleSessionName string
+	DurationSeconds int
+	Policy          string
+}
+
+// assumeRoleProvider retrieves temporary credentials from STS AssumeRole,
+// it implements credentials.Provider so that the aws session refreshes it when expired.
+type assumeRoleProvider struct {
+	credentials.Expiry
+
+	stsconn *sts.Sts
+	config  *AssumeRoleConfig
+}
+
+var _ credentials.Provider = (*assumeRoleProvider)(nil)
+
+func newAssumeRoleCredentials(stsconn *sts.Sts, config *AssumeRoleConfig) *credentials.Credentials {
+	return credentials.NewCredentials(&assumeRoleProvider{
+		stsconn: stsconn,
+		config:  config,
+	})
+}
+
+func (p *assumeRoleProvider) Retrieve() (credentials.Value, error) {
+	value := credentials.Value{ProviderName: assumeRoleProviderName}
+
+	sessionName := p.config.RoleSessionName
+	if sessionName == "" {
+		sessionName = defaultAssumeRoleSessionName
+	}
+	duration := p.config.DurationSeconds
+	if duration == 0 {
+		duration = defaultAssumeRoleDurationSeconds
+	}
+	req := map[string]interface{}{
+		"RoleKrn":         p.config.RoleKrn,
+		"RoleSessionName": sessionName,
+		"DurationSeconds": strconv.Itoa(duration),
+	}
+	if p.config.Policy != "" {
+		req["Policy"] = p.config.Policy
+	}
+
+	resp, err := p.stsconn.AssumeRole(&req)
+	if err != nil {
+		return value, fmt.Errorf("error assuming role %s: %s", p.config.RoleKrn, err)
+	}
+	cred, err := getSdkValue("AssumeRoleResult.Credentials", *resp)
+	if err != nil {
+		return value, err
+	}
+	credMap, err := If2Map(cred)
+	if err != nil || credMap == nil {
+		return value, fmt.Errorf("error assuming role %s: no credentials returned", p.config.RoleKrn)
+	}
+
+	value.AccessKeyID, _ = If2String(credMap["AccessKeyId"])
+	value.SecretAccessKey, _ = If2String(credMap["SecretAccessKey"])
+	value.SessionToken, _ = If2String(credMap["SecurityToken"])
+	if value.AccessKeyID == "" || value.SecretAccessKey == "" {
+		return value, fmt.Errorf("error assuming role %s: empty access key returned", p.config.RoleKrn)
+	}
+
+	expiration, _ := If2String(credMap["Expiration"])
+	expireAt, err := parseAssumeRoleExpiration(expiration)
+	if err != nil {
+		// fallback to the requested duration if the expiration is unrecognized
+		expireAt = time.Now().Add(time.Duration(duration) * time.Second)
+	}
+	p.SetExpiration(expireAt, assumeRoleExpiryWindow)
+
+	return value, nil
+}
+
+func parseAssumeRoleExpiration(s string) (time.Time, error) {
+	layouts := []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized expiration %q", s)
+}
+
+// sdkCredential adapts the refreshable credentials to the credential interface of sdk-go v2,
+// which fetches the secret id and key on every signing.
+type sdkCredential struct {
+	creds *credentials.Credentials
+}
+
+var _ common.Credentials = (*sdkCredential)(nil)
+
+func (c *sdkCredential) GetSecretId() string {
+	v, _ := c.creds.Get()
+	return v.AccessKeyID
+}
+
+func (c *sdkCredential) GetSecretKey() string {
+	v, _ := c.creds.Get()
+	return v.SecretAccessKey
+}
+
+// securityTokenTransport signs the requests of the sdk-go v2 clients again with the session token,
+// since they sign with the secret id and key only. The token is added before signing, so it's
+// one of the signed headers in the same way as the requests of the aws session clients.
+type securityTokenTransport struct {
+	creds *credentials.Credentials
+	base  http.RoundTripper
+}
+
+func (t *securityTokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	v, err := t.creds.Get()
+	if err != nil {
+		return nil, err
+	}
+	if v.SessionToken == "" {
+		return t.base.RoundTrip(r)
+	}
+	service, region, err := signingScope(r.Header.Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+	var body io.ReadSeeker
+	if r.GetBody != nil {
+		rc, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+	r = r.Clone(r.Context())
+	r.Header.Del("Authorization")
+	// the signer sets the security token header of the credentials and signs it
+	signer := v4.NewSigner(credentials.NewStaticCredentialsFromCreds(v))
+	if _, err = signer.Sign(r, body, service, region, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(r)
+}
+
+// signingScope returns the service and the region in the credential scope of the v4 authorization header,
+// such as "AWS4-HMAC-SHA256 Credential=AKID/20240102/cn-beijing-6/klog/aws4_request, SignedHeaders=..., Signature=...".
+func signingScope(authorization string) (service, region string, err error) {
+	for _, part := range strings.Split(authorization, ",") {
+		part = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(part), "AWS4-HMAC-SHA256"))
+		if !strings.HasPrefix(part, "Credential=") {
+			continue
+		}
+		scope := strings.Split(strings.TrimPrefix(part, "Credential="), "/")
+		if len(scope) != 5 {
+			break
+		}
+		return scope[3], scope[2], nil
+	}
+	return "", "", fmt.Errorf("unrecognized authorization header of the request: %q", authorization)
+}
+
+// ks3CredentialsProvider makes the KS3 client read the latest temporary credentials
+// on each request.
+type ks3CredentialsProvider struct {
+	creds *credentials.Credentials
+}
+
+type ks3Credentials struct {
+	value credentials.Value
+}
+
+func (c *ks3Credentials) GetAccessKeyID() string     { return c.value.AccessKeyID }
+func (c *ks3Credentials) GetAccessKeySecret() string { return c.value.SecretAccessKey }
+func (c *ks3Credentials) GetSecurityToken() string   { return c.value.SessionToken }
+
+func (p *ks3CredentialsProvider) GetCredentials() ks3.Credentials {
+	v, _ := p.creds.Get()
+	return &ks3Credentials{value: v}
+}
+
+// withTemporaryCredentials makes a sdk-go v2 client sign requests with the refreshable credentials,
+// the requests are signed again with the session token over the transport of the other sdk clients.
+func withTemporaryCredentials(cli *common.Client, creds *credentials.Credentials, transport http.RoundTripper) {
+	if creds == nil {
+		return
+	}
+	cli.WithCredential(&sdkCredential{creds: creds}).
+		WithHttpTransport(&securityTokenTransport{creds: creds, base: transport})
+}
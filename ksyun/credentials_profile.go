@@ -0,0 +1,133 @@
+package ksyun
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	defaultSharedCredentialsFile = "~/.ksyun/credentials"
+	defaultProfileName           = "default"
+)
+
+// profileCredentials is a profile section of the shared credentials file, such as
+//
+//	[default]
+//	access_key = your ak
+//	secret_key = your sk
+//	region     = cn-beijing-6
+type profileCredentials struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+}
+
+// parseSharedCredentials reads an INI-style credentials file, returns the key-values grouped by profile name.
+func parseSharedCredentials(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid profile section %q", lineNum, line)
+			}
+			name := strings.TrimSpace(strings.TrimPrefix(line[1:len(line)-1], "profile "))
+			if name == "" {
+				return nil, fmt.Errorf("line %d: profile name is empty", lineNum)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = make(map[string]string)
+			}
+			current = profiles[name]
+			continue
+		}
+		index := strings.Index(line, "=")
+		if index < 0 {
+			return nil, fmt.Errorf("line %d: expected key = value, got %q", lineNum, line)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key-value is outside any profile section", lineNum)
+		}
+		key := strings.ToLower(strings.TrimSpace(line[:index]))
+		current[key] = strings.TrimSpace(line[index+1:])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// loadProfileCredentials returns the credentials of the profile in the shared credentials file.
+// An empty filename means the default file, and an empty profile means the default profile.
+// It returns nil without error if neither of them is specified and the default one is missing.
+func loadProfileCredentials(filename, profile string) (*profileCredentials, error) {
+	explicit := filename != "" || profile != ""
+	if filename == "" {
+		filename = defaultSharedCredentialsFile
+	}
+	if profile == "" {
+		profile = defaultProfileName
+	}
+
+	path, err := getAbsPath(filename)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading shared credentials file %s: %s", filename, err)
+	}
+	defer f.Close()
+
+	profiles, err := parseSharedCredentials(f)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing shared credentials file %s: %s", filename, err)
+	}
+	values, ok := profiles[profile]
+	if !ok {
+		if !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("profile %q is not found in shared credentials file %s", profile, filename)
+	}
+	return &profileCredentials{
+		AccessKey: values["access_key"],
+		SecretKey: values["secret_key"],
+		Region:    values["region"],
+	}, nil
+}
+
+// applyProfileCredentials fills the access key, secret key and region that are
+// neither set in the provider block nor by environment variables.
+// The precedence is: provider block > environment variables > shared credentials profile.
+func (c *Config) applyProfileCredentials(filename, profile string) error {
+	if c.AccessKey != "" && c.SecretKey != "" && c.Region != "" {
+		return nil
+	}
+	creds, err := loadProfileCredentials(filename, profile)
+	if err != nil || creds == nil {
+		return err
+	}
+	// the access key and secret key must come from the same place
+	if c.AccessKey == "" && c.SecretKey == "" {
+		c.AccessKey = creds.AccessKey
+		c.SecretKey = creds.SecretKey
+	}
+	if c.Region == "" {
+		c.Region = creds.Region
+	}
+	return nil
+}
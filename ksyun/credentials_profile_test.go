@@ -0,0 +1,76 @@
+package ksyun
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSharedCredentials(t *testing.T) {
+	profiles, err := parseSharedCredentials(strings.NewReader("[a]\nk = v = w\n\n[profile b]\nK=1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profiles["a"]["k"] != "v = w" {
+		t.Errorf("expected %q, got %q", "v = w", profiles["a"]["k"])
+	}
+	if profiles["b"]["k"] != "1" {
+		t.Errorf("expected %q, got %q", "1", profiles["b"]["k"])
+	}
+
+	for _, content := range []string{"k = v\n", "[a\n", "[]\n", "[a]\nnovalue\n"} {
+		if _, err := parseSharedCredentials(strings.NewReader(content)); err == nil {
+			t.Errorf("expected error for %q", content)
+		}
+	}
+}
+
+func TestLoadProfileCredentials(t *testing.T) {
+	cases := []struct {
+		filename string
+		profile  string
+		expected *profileCredentials
+		hasError bool
+	}{
+		{"testdata/shared_credentials", "", &profileCredentials{"default_ak", "default_sk", "cn-beijing-6"}, false},
+		{"testdata/shared_credentials", "staging", &profileCredentials{"staging_ak", "staging_sk", "cn-shanghai-2"}, false},
+		{"testdata/shared_credentials", "no_region", &profileCredentials{"no_region_ak", "no_region_sk", ""}, false},
+		{"testdata/shared_credentials", "missing", nil, true},
+		{"testdata/not_exist", "", nil, true},
+		{"testdata/shared_credentials_invalid", "", nil, true},
+	}
+	for _, c := range cases {
+		creds, err := loadProfileCredentials(c.filename, c.profile)
+		if c.hasError {
+			if err == nil {
+				t.Errorf("%s[%s]: expected error", c.filename, c.profile)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s[%s]: %s", c.filename, c.profile, err)
+			continue
+		}
+		if *creds != *c.expected {
+			t.Errorf("%s[%s]: expected %+v, got %+v", c.filename, c.profile, c.expected, creds)
+		}
+	}
+}
+
+func TestApplyProfileCredentials(t *testing.T) {
+	// values from provider block or environment variables take precedence over the profile
+	config := &Config{AccessKey: "hcl_ak", SecretKey: "hcl_sk"}
+	if err := config.applyProfileCredentials("testdata/shared_credentials", "staging"); err != nil {
+		t.Fatal(err)
+	}
+	if config.AccessKey != "hcl_ak" || config.SecretKey != "hcl_sk" || config.Region != "cn-shanghai-2" {
+		t.Errorf("unexpected config %+v", config)
+	}
+
+	config = &Config{Region: "cn-guangzhou-1"}
+	if err := config.applyProfileCredentials("testdata/shared_credentials", ""); err != nil {
+		t.Fatal(err)
+	}
+	if config.AccessKey != "default_ak" || config.SecretKey != "default_sk" || config.Region != "cn-guangzhou-1" {
+		t.Errorf("unexpected config %+v", config)
+	}
+}
@@ -0,0 +1,137 @@
+package ksyun
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	klog "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/klog/v20200731"
+)
+
+func TestAssumeRoleCredentials(t *testing.T) {
+	var assumeCount int32
+	var gotToken string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("Action") {
+		case "AssumeRole":
+			atomic.AddInt32(&assumeCount, 1)
+			if r.URL.Query().Get("RoleKrn") != "krn:ksc:iam::123456:role/terraform" {
+				t.Errorf("unexpected RoleKrn %q", r.URL.Query().Get("RoleKrn"))
+			}
+			expiration := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+			_, _ = w.Write([]byte(`{"RequestId":"r1","AssumeRoleResult":{"Credentials":{` +
+				`"AccessKeyId":"tmp-ak","SecretAccessKey":"tmp-sk","SecurityToken":"tmp-token",` +
+				`"Expiration":"` + expiration + `"}}}`))
+		default:
+			gotToken = r.Header.Get(securityTokenHeader)
+			if !strings.Contains(r.Header.Get("Authorization"), "tmp-ak") {
+				t.Errorf("request is not signed with the temporary credentials: %s", r.Header.Get("Authorization"))
+			}
+			_, _ = w.Write([]byte(`{"RequestId":"r2","VpcSet":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AccessKey:     "ak",
+		SecretKey:     "sk",
+		Region:        "cn-beijing-6",
+		Domain:        strings.TrimPrefix(server.URL, "http://"),
+		IgnoreService: true,
+		AssumeRole: &AssumeRoleConfig{
+			RoleKrn:         "krn:ksc:iam::123456:role/terraform",
+			RoleSessionName: "test",
+			DurationSeconds: 3600,
+		},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.vpcconn.DescribeVpcs(&map[string]interface{}{}); err != nil {
+		t.Fatal(err)
+	}
+	if gotToken != "tmp-token" {
+		t.Errorf("expected security token %q, got %q", "tmp-token", gotToken)
+	}
+	if n := atomic.LoadInt32(&assumeCount); n != 1 {
+		t.Errorf("expected assume role once, got %d", n)
+	}
+
+	// expired credentials are refreshed before signing
+	client.credentials.Expire()
+	if _, err = client.vpcconn.DescribeVpcs(&map[string]interface{}{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&assumeCount); n != 2 {
+		t.Errorf("expected assume role twice, got %d", n)
+	}
+}
+
+func TestAssumeRoleCredentialsSdkTransport(t *testing.T) {
+	var gotToken, gotAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("Action") == "AssumeRole" {
+			_, _ = w.Write([]byte(`{"RequestId":"r1","AssumeRoleResult":{"Credentials":{` +
+				`"AccessKeyId":"tmp-ak","SecretAccessKey":"tmp-sk","SecurityToken":"tmp-token"}}}`))
+			return
+		}
+		gotToken = r.Header.Get(securityTokenHeader)
+		gotAuthorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"RequestId":"r2","Projects":[]}`))
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	config := &Config{
+		AccessKey:     "ak",
+		SecretKey:     "sk",
+		Region:        "cn-beijing-6",
+		Domain:        host,
+		Endpoint:      host,
+		IgnoreService: true,
+		AssumeRole:    &AssumeRoleConfig{RoleKrn: "krn:ksc:iam::123456:role/terraform"},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.klogconn.ListProjectsSend(klog.NewListProjectsRequest()); err != nil {
+		t.Fatal(err)
+	}
+	if gotToken != "tmp-token" {
+		t.Errorf("expected the klog request with token %q, got %q", "tmp-token", gotToken)
+	}
+	// the token is signed with the temporary credentials like the requests of the aws session clients
+	if !strings.Contains(gotAuthorization, "Credential=tmp-ak/") ||
+		!strings.Contains(gotAuthorization, strings.ToLower(securityTokenHeader)) {
+		t.Errorf("expected the klog request signed with the temporary credentials and the token, got %q", gotAuthorization)
+	}
+}
+
+func TestSigningScope(t *testing.T) {
+	service, region, err := signingScope("AWS4-HMAC-SHA256 Credential=ak/20240102/cn-beijing-6/klog/aws4_request, " +
+		"SignedHeaders=host;x-amz-date, Signature=abc")
+	if err != nil || service != "klog" || region != "cn-beijing-6" {
+		t.Errorf("unexpected scope %q %q: %v", service, region, err)
+	}
+	if _, _, err = signingScope(""); err == nil {
+		t.Error("expected error for the request without authorization")
+	}
+}
+
+func TestParseAssumeRoleExpiration(t *testing.T) {
+	for _, s := range []string{"2024-01-02T03:04:05Z", "2024-01-02T03:04:05", "2024-01-02 03:04:05"} {
+		if _, err := parseAssumeRoleExpiration(s); err != nil {
+			t.Errorf("parse %q: %s", s, err)
+		}
+	}
+	if _, err := parseAssumeRoleExpiration("tomorrow"); err == nil {
+		t.Error("expected error for unrecognized expiration")
+	}
+}
@@ -1,6 +1,6 @@
 package ksyun
 
-import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 type SdkReqParameter struct {
 	onlyTransform bool
@@ -15,7 +15,7 @@ This data source provides a list of ALB listener cert group resources according
 */
 package ksyun
 
-import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceKsyunAlbListenerCertGroups() *schema.Resource {
 	return &schema.Resource{
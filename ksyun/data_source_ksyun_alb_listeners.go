@@ -18,7 +18,7 @@ This data source provides a list of ALB listener resources according to their ID
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunAlbListeners() *schema.Resource {
@@ -151,7 +151,6 @@ func dataSourceKsyunAlbListeners() *schema.Resource {
 						},
 						"health_check": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Description: "Health check configuration. It is a nested type which documented below.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -206,7 +205,6 @@ func dataSourceKsyunAlbListeners() *schema.Resource {
 						},
 						"session": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Computed:    true,
 							Description: "session.",
 							Elem: &schema.Resource{
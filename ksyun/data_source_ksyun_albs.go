@@ -17,7 +17,7 @@ This data source provides a list of ALB resources according to their ALB ID.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunAlbs() *schema.Resource {
@@ -157,7 +157,6 @@ func dataSourceKsyunAlbs() *schema.Resource {
 						"klog_info": {
 							Type:        schema.TypeList,
 							Computed:    true,
-							MaxItems:    1,
 							Description: "klog info.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
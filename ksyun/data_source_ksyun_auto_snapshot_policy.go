@@ -31,7 +31,7 @@ Query instance auto snapshot policies information
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -32,7 +32,7 @@ query asp and volume associations by auto_snapshot_policy_id
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunAutoSnapshotVolumeAssociation() *schema.Resource {
@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccKsyunAutoSnapshotVolumeAssociationDataSource_basic(t *testing.T) {
@@ -11,7 +11,7 @@ func TestAccKsyunAutoSnapshotVolumeAssociationDataSource_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			// {
 			// 	Config: testAccDataKrdsParameterGroupConfig,
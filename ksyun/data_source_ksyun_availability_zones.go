@@ -14,7 +14,7 @@ This data source provides a list of available zones in the current region.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunAvailabilityZones() *schema.Resource {
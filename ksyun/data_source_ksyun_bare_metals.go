@@ -24,8 +24,8 @@ This data source provides a list of Bare Metal resources according to their Bare
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceKsyunBareMetals() *schema.Resource {
@@ -202,7 +202,6 @@ func dataSourceKsyunBareMetals() *schema.Resource {
 						},
 						"cpu": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Computed:    true,
 							Description: "cpu specification.",
 							Elem: &schema.Resource{
@@ -444,7 +443,6 @@ func dataSourceKsyunBareMetals() *schema.Resource {
 						},
 						"gpu": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Computed:    true,
 							Description: "Gpu specification.",
 							Elem: &schema.Resource{
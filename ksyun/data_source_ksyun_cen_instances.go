@@ -0,0 +1,102 @@
+/*
+This data source provides a list of the instances attached to the Cen.
+
+# Example Usage
+
+```hcl
+data "ksyun_cen_instances" "default" {
+  cen_id        = "xxxxxxxx-abc123456"
+  instance_type = "Vpc"
+  output_file   = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunCenInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunCenInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the cen.",
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Vpc",
+					"DirectConnectGateway",
+				}, false),
+				Description: "The type of the instances. Valid Values: 'Vpc', 'DirectConnectGateway'.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of instances that satisfy the condition.",
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the instance.",
+						},
+						"instance_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the instance.",
+						},
+						"instance_account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account ID of the instance.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the attachment.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the instance is attached.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunCenInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	cenService := CenService{meta.(*KsyunClient)}
+	return cenService.ReadAndSetCenInstances(d, dataSourceKsyunCenInstances())
+}
@@ -0,0 +1,91 @@
+/*
+This data source provides a list of the routes learned by the Cen from the attached instances.
+
+# Example Usage
+
+```hcl
+data "ksyun_cen_routes" "default" {
+  cen_id      = "xxxxxxxx-abc123456"
+  output_file = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceKsyunCenRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunCenRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the cen.",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the attached instance which the routes are learned from.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of routes that satisfy the condition.",
+			},
+			"routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination CIDR block of the route.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance which the route is learned from.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the instance.",
+						},
+						"instance_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the instance.",
+						},
+						"route_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the route, such as System and Custom.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the route, such as Active and Conflicted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunCenRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	cenService := CenService{meta.(*KsyunClient)}
+	return cenService.ReadAndSetCenRoutes(d, dataSourceKsyunCenRoutes())
+}
@@ -29,7 +29,7 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -368,20 +368,9 @@ func dataSourceKsyunClickhouse() *schema.Resource {
 						"area": {
 							Type:        schema.TypeMap,
 							Computed:    true,
-							Description: "Instance area configuration.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"master": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "Master area.",
-									},
-									"standby": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "Standby area.",
-									},
-								},
+							Description: "Instance area configuration, the `master` and `standby` area.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
 							},
 						},
 						"shard_list": {
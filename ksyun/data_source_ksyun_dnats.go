@@ -18,7 +18,7 @@ data "ksyun_dnats" "default" {
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunDnats() *schema.Resource {
@@ -13,7 +13,7 @@ This data source provides a list of group resources.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunIamGroups() *schema.Resource {
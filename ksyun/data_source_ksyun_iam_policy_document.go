@@ -0,0 +1,384 @@
+/*
+This data source renders an IAM policy document in the canonical JSON, which is used by the
+`policy_document` of `ksyun_iam_policy` and the `policy` of `ksyun_ks3_bucket`.
+
+The statements of `source_policy_documents` are merged in order, a statement with the same `sid`
+overrides the earlier one, and the `statement` blocks override the statements of the source documents.
+
+# Example Usage
+
+```hcl
+data "ksyun_iam_policy_document" "base" {
+  statement {
+    sid       = "ListUsers"
+    actions   = ["iam:ListUsers"]
+    resources = ["*"]
+  }
+}
+
+data "ksyun_iam_policy_document" "foo" {
+  source_policy_documents = [data.ksyun_iam_policy_document.base.json]
+
+  statement {
+    sid       = "GetUser"
+    effect    = "Allow"
+    actions   = ["iam:GetUser"]
+    resources = ["krn:ksc:iam::2000000001:user/*"]
+
+    condition {
+      test     = "IpAddress"
+      variable = "ksc:SourceIp"
+      values   = ["10.0.0.0/8"]
+    }
+  }
+}
+
+resource "ksyun_iam_policy" "foo" {
+  policy_name     = "tf-policy"
+  policy_document = data.ksyun_iam_policy_document.foo.json
+}
+```
+*/
+
+package ksyun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunIamPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunIamPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "2015-11-01",
+				Description: "The version of the policy document.",
+			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: "A list of JSON policy documents whose statements are merged in order.",
+			},
+			"statement": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The statements of the policy document, they override the statements of the source documents with the same `sid`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the statement.",
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Allow",
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+							Description:  "Whether the statement allows or denies the actions. Valid Values: 'Allow', 'Deny'. Default is 'Allow'.",
+						},
+						"actions": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The actions that the statement applies to, such as `iam:ListUsers`.",
+						},
+						"not_actions": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The actions that the statement doesn't apply to.",
+						},
+						"resources": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The KRNs of the resources that the statement applies to.",
+						},
+						"not_resources": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The KRNs of the resources that the statement doesn't apply to.",
+						},
+						"principals": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The principals that the statement applies to, it's used by the resource based policies such as the KS3 bucket policy.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The type of the principals, such as `KSC`.",
+									},
+									"identifiers": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "The identifiers of the principals, such as `krn:ksc:iam::2000000001:root`.",
+									},
+								},
+							},
+						},
+						"condition": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The conditions of the statement.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"test": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The condition operator, such as `IpAddress` and `StringEquals`.",
+									},
+									"variable": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The condition key, such as `ksc:SourceIp`.",
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The values of the condition.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy document in the canonical JSON.",
+			},
+		},
+	}
+}
+
+// iamPolicyStrings is a list of strings in the policy document, it's a single value or an array in JSON.
+// The numbers and the booleans, such as the values of NumericLessThan and Bool conditions, are kept as strings.
+type iamPolicyStrings []string
+
+func (s *iamPolicyStrings) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return err
+	}
+	values, ok := v.([]interface{})
+	if !ok {
+		values = []interface{}{v}
+	}
+	list := make(iamPolicyStrings, 0, len(values))
+	for _, value := range values {
+		switch value.(type) {
+		case string, json.Number, bool:
+			list = append(list, fmt.Sprint(value))
+		default:
+			return fmt.Errorf("the value %s of the policy document must be a string, a number or a boolean", b)
+		}
+	}
+	*s = list
+	return nil
+}
+
+type iamPolicyStatement struct {
+	Sid         string                                 `json:"Sid,omitempty"`
+	Effect      string                                 `json:"Effect"`
+	Principal   map[string]iamPolicyStrings            `json:"Principal,omitempty"`
+	Action      iamPolicyStrings                       `json:"Action,omitempty"`
+	NotAction   iamPolicyStrings                       `json:"NotAction,omitempty"`
+	Resource    iamPolicyStrings                       `json:"Resource,omitempty"`
+	NotResource iamPolicyStrings                       `json:"NotResource,omitempty"`
+	Condition   map[string]map[string]iamPolicyStrings `json:"Condition,omitempty"`
+}
+
+// iamPolicyStatements is the statements of the policy document, it's a single statement or an array in JSON
+type iamPolicyStatements []*iamPolicyStatement
+
+func (s *iamPolicyStatements) UnmarshalJSON(b []byte) error {
+	if strings.HasPrefix(strings.TrimSpace(string(b)), "{") {
+		single := &iamPolicyStatement{}
+		if err := strictJsonUnmarshal(b, single); err != nil {
+			return err
+		}
+		*s = iamPolicyStatements{single}
+		return nil
+	}
+	var list []*iamPolicyStatement
+	if err := strictJsonUnmarshal(b, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// strictJsonUnmarshal doesn't allow the unknown fields, or they would be lost in the canonical JSON
+func strictJsonUnmarshal(b []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+type iamPolicyDocument struct {
+	Version   string              `json:"Version"`
+	Statement iamPolicyStatements `json:"Statement"`
+}
+
+// merge adds the statement, or replaces the statement with the same sid in place
+func (doc *iamPolicyDocument) merge(statement *iamPolicyStatement) {
+	if statement.Sid != "" {
+		for i, v := range doc.Statement {
+			if v.Sid == statement.Sid {
+				doc.Statement[i] = statement
+				return
+			}
+		}
+	}
+	doc.Statement = append(doc.Statement, statement)
+}
+
+// normalize sorts the values of the statement, which makes the JSON canonical
+func (statement *iamPolicyStatement) normalize() {
+	for _, v := range []iamPolicyStrings{statement.Action, statement.NotAction, statement.Resource, statement.NotResource} {
+		sort.Strings(v)
+	}
+	for _, v := range statement.Principal {
+		sort.Strings(v)
+	}
+	for _, condition := range statement.Condition {
+		for _, v := range condition {
+			sort.Strings(v)
+		}
+	}
+}
+
+func iamPolicyStringList(v interface{}) iamPolicyStrings {
+	var result iamPolicyStrings
+	switch v := v.(type) {
+	case *schema.Set:
+		result = SchemaSetToStringSlice(v)
+	case []interface{}:
+		for _, item := range v {
+			result = append(result, item.(string))
+		}
+	}
+	return result
+}
+
+func expandIamPolicyStatement(m map[string]interface{}) *iamPolicyStatement {
+	statement := &iamPolicyStatement{
+		Sid:         m["sid"].(string),
+		Effect:      m["effect"].(string),
+		Action:      iamPolicyStringList(m["actions"]),
+		NotAction:   iamPolicyStringList(m["not_actions"]),
+		Resource:    iamPolicyStringList(m["resources"]),
+		NotResource: iamPolicyStringList(m["not_resources"]),
+	}
+	for _, v := range m["principals"].(*schema.Set).List() {
+		principal := v.(map[string]interface{})
+		if statement.Principal == nil {
+			statement.Principal = make(map[string]iamPolicyStrings)
+		}
+		key := principal["type"].(string)
+		statement.Principal[key] = append(statement.Principal[key], iamPolicyStringList(principal["identifiers"])...)
+	}
+	for _, v := range m["condition"].(*schema.Set).List() {
+		condition := v.(map[string]interface{})
+		if statement.Condition == nil {
+			statement.Condition = make(map[string]map[string]iamPolicyStrings)
+		}
+		test := condition["test"].(string)
+		if statement.Condition[test] == nil {
+			statement.Condition[test] = make(map[string]iamPolicyStrings)
+		}
+		statement.Condition[test][condition["variable"].(string)] = iamPolicyStringList(condition["values"])
+	}
+	return statement
+}
+
+func dataSourceKsyunIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := &iamPolicyDocument{
+		Version:   d.Get("version").(string),
+		Statement: make(iamPolicyStatements, 0),
+	}
+	for i, v := range d.Get("source_policy_documents").([]interface{}) {
+		source := &iamPolicyDocument{}
+		if err := strictJsonUnmarshal([]byte(v.(string)), source); err != nil {
+			return fmt.Errorf("error on parsing source_policy_documents.%d, %s", i, err)
+		}
+		for _, statement := range source.Statement {
+			statement.normalize()
+			doc.merge(statement)
+		}
+	}
+
+	sids := make(map[string]bool)
+	for _, v := range d.Get("statement").([]interface{}) {
+		statement := expandIamPolicyStatement(v.(map[string]interface{}))
+		statement.normalize()
+		if statement.Sid != "" {
+			if sids[statement.Sid] {
+				return fmt.Errorf("the sid %q of the statements must be unique", statement.Sid)
+			}
+			sids[statement.Sid] = true
+		}
+		doc.merge(statement)
+	}
+
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	policy := string(b)
+	if err = d.Set("json", policy); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%d", schema.HashString(policy)))
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		return writeToFile(output.(string), policy)
+	}
+	return nil
+}
+
+// normalizeIamPolicyDocument returns the canonical JSON of the policy document
+func normalizeIamPolicyDocument(policy string) (string, error) {
+	doc := &iamPolicyDocument{}
+	if err := strictJsonUnmarshal([]byte(policy), doc); err != nil {
+		return "", err
+	}
+	for _, statement := range doc.Statement {
+		statement.normalize()
+	}
+	b, err := json.Marshal(doc)
+	return string(b), err
+}
@@ -0,0 +1,181 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testIamPolicyDocumentExpected = `{
+  "Version": "2015-11-01",
+  "Statement": [
+    {
+      "Sid": "List",
+      "Effect": "Deny",
+      "Action": [
+        "iam:ListRoles",
+        "iam:ListUsers"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Sid": "Source",
+      "Effect": "Allow",
+      "Action": [
+        "iam:GetRole"
+      ],
+      "Resource": [
+        "*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "KSC": [
+          "krn:ksc:iam::2000000001:root",
+          "krn:ksc:iam::2000000002:root"
+        ]
+      },
+      "Action": [
+        "ks3:GetObject"
+      ],
+      "Resource": [
+        "krn:ksc:ks3:::bucket/*"
+      ],
+      "Condition": {
+        "IpAddress": {
+          "ksc:SourceIp": [
+            "10.0.0.0/8",
+            "192.168.0.0/16"
+          ]
+        }
+      }
+    }
+  ]
+}`
+
+func TestUnitKsyunIamPolicyDocumentDataSource_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_iam_policy_document" "source" {
+  statement {
+    sid       = "List"
+    actions   = ["iam:ListUsers"]
+    resources = ["*"]
+  }
+}
+
+data "ksyun_iam_policy_document" "foo" {
+  source_policy_documents = [
+    data.ksyun_iam_policy_document.source.json,
+    "{\"Statement\": {\"Sid\": \"Source\", \"Effect\": \"Allow\", \"Action\": \"iam:GetRole\", \"Resource\": \"*\"}}",
+  ]
+
+  statement {
+    sid       = "List"
+    effect    = "Deny"
+    actions   = ["iam:ListUsers", "iam:ListRoles"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["ks3:GetObject"]
+    resources = ["krn:ksc:ks3:::bucket/*"]
+
+    principals {
+      type        = "KSC"
+      identifiers = ["krn:ksc:iam::2000000002:root", "krn:ksc:iam::2000000001:root"]
+    }
+
+    condition {
+      test     = "IpAddress"
+      variable = "ksc:SourceIp"
+      values   = ["192.168.0.0/16", "10.0.0.0/8"]
+    }
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ksyun_iam_policy_document.foo", "json", testIamPolicyDocumentExpected),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyDocumentDiffSuppressFunc(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Resource":["*"]}]}`,
+			new:      "{\n  \"Statement\": [{\"Resource\": [\"*\"], \"Action\": [\"iam:List*\"], \"Effect\": \"Allow\"}],\n  \"Version\": \"2015-11-01\"\n}",
+			suppress: true,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Resource":["b","a"]}]}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Resource":["a","b"]}]}`,
+			suppress: true,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Resource":["*"]}]}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Deny","Action":["iam:List*"],"Resource":["*"]}]}`,
+			suppress: false,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Id":"a","Statement":[{"Effect":"Allow","Action":"iam:List*"}]}`,
+			new:      `{"Version":"2015-11-01","Id":"b","Statement":[{"Effect":"Allow","Action":["iam:List*"]}]}`,
+			suppress: false,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":{"Effect":"Allow","Action":"iam:List*","Unknown":"a"}}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Unknown":"b"}]}`,
+			suppress: false,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Condition":{"NumericLessThan":{"krn:RequestCount":10}}}]}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Condition":{"NumericLessThan":{"krn:RequestCount":["10"]}}}]}`,
+			suppress: true,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Condition":{"NumericLessThan":{"krn:RequestCount":10}}}]}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Condition":{"NumericLessThan":{"krn:RequestCount":20}}}]}`,
+			suppress: false,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Condition":{"Bool":{"krn:SecureTransport":true}}}]}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Condition":{"Bool":{"krn:SecureTransport":["true"]}}}]}`,
+			suppress: true,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Condition":{"Bool":{"krn:SecureTransport":true}}}]}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":["iam:List*"],"Condition":{"Bool":{"krn:SecureTransport":false}}}]}`,
+			suppress: false,
+		},
+		{
+			old:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Condition":{"Bool":{"krn:SecureTransport":{"a":true}}}}]}`,
+			new:      `{"Version":"2015-11-01","Statement":[{"Effect":"Allow","Action":"iam:List*","Condition":{"Bool":{"krn:SecureTransport":{"a":"true"}}}}]}`,
+			suppress: false,
+		},
+		{
+			old:      "",
+			new:      `{"Version":"2015-11-01","Statement":[]}`,
+			suppress: false,
+		},
+	}
+	for i, c := range cases {
+		if got := policyDocumentDiffSuppressFunc("policy", c.old, c.new, nil); got != c.suppress {
+			t.Errorf("case %d: expected suppress %t, got %t", i, c.suppress, got)
+		}
+	}
+}
@@ -29,8 +29,8 @@ This data source providers a list of instance resources according to their avail
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceKsyunInstances() *schema.Resource {
@@ -183,7 +183,6 @@ func dataSourceKsyunInstances() *schema.Resource {
 						"instance_configure": {
 							Type:        schema.TypeList,
 							Computed:    true,
-							MaxItems:    1,
 							Description: "the configure of the instance.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -234,7 +233,6 @@ func dataSourceKsyunInstances() *schema.Resource {
 						"instance_state": {
 							Type:        schema.TypeList,
 							Computed:    true,
-							MaxItems:    1,
 							Description: "state of the instance.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -264,7 +262,6 @@ func dataSourceKsyunInstances() *schema.Resource {
 						"monitoring": {
 							Type:        schema.TypeList,
 							Computed:    true,
-							MaxItems:    1,
 							Description: "state of the monitoring.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -326,7 +323,6 @@ func dataSourceKsyunInstances() *schema.Resource {
 									"security_group_set": {
 										Type:        schema.TypeList,
 										Computed:    true,
-										MaxItems:    1,
 										Description: "a list of the security group.",
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
@@ -341,7 +337,6 @@ func dataSourceKsyunInstances() *schema.Resource {
 									"group_set": {
 										Type:        schema.TypeList,
 										Computed:    true,
-										MaxItems:    1,
 										Description: "a list of the security group.",
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
@@ -380,7 +375,6 @@ func dataSourceKsyunInstances() *schema.Resource {
 						"system_disk": {
 							Type:        schema.TypeList,
 							Computed:    true,
-							MaxItems:    1,
 							Description: "System disk information.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
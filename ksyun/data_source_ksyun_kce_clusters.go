@@ -15,7 +15,7 @@ This data source providers a list of kce cluster resources according to their in
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKceClusters() *schema.Resource {
@@ -14,7 +14,7 @@ This data source providers a list of available instance image which support kce.
 
 package ksyun
 
-import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceKsyunKceInstanceImages() *schema.Resource {
 	return &schema.Resource{
@@ -0,0 +1,100 @@
+/*
+This data source provides a list of kce node pools of a cluster.
+
+# Example Usage
+
+```hcl
+data "ksyun_kce_node_pools" "default" {
+  cluster_id  = "67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx"
+  name_regex  = "tf-node-pool"
+  output_file = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// computedSchema returns a copy of the resource schema with all the fields computed
+func computedSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(m))
+	for k, v := range m {
+		s := &schema.Schema{
+			Type:        v.Type,
+			Computed:    true,
+			Description: v.Description,
+		}
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			s.Elem = &schema.Resource{Schema: computedSchema(elem.Schema)}
+		case *schema.Schema:
+			s.Elem = &schema.Schema{Type: elem.Type}
+		}
+		if v.Type == schema.TypeSet {
+			s.Set = v.Set
+		}
+		result[k] = s
+	}
+	return result
+}
+
+func dataSourceKsyunKceNodePools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKceNodePoolsRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the kce cluster.",
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of node pool IDs.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by node pool name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of node pools that satisfy the condition.",
+			},
+			"node_pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of node pools.",
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						r := resourceKsyunKceNodePool()
+						m := computedSchema(r.Schema)
+						delete(m, "instance_delete_mode")
+						delete(m["node_template"].Elem.(*schema.Resource).Schema, "instance_password")
+						return m
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKceNodePoolsRead(d *schema.ResourceData, meta interface{}) error {
+	kceService := KceService{meta.(*KsyunClient)}
+	return kceService.ReadAndSetKceNodePools(d, dataSourceKsyunKceNodePools())
+}
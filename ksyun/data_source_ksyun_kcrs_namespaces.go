@@ -14,7 +14,7 @@ data "ksyun_kcrs_namespaces" "foo" {
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKcrsNamespaces() *schema.Resource {
@@ -14,7 +14,7 @@ data "ksyun_kcrs_tokens" "foo" {
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKcrsTokens() *schema.Resource {
@@ -15,7 +15,7 @@ data "ksyun_kcrs_webhook_triggers" "foo" {
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKcrsWebhookTriggers() *schema.Resource {
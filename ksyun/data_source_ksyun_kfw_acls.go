@@ -16,7 +16,7 @@ This data source provides a list of Cloud Firewall ACL Rule resources according
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKfwAcls() *schema.Resource {
@@ -16,7 +16,7 @@ This data source provides a list of Cloud Firewall Address Book resources accord
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKfwAddrbooks() *schema.Resource {
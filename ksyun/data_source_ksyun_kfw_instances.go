@@ -15,7 +15,7 @@ This data source provides a list of Cloud Firewall Instance resources according
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKfwInstances() *schema.Resource {
@@ -0,0 +1,142 @@
+/*
+This data source provides a list of KLog log pools of a project.
+
+# Example Usage
+
+```hcl
+data "ksyun_klog_log_pools" "default" {
+  project_name = "tf-klog-project"
+  name_regex   = "^tf-"
+  output_file  = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunKlogLogPools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKlogLogPoolsRead,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the project.",
+			},
+			"log_pool_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the log pool to search.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by log pool name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of log pools that satisfy the condition.",
+			},
+			"log_pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of log pools.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the log pool.",
+						},
+						"log_pool_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the log pool.",
+						},
+						"log_pool_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the log pool.",
+						},
+						"project_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the project.",
+						},
+						"retention_days": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The days to keep the logs.",
+						},
+						"partitions": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the partitions of the log pool.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the log pool.",
+						},
+						"scene": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The scene of the log pool.",
+						},
+						"web_tracking": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the web tracking is enabled.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of creation of the log pool.",
+						},
+						"update_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of the last update of the log pool.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tags of the log pool.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The key of the tag.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The value of the tag.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKlogLogPoolsRead(d *schema.ResourceData, meta interface{}) error {
+	klogLogPoolService := KlogLogPoolService{meta.(*KsyunClient)}
+	return klogLogPoolService.ReadAndSetLogPools(d, dataSourceKsyunKlogLogPools())
+}
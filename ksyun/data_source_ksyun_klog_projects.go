@@ -18,7 +18,7 @@ This data source provides a list of KLOG projects.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKlogProjects() *schema.Resource {
@@ -38,12 +38,12 @@ func dataSourceKsyunKlogProjects() *schema.Resource {
 			"page": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Page number start from 0.",
+				Description: "Page number start from 0. If it's not set, the projects of all pages are returned.",
 			},
 			"size": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Page size, 1 - 500.",
+				Description: "Page size, 1 - 500. It's also the size of each request when the page is not set.",
 			},
 			"total_count": {
 				Type:        schema.TypeInt,
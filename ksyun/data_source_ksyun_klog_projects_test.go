@@ -1,8 +1,10 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunKlogProjectsDataSource_basic(t *testing.T) {
@@ -10,7 +12,7 @@ func TestAccKsyunKlogProjectsDataSource_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataKlogProjectsConfig,
@@ -22,6 +24,45 @@ func TestAccKsyunKlogProjectsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestUnitKsyunKlogProjectsDataSource_pages(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitDataKlogProjectsResources,
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitDataKlogProjectsResources + `
+data "ksyun_klog_projects" "all" {
+  project_name = "tf-unit-project"
+  size         = 2
+}
+
+data "ksyun_klog_projects" "page" {
+  project_name = "tf-unit-project"
+  page         = 1
+  size         = 2
+}
+
+data "ksyun_klog_projects" "first_page" {
+  project_name = "tf-unit-project"
+  page         = 0
+  size         = 2
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ksyun_klog_projects.all", "total_count", "3"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_projects.page", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_projects.first_page", "total_count", "2"),
+				),
+			},
+		},
+	})
+}
+
 const testAccDataKlogProjectsConfig = `
 
 data "ksyun_klog_projects" "foo" {
@@ -29,3 +70,10 @@ data "ksyun_klog_projects" "foo" {
   output_file = "output_result"
 }
 `
+
+const testUnitDataKlogProjectsResources = `
+resource "ksyun_klog_project" "foo" {
+  count        = 3
+  project_name = "tf-unit-project-${count.index}"
+}
+`
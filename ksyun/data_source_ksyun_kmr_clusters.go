@@ -44,7 +44,7 @@ Provides a KMR Clusters data source.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKmrClusters() *schema.Resource {
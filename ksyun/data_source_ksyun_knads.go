@@ -17,7 +17,7 @@ This data source provides a list of KNAD resources  according to their KNAD ID.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKnads() *schema.Resource {
@@ -15,7 +15,7 @@ Query KPFS client installation package information and mount IP by file system I
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKpfsClientInstall() *schema.Resource {
@@ -19,7 +19,7 @@ Query available storage cluster information by UID.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKpfsClusters() *schema.Resource {
@@ -15,7 +15,7 @@ This data source provides a list of kpfs fileSystem resources according to their
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunKpfsFileSystems() *schema.Resource {
@@ -0,0 +1,72 @@
+/*
+This data source provides a list of the accounts of a krds instance.
+
+# Example Usage
+
+```hcl
+data "ksyun_krds_accounts" "default" {
+  db_instance_identifier = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  name_regex             = "app_*"
+  output_file            = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunKrdsAccounts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKrdsAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the krds instance.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by account name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of accounts that satisfy the condition.",
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of accounts.",
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						m := computedSchema(resourceKsyunKrdsAccount().Schema)
+						delete(m, "db_instance_identifier")
+						delete(m, "account_password")
+						return m
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKrdsAccountsRead(d *schema.ResourceData, meta interface{}) (err error) {
+	err = readAndSetKrdsAccounts(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading krds accounts, error is %s", err)
+	}
+	return err
+}
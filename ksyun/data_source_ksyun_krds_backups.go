@@ -0,0 +1,80 @@
+/*
+This data source provides a list of the backups of a krds instance.
+
+# Example Usage
+
+```hcl
+data "ksyun_krds_backups" "default" {
+  db_instance_identifier = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  backup_mode            = "Manual"
+  output_file            = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunKrdsBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKrdsBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the krds instance.",
+			},
+			"backup_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Manual",
+					"Auto",
+				}, false),
+				Description: "The mode of the backups. Valid values: Manual, Auto.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by backup name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of backups that satisfy the condition.",
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of backups.",
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						m := computedSchema(resourceKsyunKrdsBackup().Schema)
+						delete(m, "db_instance_identifier")
+						return m
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKrdsBackupsRead(d *schema.ResourceData, meta interface{}) (err error) {
+	err = readAndSetKrdsBackups(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading krds backups, error is %s", err)
+	}
+	return err
+}
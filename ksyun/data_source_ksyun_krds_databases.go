@@ -0,0 +1,71 @@
+/*
+This data source provides a list of the databases of a krds instance.
+
+# Example Usage
+
+```hcl
+data "ksyun_krds_databases" "default" {
+  db_instance_identifier = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  name_regex             = "app*"
+  output_file            = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunKrdsDatabases() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKrdsDatabasesRead,
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the krds instance.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by database name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of databases that satisfy the condition.",
+			},
+			"databases": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of databases.",
+				Elem: &schema.Resource{
+					Schema: func() map[string]*schema.Schema {
+						m := computedSchema(resourceKsyunKrdsDatabase().Schema)
+						delete(m, "db_instance_identifier")
+						return m
+					}(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKrdsDatabasesRead(d *schema.ResourceData, meta interface{}) (err error) {
+	err = readAndSetKrdsDatabases(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading krds databases, error is %s", err)
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+/*
+This data source provides the metadata of a KS3 object, and the content of it if it's a text.
+
+# Example Usage
+
+```hcl
+data "ksyun_ks3_object" "config" {
+  bucket = "bucket-20240206-104450"
+  key    = "config/app.json"
+}
+
+output "config" {
+  value = jsondecode(data.ksyun_ks3_object.config.body)
+}
+```
+*/
+
+package ksyun
+
+import (
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
+)
+
+// ks3TextContentTypes are the content types whose content is returned as the body
+var ks3TextContentTypes = regexp.MustCompile(`^(text/.+|application/(json|xml|javascript|x-sh|x-yaml|yaml)|.+\+(json|xml))$`)
+
+func dataSourceKsyunKs3Object() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKs3ObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of the object.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content of the object, it's only available for the text objects, such as text/*, application/json and application/xml.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MIME type of the object.",
+			},
+			"content_length": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the object in bytes.",
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The etag of the object.",
+			},
+			"last_modified": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modified time of the object.",
+			},
+			"storage_class": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The storage class of the object.",
+			},
+			"server_side_encryption": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The server-side encryption algorithm of the object.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The user metadata of the object.",
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKs3ObjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*KsyunClient)
+	ks3Service := Ks3Service{client}
+	bucketName := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	header, err := ks3Service.DescribeKs3Object(bucketName, key)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "ksyun_ks3_object", "GetObjectDetailedMeta", KsyunKs3GoSdk)
+	}
+
+	contentType := header.Get(ks3.HTTPHeaderContentType)
+	body := ""
+	if ks3TextContentTypes.MatchString(strings.Split(contentType, ";")[0]) {
+		raw, err := client.WithKs3BucketByName(bucketName, func(bucket *ks3.Bucket) (interface{}, error) {
+			reader, err := bucket.GetObject(key)
+			if err != nil {
+				return nil, err
+			}
+			defer reader.Close()
+			return ioutil.ReadAll(reader)
+		})
+		if err != nil {
+			return WrapErrorf(err, DataDefaultErrorMsg, "ksyun_ks3_object", "GetObject", KsyunKs3GoSdk)
+		}
+		addDebug("GetObject", contentType, map[string]string{"bucketName": bucketName, "key": key})
+		body = string(raw.([]byte))
+	}
+
+	d.SetId(AssembleIds(bucketName, key))
+	d.Set("body", body)
+	object := flattenKs3ObjectHeader(header)
+	for k, v := range object {
+		if err := d.Set(k, v); err != nil {
+			return WrapError(err)
+		}
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		return writeToFile(output.(string), object)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+This data source provides a list of KS3 objects under the prefix of a bucket.
+
+# Example Usage
+
+```hcl
+data "ksyun_ks3_objects" "scripts" {
+  bucket      = "bucket-20240206-104450"
+  prefix      = "scripts/"
+  delimiter   = "/"
+  output_file = "objects.json"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunKs3Objects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunKs3ObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket.",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The prefix of the keys to list.",
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The character to group the keys, the keys between the prefix and the first delimiter are returned as `common_prefixes`.",
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The max number of the objects to return, all the objects under the prefix are returned if it's not set.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of the objects.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys of the objects.",
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The common prefixes grouped by the delimiter.",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of the objects.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key of the object.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the object in bytes.",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The etag of the object.",
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The storage class of the object.",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The last modified time of the object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunKs3ObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	ks3Service := Ks3Service{meta.(*KsyunClient)}
+	bucketName := d.Get("bucket").(string)
+	objects, commonPrefixes, err := ks3Service.ListKs3Objects(bucketName, d.Get("prefix").(string),
+		d.Get("delimiter").(string), d.Get("max_keys").(int))
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "ksyun_ks3_objects", "ListObjects", KsyunKs3GoSdk)
+	}
+
+	keys := make([]string, 0, len(objects))
+	s := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		keys = append(keys, object.Key)
+		s = append(s, map[string]interface{}{
+			"key":           object.Key,
+			"size":          int(object.Size),
+			"etag":          trimKs3Etag(object.ETag),
+			"storage_class": object.StorageClass,
+			"last_modified": object.LastModified.Format(time.RFC3339),
+		})
+	}
+	if commonPrefixes == nil {
+		commonPrefixes = []string{}
+	}
+
+	d.SetId(dataResourceIdHash(append([]string{bucketName}, keys...)))
+	d.Set("total_count", len(objects))
+	d.Set("keys", keys)
+	d.Set("common_prefixes", commonPrefixes)
+	if err := d.Set("objects", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		return writeToFile(output.(string), s)
+	}
+	return nil
+}
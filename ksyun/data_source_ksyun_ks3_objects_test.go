@@ -0,0 +1,28 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKsyunKs3ObjectsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKs3ObjectConfig("echo hello", "private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_ks3_objects.foo"),
+					resource.TestCheckResourceAttr("data.ksyun_ks3_objects.foo", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_ks3_objects.foo", "keys.0", "scripts/bootstrap.sh"),
+					resource.TestCheckResourceAttr("data.ksyun_ks3_object.foo", "body", "echo hello"),
+					resource.TestCheckResourceAttrPair("data.ksyun_ks3_object.foo", "etag", "ksyun_ks3_object.foo", "etag"),
+				),
+			},
+		},
+	})
+}
@@ -15,7 +15,7 @@ This data source provides a list of Load Balancer Rule resources according to th
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunSlbAcls() *schema.Resource {
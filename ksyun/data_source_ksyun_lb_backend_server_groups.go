@@ -14,7 +14,7 @@ ids=[]
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunBackendServerGroups() *schema.Resource {
@@ -101,7 +101,6 @@ func dataSourceKsyunBackendServerGroups() *schema.Resource {
 						},
 						"health_check": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Description: "Health check information, only the mirror server has this parameter.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -16,7 +16,7 @@ Provides a list of lb host headers in the current region.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunListenerHostHeaders() *schema.Resource {
@@ -19,7 +19,7 @@ Provides a Load Balancer Listener server resource.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunLbListenerServers() *schema.Resource {
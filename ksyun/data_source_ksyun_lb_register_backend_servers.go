@@ -16,7 +16,7 @@ Provides a list of register backend servers in the current region.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunRegisterBackendServers() *schema.Resource {
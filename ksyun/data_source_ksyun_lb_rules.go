@@ -16,7 +16,7 @@ Provides a list of ksyun lb rules resources in the current region.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunSlbRules() *schema.Resource {
@@ -95,7 +95,6 @@ func dataSourceKsyunSlbRules() *schema.Resource {
 
 						"health_check": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Description: "A list of health checks.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -150,7 +149,6 @@ func dataSourceKsyunSlbRules() *schema.Resource {
 						},
 						"session": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Computed:    true,
 							Description: "session configuration.",
 							Elem: &schema.Resource{
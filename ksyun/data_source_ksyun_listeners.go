@@ -16,8 +16,8 @@ This data source provides a list of Load Balancer Listener resources according t
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceKsyunListeners() *schema.Resource {
@@ -145,7 +145,6 @@ func dataSourceKsyunListeners() *schema.Resource {
 
 						"health_check": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Description: "Health check configuration. It is a nested type which documented below.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -200,7 +199,6 @@ func dataSourceKsyunListeners() *schema.Resource {
 						},
 						"session": {
 							Type:        schema.TypeList,
-							MaxItems:    1,
 							Computed:    true,
 							Description: "session configuration.",
 							Elem: &schema.Resource{
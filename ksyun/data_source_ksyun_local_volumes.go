@@ -14,7 +14,7 @@ This data source provides a list of kec local volumes in the current region.
 
 package ksyun
 
-import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func dataSourceKsyunLocalVolumes() *schema.Resource {
 	return &schema.Resource{
@@ -0,0 +1,111 @@
+/*
+This data source provides a list of Monitor alarm contacts.
+
+# Example Usage
+
+```hcl
+data "ksyun_monitor_alarm_contacts" "default" {
+  contact_group_ids = ["4423"]
+  name_regex        = "^ops"
+  output_file       = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunMonitorAlarmContacts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunMonitorAlarmContactsRead,
+		Schema: map[string]*schema.Schema{
+			"contact_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of contact group IDs, only the contacts in the groups are returned.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by contact name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of contacts that satisfy the condition.",
+			},
+			"contacts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of contacts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the contact.",
+						},
+						"contact_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the contact.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email of the contact.",
+						},
+						"phone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The phone number of the contact.",
+						},
+						"status": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The status of the contact, 1: enabled, 0: disabled.",
+						},
+						"contact_groups": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The contact groups the contact belongs to.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"contact_group_id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The ID of the contact group.",
+									},
+									"group_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the contact group.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunMonitorAlarmContactsRead(d *schema.ResourceData, meta interface{}) error {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	return monitorService.ReadAndSetAlarmContacts(d, dataSourceKsyunMonitorAlarmContacts())
+}
@@ -0,0 +1,90 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunMonitorAlarmContactsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "ksyun_monitor_alarm_contacts" "foo" {
+  output_file = "output_result"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_monitor_alarm_contacts.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunMonitorAlarmContactsDataSource_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitDataMonitorAlarmContactsResources,
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitDataMonitorAlarmContactsResources + `
+data "ksyun_monitor_alarm_contacts" "all" {}
+
+data "ksyun_monitor_alarm_contacts" "ops" {
+  contact_group_ids = [ksyun_monitor_alarm_contact_group.ops.id]
+}
+
+data "ksyun_monitor_alarm_contacts" "name_regex" {
+  name_regex = "-1$"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.all", "total_count", "3"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.ops", "total_count", "1"),
+					resource.TestCheckResourceAttrPair("data.ksyun_monitor_alarm_contacts.ops", "contacts.0.id",
+						"ksyun_monitor_alarm_contact.ops", "id"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.ops", "contacts.0.contact_name", "tf-unit-ops"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.ops", "contacts.0.email", "ops@example.com"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.ops", "contacts.0.status", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.ops", "contacts.0.contact_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.ops", "contacts.0.contact_groups.0.group_name", "tf-unit-ops"),
+					resource.TestCheckResourceAttrPair("data.ksyun_monitor_alarm_contacts.ops", "contacts.0.contact_groups.0.contact_group_id",
+						"ksyun_monitor_alarm_contact_group.ops", "id"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_contacts.name_regex", "total_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testUnitDataMonitorAlarmContactsResources = `
+resource "ksyun_monitor_alarm_contact_group" "ops" {
+  group_name = "tf-unit-ops"
+}
+
+resource "ksyun_monitor_alarm_contact" "ops" {
+  contact_name      = "tf-unit-ops"
+  email             = "ops@example.com"
+  phone             = "13800000000"
+  contact_group_ids = [ksyun_monitor_alarm_contact_group.ops.id]
+}
+
+resource "ksyun_monitor_alarm_contact" "foo" {
+  count        = 2
+  contact_name = "tf-unit-contact-${count.index}"
+  email        = "dev@example.com"
+  phone        = "13900000000"
+}
+`
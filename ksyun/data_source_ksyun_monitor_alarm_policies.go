@@ -0,0 +1,116 @@
+/*
+This data source provides a list of Monitor alarm policies.
+
+# Example Usage
+
+```hcl
+data "ksyun_monitor_alarm_policies" "default" {
+  name_regex  = "^tf-"
+  output_file = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunMonitorAlarmPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunMonitorAlarmPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of alarm policy IDs.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by policy name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of alarm policies that satisfy the condition.",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of alarm policies.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the alarm policy.",
+						},
+						"policy_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the alarm policy.",
+						},
+						"policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the alarm policy.",
+						},
+						"product_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The cloud service category of the alarm policy.",
+						},
+						"policy_type": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The type of the alarm policy, 0: Normal policy, 1: Default policy.",
+						},
+						"enabled": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Whether the alarm policy is enabled, 1: enabled, 0: disabled.",
+						},
+						"instance_info_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the instances bound to the alarm policy.",
+						},
+						"trigger_rule_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the trigger rules.",
+						},
+						"contact_info_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of the contacts notified.",
+						},
+						"callback_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL called back when the alarm is triggered.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunMonitorAlarmPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	return monitorService.ReadAndSetAlarmPolicies(d, dataSourceKsyunMonitorAlarmPolicies())
+}
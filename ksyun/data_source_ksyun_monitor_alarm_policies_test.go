@@ -0,0 +1,106 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunMonitorAlarmPoliciesDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "ksyun_monitor_alarm_policies" "foo" {
+  output_file = "output_result"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_monitor_alarm_policies.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunMonitorAlarmPoliciesDataSource_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitDataMonitorAlarmPoliciesResources,
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitDataMonitorAlarmPoliciesResources + `
+data "ksyun_monitor_alarm_policies" "all" {}
+
+data "ksyun_monitor_alarm_policies" "ids" {
+  ids = [ksyun_monitor_alarm_policy.foo[0].id]
+}
+
+data "ksyun_monitor_alarm_policies" "name_regex" {
+  name_regex = "^tf-unit-policy-[12]$"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_policies.all", "total_count", "3"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_policies.ids", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_policies.ids", "policies.0.policy_name", "tf-unit-policy-0"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_policies.ids", "policies.0.trigger_rule_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_policies.ids", "policies.0.contact_info_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_policies.ids", "policies.0.callback_url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttrPair("data.ksyun_monitor_alarm_policies.ids", "policies.0.id",
+						"ksyun_monitor_alarm_policy.foo.0", "id"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_alarm_policies.name_regex", "total_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testUnitDataMonitorAlarmPoliciesResources = `
+resource "ksyun_monitor_alarm_contact" "foo" {
+  contact_name = "tf-unit-contact"
+  email        = "ops@example.com"
+  phone        = "13800000000"
+}
+
+resource "ksyun_monitor_alarm_policy" "foo" {
+  count              = 3
+  policy_name        = "tf-unit-policy-${count.index}"
+  product_type       = 0
+  policy_type        = 0
+  resource_bind_type = 3
+
+  trigger_rules {
+    compare       = ">"
+    effect_bt     = "00:00"
+    effect_et     = "23:59"
+    interval      = 5
+    item_key      = "cpu.utilizition.total"
+    item_name     = "CPU"
+    max_count     = 3
+    method        = "avg"
+    period        = "5m"
+    points        = 2
+    trigger_value = "90"
+    units         = "%"
+  }
+
+  user_notice {
+    contact_way  = 2
+    contact_flag = 2
+    contact_id   = ksyun_monitor_alarm_contact.foo.id
+  }
+
+  url_notice = ["https://example.com/webhook"]
+}
+`
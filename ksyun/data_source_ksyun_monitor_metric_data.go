@@ -0,0 +1,183 @@
+/*
+This data source provides the aggregated values of a Monitor metric of instances,
+it can be used to check the usage of the instances at plan time.
+
+~> **NOTE:** The metric statistics are queried with the `GetMetricStatisticsBatch` api of the 2018-11-14 Monitor api version,
+the 2021-01-01 api version has no action to query the metric data.
+
+# Example Usage
+
+```hcl
+data "ksyun_monitor_metric_data" "memory" {
+  namespace         = "KCS"
+  instance_ids      = ["your redis instance id"]
+  metric_name       = "redis.memory.usage"
+  period            = 300
+  statistic         = "Max"
+  last_minutes      = 1440
+  max_allowed_value = 80
+}
+
+output "memory_usage" {
+  value = data.ksyun_monitor_metric_data.memory.max_value
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunMonitorMetricData() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunMonitorMetricDataRead,
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The namespace of the cloud service, such as `KEC`.",
+			},
+			"instance_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A list of instance IDs to query.",
+			},
+			"metric_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the metric.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(60),
+				Description:  "The aggregation period of the datapoints in seconds.",
+			},
+			"statistic": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Average",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Average",
+					"Max",
+					"Min",
+				}, false),
+				Description: "The statistic of the datapoints, valid values: `Average`, `Max`, `Min`. The value of an instance is the average of the averages, the max of the maximums or the min of the minimums of its datapoints.",
+			},
+			"start_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"last_minutes"},
+				Description:   "The start of the time window in RFC3339 format, such as `2021-01-01T00:00:00Z`.",
+			},
+			"end_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"last_minutes"},
+				Description:   "The end of the time window in RFC3339 format. It must be set together with `start_time`, defaults to now.",
+			},
+			"last_minutes": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Default:       60,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"start_time", "end_time"},
+				Description:   "The length of the time window ending now in minutes, used when `start_time` is not set.",
+			},
+			"fail_on_missing_data": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to fail when an instance has no datapoints in the time window. If false, the instances are listed in `missing_instance_ids`.",
+			},
+			"max_allowed_value": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "If set, fail when the value of any instance is above it.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of instances that have datapoints.",
+			},
+			"max_value": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The max value of the instances.",
+			},
+			"min_value": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The min value of the instances.",
+			},
+			"missing_instance_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The IDs of the instances which have no datapoints in the time window.",
+			},
+			"data": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of the metric data of the instances.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						"value": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The aggregated value of the datapoints.",
+						},
+						"datapoints": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The datapoints of the statistic.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timestamp": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The time of the datapoint.",
+									},
+									"value": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The value of the datapoint.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunMonitorMetricDataRead(d *schema.ResourceData, meta interface{}) error {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	return monitorService.ReadAndSetMetricData(d)
+}
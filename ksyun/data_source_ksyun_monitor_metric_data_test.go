@@ -0,0 +1,163 @@
+package ksyun
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunMonitorMetricDataDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "ksyun_monitor_metric_data" "foo" {
+  namespace            = "KEC"
+  instance_ids         = ["00000000-0000-0000-0000-000000000000"]
+  metric_name          = "cpu.utilizition.total"
+  fail_on_missing_data = false
+  output_file          = "output_result"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_monitor_metric_data.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunMonitorMetricDataDataSource_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	server.PutMetricData("KEC", "00000000-0000-0000-0000-000000000001", "cpu.utilizition.total", 20, 30, 40)
+	server.PutMetricData("KEC", "00000000-0000-0000-0000-000000000002", "cpu.utilizition.total", 50, 70)
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_monitor_metric_data" "average" {
+  namespace    = "KEC"
+  instance_ids = ["00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"]
+  metric_name  = "cpu.utilizition.total"
+}
+
+data "ksyun_monitor_metric_data" "max" {
+  namespace    = "KEC"
+  instance_ids = ["00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"]
+  metric_name  = "cpu.utilizition.total"
+  statistic    = "Max"
+  period       = 60
+  start_time   = "2021-01-01T00:00:00Z"
+  end_time     = "2021-01-01T01:00:00Z"
+}
+
+data "ksyun_monitor_metric_data" "missing" {
+  namespace            = "KEC"
+  instance_ids         = ["00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000003"]
+  metric_name          = "cpu.utilizition.total"
+  statistic            = "Min"
+  fail_on_missing_data = false
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "data.0.instance_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "data.0.value", "30"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "data.0.datapoints.#", "3"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "data.0.datapoints.2.value", "40"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "data.1.value", "60"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "max_value", "60"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "min_value", "30"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.average", "missing_instance_ids.#", "0"),
+					resource.TestCheckResourceAttrSet("data.ksyun_monitor_metric_data.average", "start_time"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.max", "max_value", "80"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.max", "min_value", "50"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.max", "data.0.datapoints.2.timestamp", "2021-01-01T01:00:00Z"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.max", "data.0.datapoints.1.timestamp", "2021-01-01T00:59:00Z"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.missing", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.missing", "data.0.value", "10"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.missing", "missing_instance_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.missing", "missing_instance_ids.0", "00000000-0000-0000-0000-000000000003"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunMonitorMetricDataDataSource_checks(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	server.PutMetricData("KCS", "00000000-0000-0000-0000-000000000001", "redis.memory.usage", 70, 85)
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_monitor_metric_data" "foo" {
+  namespace    = "KCS"
+  instance_ids = ["00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"]
+  metric_name  = "redis.memory.usage"
+}
+`,
+				ExpectError: regexp.MustCompile("no data of the metric redis.memory.usage in namespace KCS between .* for instances: 00000000-0000-0000-0000-000000000002"),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_monitor_metric_data" "foo" {
+  namespace         = "KCS"
+  instance_ids      = ["00000000-0000-0000-0000-000000000001"]
+  metric_name       = "redis.memory.usage"
+  statistic         = "Max"
+  max_allowed_value = 90
+}
+`,
+				ExpectError: regexp.MustCompile("the Max of the metric redis.memory.usage of instance 00000000-0000-0000-0000-000000000001 is 95, above the max allowed value 90"),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_monitor_metric_data" "foo" {
+  namespace         = "KCS"
+  instance_ids      = ["00000000-0000-0000-0000-000000000001"]
+  metric_name       = "redis.memory.usage"
+  max_allowed_value = 0
+}
+`,
+				ExpectError: regexp.MustCompile("above the max allowed value 0"),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_monitor_metric_data" "foo" {
+  namespace    = "KCS"
+  instance_ids = ["00000000-0000-0000-0000-000000000001"]
+  metric_name  = "redis.memory.usage"
+  end_time     = "2021-01-01T01:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile("the end_time must be set together with the start_time"),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_monitor_metric_data" "foo" {
+  namespace         = "KCS"
+  instance_ids      = ["00000000-0000-0000-0000-000000000001"]
+  metric_name       = "redis.memory.usage"
+  max_allowed_value = 80
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metric_data.foo", "max_value", "77.5"),
+				),
+			},
+		},
+	})
+}
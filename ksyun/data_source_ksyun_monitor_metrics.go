@@ -0,0 +1,113 @@
+/*
+This data source provides the catalog of the Monitor metrics of a namespace.
+
+# Example Usage
+
+```hcl
+data "ksyun_monitor_metrics" "default" {
+  namespace   = "KEC"
+  instance_id = "your instance id"
+  output_file = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunMonitorMetrics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunMonitorMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The namespace of the cloud service, such as `KEC`.",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the instance.",
+			},
+			"metric_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the metric to search.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by metric name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of metrics that satisfy the condition.",
+			},
+			"metrics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "An information list of metrics.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the metric, in the format of `namespace:metric_name`.",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The namespace of the metric.",
+						},
+						"metric_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the metric.",
+						},
+						"metric_desc": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the metric.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						"interval": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The interval of the metric in minutes.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the metric, such as GAUGE or COUNTER.",
+						},
+						"unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unit of the metric.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunMonitorMetricsRead(d *schema.ResourceData, meta interface{}) error {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	return monitorService.ReadAndSetMetrics(d, dataSourceKsyunMonitorMetrics())
+}
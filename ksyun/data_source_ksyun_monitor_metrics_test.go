@@ -0,0 +1,69 @@
+package ksyun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunMonitorMetricsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "ksyun_monitor_metrics" "foo" {
+  namespace   = "KEC"
+  output_file = "output_result"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_monitor_metrics.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunMonitorMetricsDataSource_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_monitor_metrics" "kec" {
+  namespace   = "KEC"
+  instance_id = "00000000-0000-0000-0000-000000000001"
+}
+
+data "ksyun_monitor_metrics" "cpu" {
+  namespace   = "KEC"
+  metric_name = "cpu.utilizition.total"
+}
+
+data "ksyun_monitor_metrics" "net" {
+  namespace  = "KEC"
+  name_regex = "^net\\."
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.kec", "total_count", "4"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.kec", "metrics.0.instance_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.cpu", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.cpu", "metrics.0.id", "KEC:cpu.utilizition.total"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.cpu", "metrics.0.namespace", "KEC"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.cpu", "metrics.0.unit", "Percent"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.cpu", "metrics.0.type", "GAUGE"),
+					resource.TestCheckResourceAttr("data.ksyun_monitor_metrics.net", "total_count", "2"),
+				),
+			},
+		},
+	})
+}
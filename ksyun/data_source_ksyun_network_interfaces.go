@@ -19,7 +19,7 @@ This data source provides a list of Network Interface resources according to the
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunNetworkInterfaces() *schema.Resource {
@@ -17,7 +17,7 @@ This data source provides a list of Route resources according to their Route ID,
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunRoutes() *schema.Resource {
@@ -16,7 +16,7 @@ This data source provides a list of Security Group resources according to their
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunSecurityGroups() *schema.Resource {
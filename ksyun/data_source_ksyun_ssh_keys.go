@@ -14,8 +14,8 @@ This data source provides a list of SSH Key resources according to their SSH Key
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceKsyunSSHKeys() *schema.Resource {
@@ -34,7 +34,7 @@ func dataSourceKsyunSSHKeys() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 				Description:  "A regex string to filter results by kay name.",
 			},
 			"output_file": {
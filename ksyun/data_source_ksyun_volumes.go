@@ -20,7 +20,7 @@ This data source provides a list of EBS volumes.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunVolumes() *schema.Resource {
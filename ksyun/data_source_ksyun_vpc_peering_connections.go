@@ -0,0 +1,155 @@
+/*
+This data source provides a list of VPC peering connections according to their ID, name, VPC and state.
+
+# Example Usage
+
+```hcl
+data "ksyun_vpc_peering_connections" "default" {
+  vpc_id      = "5f3d4e1a-6c2b-4a7e-8f9d-xxxxxxxxxxxx"
+  state       = ["active"]
+  output_file = "output_result"
+}
+```
+*/
+
+package ksyun
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceKsyunVpcPeeringConnections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKsyunVpcPeeringConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of peering connection IDs.",
+			},
+			"vpc_id": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of requester VPC IDs.",
+			},
+			"peer_vpc_id": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of accepter VPC IDs.",
+			},
+			"state": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "A list of peering connection states, such as pending-acceptance and active.",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regex string to filter results by peering name.",
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File name where to save data source results (after running `terraform plan`).",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of peering connections that satisfy the condition.",
+			},
+			"vpc_peering_connections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "It is a nested type which documented below.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the peering connection.",
+						},
+						"vpc_peering_connection_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the peering connection.",
+						},
+						"peering_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the peering connection.",
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the requester VPC.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the requester VPC.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account ID of the requester VPC.",
+						},
+						"peer_vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the accepter VPC.",
+						},
+						"peer_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the accepter VPC.",
+						},
+						"peer_account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account ID of the accepter VPC.",
+						},
+						"band_width": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The bandwidth of the peering connection in Mbps.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the peering connection.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time of creation of the peering connection.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKsyunVpcPeeringConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	vpcService := VpcService{meta.(*KsyunClient)}
+	return vpcService.ReadAndSetVpcPeeringConnections(d, dataSourceKsyunVpcPeeringConnections())
+}
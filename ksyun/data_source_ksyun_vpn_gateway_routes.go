@@ -18,7 +18,7 @@ This data source provides a list of VPN GatewayRoutes.
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceKsyunVpnGatewayRoutes() *schema.Resource {
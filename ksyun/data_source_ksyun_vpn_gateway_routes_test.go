@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func Test_dataSourceKsyunVpnGatewayRoutes(t *testing.T) {
@@ -12,7 +12,7 @@ func Test_dataSourceKsyunVpnGatewayRoutes(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDataVpnGatewayRoutesConfig,
@@ -1,5 +1,15 @@
 package ksyun
 
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/KscSDK/ksc-sdk-go/ksc/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/kingsoftcloud/sdk-go/v2/ksyun/common/profile"
+)
+
 type endpoint string
 
 const (
@@ -21,3 +31,100 @@ func GetInsecureEndpointURL(region string) string {
 func GetEndpointURL(region string) string {
 	return publicSecureEndpoint.GetURL()
 }
+
+// endpointServices are the services whose host can be overridden by the endpoints block.
+// kcs, kce, tag and monitor cover all api versions of the service.
+var endpointServices = []string{
+	"bws", "cen", "clickhouse", "ebs", "eip", "epc", "iam", "kce", "kcm", "kcrs", "kcs",
+	"kec", "klog", "kmr", "knad", "kpfs", "krds", "ks3", "mongodb", "monitor", "pdns",
+	"rabbitmq", "sks", "slb", "sqlserver", "sts", "tag", "vpc",
+}
+
+func endpointsSchema() *schema.Schema {
+	services := make(map[string]*schema.Schema, len(endpointServices))
+	for _, service := range endpointServices {
+		services[service] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateEndpoint,
+			Description:  fmt.Sprintf("Use this to override the default endpoint of the %s service, such as `%s.example.com` or `https://%s.example.com`.", service, service, service),
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["endpoints"],
+		Elem: &schema.Resource{
+			Schema: services,
+		},
+	}
+}
+
+func validateEndpoint(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parseEndpoint(v.(string), false); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+	return
+}
+
+// parseEndpoint splits the endpoint into the host and whether it uses https,
+// the endpoint without scheme follows useSSL.
+func parseEndpoint(raw string, useSSL bool) (host string, ssl bool, err error) {
+	if !strings.Contains(raw, "://") {
+		if raw == "" || strings.ContainsAny(raw, "/?# ") {
+			return "", false, fmt.Errorf("is not a valid endpoint: %s", raw)
+		}
+		return raw, useSSL, nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false, fmt.Errorf("is not a valid endpoint: %s", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Trim(u.Path, "/") != "" {
+		return "", false, fmt.Errorf("is not a valid endpoint, it must be a host with an optional http or https scheme: %s", raw)
+	}
+	return u.Host, u.Scheme == "https", nil
+}
+
+// serviceUrlInfo returns the url info of the service, which is the custom endpoint if set, or the default one.
+func (c *Config) serviceUrlInfo(service string, def *utils.UrlInfo) *utils.UrlInfo {
+	raw, ok := c.Endpoints[service]
+	if !ok || raw == "" {
+		return def
+	}
+	host, ssl, err := parseEndpoint(raw, c.UseSSL)
+	if err != nil {
+		// validated by the provider schema already
+		return def
+	}
+	return &utils.UrlInfo{
+		UseSSL:                      ssl,
+		CustomerDomain:              host,
+		CustomerDomainIgnoreService: true,
+	}
+}
+
+// serviceEndpoint returns the custom endpoint of the services that are not built on the ksc sdk,
+// it falls back to the endpoint argument which is shared by these services.
+func (c *Config) serviceEndpoint(service string) string {
+	if raw := c.Endpoints[service]; raw != "" {
+		return raw
+	}
+	return c.Endpoint
+}
+
+// setHttpProfileEndpoint sets the host and the scheme of the kingsoftcloud sdk client profile
+func (c *Config) setHttpProfileEndpoint(service string, cpf *profile.ClientProfile) {
+	raw := c.serviceEndpoint(service)
+	cpf.HttpProfile.Endpoint = raw
+	if c.Endpoints[service] == "" {
+		return
+	}
+	if host, ssl, err := parseEndpoint(raw, c.UseSSL); err == nil {
+		cpf.HttpProfile.Endpoint = host
+		if ssl {
+			cpf.HttpProfile.Scheme = "HTTPS"
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/KscSDK/ksc-sdk-go/ksc/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/kingsoftcloud/sdk-go/v2/ksyun/common/profile"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	cases := []struct {
+		raw      string
+		useSSL   bool
+		host     string
+		ssl      bool
+		hasError bool
+	}{
+		{"vpc.example.com", false, "vpc.example.com", false, false},
+		{"vpc.example.com", true, "vpc.example.com", true, false},
+		{"127.0.0.1:8080", false, "127.0.0.1:8080", false, false},
+		{"https://vpc.example.com", false, "vpc.example.com", true, false},
+		{"http://vpc.example.com/", true, "vpc.example.com", false, false},
+		{"", false, "", false, true},
+		{"vpc.example.com/api", false, "", false, true},
+		{"ftp://vpc.example.com", false, "", false, true},
+		{"https://vpc.example.com/api", false, "", false, true},
+	}
+	for _, c := range cases {
+		host, ssl, err := parseEndpoint(c.raw, c.useSSL)
+		if c.hasError {
+			if err == nil {
+				t.Errorf("%q: expected error", c.raw)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %s", c.raw, err)
+			continue
+		}
+		if host != c.host || ssl != c.ssl {
+			t.Errorf("%q: expected %s %v, got %s %v", c.raw, c.host, c.ssl, host, ssl)
+		}
+	}
+}
+
+func TestServiceEndpoints(t *testing.T) {
+	c := &Config{
+		Endpoint: "ks3-cn-beijing.ksyuncs.com",
+		Endpoints: map[string]string{
+			"vpc":  "https://vpc.example.com",
+			"klog": "https://klog.example.com",
+		},
+	}
+	def := &utils.UrlInfo{CustomerDomain: "example.com"}
+	if info := c.serviceUrlInfo("kec", def); info != def {
+		t.Errorf("expected the default url info for kec, got %+v", info)
+	}
+	info := c.serviceUrlInfo("vpc", def)
+	if url := utils.Url(info, utils.ServiceInfo{Service: "vpc"}); url != "https://vpc.example.com" {
+		t.Errorf("expected the url of vpc %s, got %s", "https://vpc.example.com", url)
+	}
+	if endpoint := c.serviceEndpoint("ks3"); endpoint != "ks3-cn-beijing.ksyuncs.com" {
+		t.Errorf("expected the endpoint of ks3 %s, got %s", "ks3-cn-beijing.ksyuncs.com", endpoint)
+	}
+
+	cpf := profile.NewClientProfile()
+	c.setHttpProfileEndpoint("klog", cpf)
+	if cpf.HttpProfile.Endpoint != "klog.example.com" || cpf.HttpProfile.Scheme != "HTTPS" {
+		t.Errorf("unexpected http profile of klog: %+v", cpf.HttpProfile)
+	}
+}
+
+func TestUnitKsyunEndpoints(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitEndpointsConfig(server),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_vpc.foo", "vpc_name", "tf-unit-endpoints"),
+				),
+			},
+		},
+	})
+}
+
+// testUnitEndpointsConfig points the services of ksyun_vpc to the mock server,
+// while the domain of the others is unreachable.
+func testUnitEndpointsConfig(server *mockapi.Server) string {
+	return fmt.Sprintf(`
+provider "ksyun" {
+  access_key = "mock-ak"
+  secret_key = "mock-sk"
+  region     = "cn-beijing-6"
+  domain     = "unreachable.invalid"
+  endpoints {
+    vpc = "http://%[1]s"
+    tag = "%[1]s"
+  }
+}
+
+resource "ksyun_vpc" "foo" {
+  vpc_name   = "tf-unit-endpoints"
+  cidr_block = "192.168.0.0/16"
+}
+`, server.Domain())
+}
@@ -6,7 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/pkg/errors"
 )
 
@@ -117,6 +117,9 @@ func isServerError(err error) bool {
 
 // retryError check whether retry and returns retry error
 func retryError(err error, additionRetryableError ...string) *resource.RetryError {
+	if err == nil {
+		return nil
+	}
 	switch realErr := errors.Cause(err).(type) {
 	case awserr.RequestFailure:
 		if isExpectError(realErr, retryableErrorCode) {
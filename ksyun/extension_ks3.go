@@ -138,7 +138,7 @@ func WrapErrorf(cause error, msg string, args ...interface{}) error {
 	_, filepath, line, ok := runtime.Caller(1)
 	if !ok {
 		log.Printf("\u001B[31m[ERROR]\u001B[0m runtime.Caller error in WrapErrorf.")
-		return WrapComplexError(cause, Error(msg), "", -1)
+		return WrapComplexError(cause, Error("%s", msg), "", -1)
 	}
 	parts := strings.Split(filepath, "/")
 	if len(parts) > 3 {
@@ -0,0 +1,298 @@
+// Package cassette records the api interactions of acceptance tests into a file,
+// and replays them offline, so that the CRUD logic is able to be tested without credentials.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+// ModeEnv is the environment variable of the cassette mode
+const ModeEnv = "KSYUN_CASSETTE_MODE"
+
+type Mode string
+
+const (
+	// ModeDisabled sends requests to the real api without recording
+	ModeDisabled Mode = ""
+	// ModeRecord sends requests to the real api and saves the interactions
+	ModeRecord Mode = "record"
+	// ModeReplay responds with the saved interactions without network access
+	ModeReplay Mode = "replay"
+)
+
+// ModeFromEnv returns the mode of KSYUN_CASSETTE_MODE
+func ModeFromEnv() (Mode, error) {
+	switch mode := Mode(strings.ToLower(os.Getenv(ModeEnv))); mode {
+	case ModeDisabled, ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return mode, fmt.Errorf("invalid %s %q, expected %q or %q", ModeEnv, mode, ModeRecord, ModeReplay)
+	}
+}
+
+// volatileParams are ignored when matching the requests, they differ on each run
+var volatileParams = map[string]bool{
+	"ClientToken": true,
+}
+
+// Request is the normalized request used for matching
+type Request struct {
+	Host   string `json:"host"`
+	Action string `json:"action"`
+	Params string `json:"params"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is a http.RoundTripper that records or replays the interactions of a cassette file
+type Recorder struct {
+	mode     Mode
+	filename string
+	base     http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	// cursor is the index of the next interaction to replay of each request,
+	// so the polling of the same request gets the responses in recorded order.
+	cursor map[Request]int
+}
+
+// New returns a recorder of the cassette file, the file must exist in replay mode.
+func New(filename string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		mode:     mode,
+		filename: filename,
+		cassette: &Cassette{},
+		cursor:   make(map[Request]int),
+	}
+	if mode != ModeReplay {
+		return r, nil
+	}
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, r.cassette); err != nil {
+		return nil, fmt.Errorf("error parsing cassette %s: %s", filename, err)
+	}
+	return r, nil
+}
+
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Transport wraps the base transport, it's used as Config.Transport of the provider
+func (r *Recorder) Transport(base http.RoundTripper) http.RoundTripper {
+	r.base = base
+	return r
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch r.mode {
+	case ModeReplay:
+		return r.replay(req)
+	case ModeRecord:
+		return r.record(req)
+	default:
+		return r.base.RoundTrip(req)
+	}
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key, err := normalize(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var matched []*Interaction
+	for _, i := range r.cassette.Interactions {
+		if i.Request == key {
+			matched = append(matched, i)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("cassette %s: no interaction matches %s %s %s", r.filename, key.Host, key.Action, key.Params)
+	}
+	// the last response is repeated after all are replayed
+	index := r.cursor[key]
+	if index >= len(matched) {
+		index = len(matched) - 1
+	}
+	r.cursor[key] = index + 1
+
+	resp := matched[index].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        resp.Header.Clone(),
+		Body:          ioutil.NopCloser(strings.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	key, err := normalize(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := http.Header{}
+	if v := resp.Header.Get("Content-Type"); v != "" {
+		header.Set("Content-Type", v)
+	}
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: key,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       string(body),
+		},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// Stop saves the recorded interactions in record mode
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.filename), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.filename, b, 0644)
+}
+
+// normalize returns the matching key of the request, which is composed of the host, action,
+// and the params in query string and body, sorted by name. The sensitive params are redacted,
+// so they are not saved in the cassette.
+func normalize(req *http.Request) (Request, error) {
+	query := req.URL.Query()
+	key := Request{
+		Host:   req.URL.Host,
+		Action: query.Get("Action"),
+	}
+	params := make(map[string]interface{})
+	for k, v := range query {
+		if k != "Action" {
+			params[k] = strings.Join(v, ",")
+		}
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := readBody(req)
+		if err != nil {
+			return key, err
+		}
+		contentType := req.Header.Get("Content-Type")
+		switch {
+		case strings.Contains(contentType, "json") && len(body) > 0:
+			var v interface{}
+			if err = json.Unmarshal(body, &v); err != nil {
+				return key, err
+			}
+			params["body"] = v
+		case len(body) > 0:
+			form, err := url.ParseQuery(string(body))
+			if err != nil {
+				return key, err
+			}
+			for k, v := range form {
+				params[k] = strings.Join(v, ",")
+			}
+		}
+	}
+
+	for k := range volatileParams {
+		delete(params, k)
+	}
+	params = logger.Redact(params).(map[string]interface{})
+
+	names := make([]string, 0, len(params))
+	for k := range params {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var buf strings.Builder
+	for _, k := range names {
+		// encoding/json sorts the keys of maps
+		v, err := json.Marshal(params[k])
+		if err != nil {
+			return key, err
+		}
+		if buf.Len() > 0 {
+			buf.WriteString("&")
+		}
+		buf.WriteString(k + "=" + string(v))
+	}
+	key.Params = buf.String()
+	return key, nil
+}
+
+// readBody reads the body and restores it for sending
+func readBody(req *http.Request) ([]byte, error) {
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
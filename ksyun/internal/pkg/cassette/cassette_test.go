@@ -0,0 +1,96 @@
+package cassette
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func doRequest(t *testing.T, rt http.RoundTripper, method, rawurl, body string) string {
+	var req *http.Request
+	var err error
+	if body == "" {
+		req, err = http.NewRequest(method, rawurl, nil)
+	} else {
+		req, err = http.NewRequest(method, rawurl, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	count := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("Action") {
+		case "CreateVpc":
+			_, _ = w.Write([]byte(`{"Vpc":{"VpcId":"vpc-1"},"Password":"` + r.Form.Get("Password") + `"}`))
+		case "DescribeVpcs":
+			if count < 3 {
+				_, _ = w.Write([]byte(`{"VpcSet":[{"State":"creating"}]}`))
+			} else {
+				_, _ = w.Write([]byte(`{"VpcSet":[{"State":"available"}]}`))
+			}
+		}
+	}))
+	defer server.Close()
+
+	filename := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := New(filename, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := recorder.Transport(http.DefaultTransport)
+	doRequest(t, rt, "POST", server.URL+"/?Action=CreateVpc", "VpcName=tf&CidrBlock=10.0.0.0%2F16&ClientToken=abc&Password=p")
+	doRequest(t, rt, "GET", server.URL+"/?Action=DescribeVpcs&VpcId.1=vpc-1", "")
+	doRequest(t, rt, "GET", server.URL+"/?Action=DescribeVpcs&VpcId.1=vpc-1", "")
+	if err = recorder.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "Password=p") || strings.Contains(string(b), "abc") {
+		t.Errorf("cassette contains sensitive or volatile params: %s", b)
+	}
+
+	server.Close()
+	replayer, err := New(filename, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt = replayer.Transport(nil)
+	// the params are matched regardless of order and volatile params
+	if body := doRequest(t, rt, "POST", server.URL+"/?Action=CreateVpc", "CidrBlock=10.0.0.0%2F16&VpcName=tf&ClientToken=xyz&Password=q"); !strings.Contains(body, "vpc-1") {
+		t.Errorf("unexpected response %s", body)
+	}
+	expects := []string{"creating", "available", "available"}
+	for _, expect := range expects {
+		if body := doRequest(t, rt, "GET", server.URL+"/?VpcId.1=vpc-1&Action=DescribeVpcs", ""); !strings.Contains(body, expect) {
+			t.Errorf("expected %s, got %s", expect, body)
+		}
+	}
+
+	req, _ := http.NewRequest("GET", server.URL+"/?Action=DeleteVpc&VpcId=vpc-1", nil)
+	if _, err = rt.RoundTrip(req); err == nil {
+		t.Errorf("expected error for the request that is not recorded")
+	}
+}
@@ -0,0 +1,105 @@
+// Package encryption encrypts the secrets kept in the state with a pgp key,
+// it takes over the helper/encryption package that was dropped by terraform-plugin-sdk v2.
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+)
+
+const keybasePrefix = "keybase:"
+
+// RetrieveGPGKey returns the PGP key specified as the pgpKey parameter, or queries
+// the public key from the keybase service if the parameter is a keybase username
+// prefixed with the phrase "keybase:"
+func RetrieveGPGKey(pgpKey string) (string, error) {
+	if !strings.HasPrefix(pgpKey, keybasePrefix) {
+		return pgpKey, nil
+	}
+	publicKey, err := fetchKeybasePubkey(strings.TrimPrefix(pgpKey, keybasePrefix))
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Public Key for %s: %w", pgpKey, err)
+	}
+	return publicKey, nil
+}
+
+// EncryptValue encrypts the given value with the given encryption key. Description
+// should be set such that errors return a meaningful user-facing response.
+func EncryptValue(encryptionKey, value, description string) (string, string, error) {
+	data, err := base64.StdEncoding.DecodeString(encryptionKey)
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: error decoding given PGP key: %w", description, err)
+	}
+	entity, err := openpgp.ReadEntity(packet.NewReader(bytes.NewBuffer(data)))
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: error parsing given PGP key: %w", description, err)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	pt, err := openpgp.Encrypt(buf, []*openpgp.Entity{entity}, nil, nil, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: error setting up encryption for PGP message: %w", description, err)
+	}
+	if _, err = pt.Write([]byte(value)); err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: error encrypting PGP message: %w", description, err)
+	}
+	if err = pt.Close(); err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: error encrypting PGP message: %w", description, err)
+	}
+
+	return fmt.Sprintf("%x", entity.PrimaryKey.Fingerprint), base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// fetchKeybasePubkey fetches the primary public key of the keybase user,
+// the key is returned as a base64-encoded string.
+func fetchKeybasePubkey(username string) (string, error) {
+	url := fmt.Sprintf("https://keybase.io/_/api/1.0/user/lookup.json?usernames=%s&fields=public_keys", username)
+	resp, err := cleanhttp.DefaultClient().Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	out := struct {
+		Status struct {
+			Name string
+		}
+		Them []struct {
+			PublicKeys struct {
+				Primary struct {
+					Bundle string
+				}
+			} `json:"public_keys"`
+		}
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	if out.Status.Name != "OK" {
+		return "", fmt.Errorf("got non-OK response: %q", out.Status.Name)
+	}
+	if len(out.Them) == 0 || out.Them[0].PublicKeys.Primary.Bundle == "" {
+		return "", fmt.Errorf("unable to fetch keys for user %q from keybase", username)
+	}
+
+	entityList, err := openpgp.ReadArmoredKeyRing(strings.NewReader(out.Them[0].PublicKeys.Primary.Bundle))
+	if err != nil {
+		return "", err
+	}
+	if len(entityList) != 1 || entityList[0] == nil {
+		return "", fmt.Errorf("primary key could not be parsed for user %q", username)
+	}
+
+	serialized := bytes.NewBuffer(nil)
+	if err = entityList[0].Serialize(serialized); err != nil {
+		return "", fmt.Errorf("error serializing entity for user %q: %w", username, err)
+	}
+	return base64.StdEncoding.EncodeToString(serialized.Bytes()), nil
+}
@@ -0,0 +1,59 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+)
+
+func TestEncryptValue(t *testing.T) {
+	entity, err := openpgp.NewEntity("terraform", "test", "terraform@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := bytes.NewBuffer(nil)
+	if err = entity.Serialize(publicKey); err != nil {
+		t.Fatal(err)
+	}
+
+	fingerprint, encrypted, err := EncryptValue(base64.StdEncoding.EncodeToString(publicKey.Bytes()), "secret", "test value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := fmt.Sprintf("%x", entity.PrimaryKey.Fingerprint); fingerprint != expected {
+		t.Errorf("expected fingerprint %s, got %s", expected, fingerprint)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, err := openpgp.ReadMessage(bytes.NewReader(ciphertext), openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := io.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != "secret" {
+		t.Errorf("expected the decrypted value secret, got %s", plaintext)
+	}
+}
+
+func TestEncryptValueInvalidKey(t *testing.T) {
+	if _, _, err := EncryptValue("not base64", "secret", "test value"); err == nil {
+		t.Error("expected an error for the invalid key")
+	}
+}
+
+func TestRetrieveGPGKey(t *testing.T) {
+	key, err := RetrieveGPGKey("mQENBFXbjPUBCADjNjCUQwfxKL+RR2GA6pv/1K+zJZ8UWIF9S0lk7cVIEfJiprzzwiMwBS5cD0da")
+	if err != nil || key != "mQENBFXbjPUBCADjNjCUQwfxKL+RR2GA6pv/1K+zJZ8UWIF9S0lk7cVIEfJiprzzwiMwBS5cD0da" {
+		t.Errorf("expected the key itself if it's not a keybase user, got %s, %v", key, err)
+	}
+}
@@ -5,8 +5,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // generate a hash code that's value if from 'backend_server_group_id'
@@ -14,7 +13,7 @@ import (
 func HashFuncWithKeys(keys ...string) schema.SchemaSetFunc {
 	return func(v interface{}) int {
 		if v == nil {
-			return hashcode.String("")
+			return schema.HashString("")
 		}
 		m := v.(map[string]interface{})
 		var buf bytes.Buffer
@@ -62,6 +61,6 @@ func HashFuncWithKeys(keys ...string) schema.SchemaSetFunc {
 				}
 			}
 		}
-		return hashcode.String(buf.String())
+		return schema.HashString(buf.String())
 	}
 }
@@ -0,0 +1,462 @@
+package mockapi
+
+func registerCenHandlers(s *Server) {
+	s.handlers["CreateCen"] = createCen
+	s.handlers["DescribeCens"] = describeCens
+	s.handlers["ModifyCen"] = modifyCen
+	s.handlers["DeleteCen"] = deleteCen
+	s.handlers["AttachCenInstances"] = attachCenInstances
+	s.handlers["DescribeCenInstances"] = describeCenInstances
+	s.handlers["DetachCenInstances"] = detachCenInstances
+	s.handlers["DescribeCenRoutes"] = describeCenRoutes
+	s.handlers["CreateCenBandwidthPackage"] = createCenBandwidthPackage
+	s.handlers["DescribeCenBandwidthPackages"] = describeCenBandwidthPackages
+	s.handlers["ModifyCenBandwidthPackage"] = modifyCenBandwidthPackage
+	s.handlers["AssociateCenBandwidthPackage"] = associateCenBandwidthPackage
+	s.handlers["DisassociateCenBandwidthPackage"] = disassociateCenBandwidthPackage
+	s.handlers["DeleteCenBandwidthPackage"] = deleteCenBandwidthPackage
+	s.handlers["CreateCenRegionBandwidthLimit"] = createCenRegionBandwidthLimit
+	s.handlers["DescribeCenRegionBandwidthLimits"] = describeCenRegionBandwidthLimits
+	s.handlers["ModifyCenRegionBandwidthLimit"] = modifyCenRegionBandwidthLimit
+	s.handlers["DeleteCenRegionBandwidthLimit"] = deleteCenRegionBandwidthLimit
+	s.handlers["CreateCenGrant"] = createCenGrant
+	s.handlers["DescribeCenGrants"] = describeCenGrants
+	s.handlers["DeleteCenGrant"] = deleteCenGrant
+}
+
+func createCen(s *Server, p Params) (map[string]interface{}, error) {
+	id := s.newId()
+	cen := map[string]interface{}{
+		"CenId":       id,
+		"CenName":     p.Get("CenName", "cen-"+id[len(id)-4:]),
+		"Description": p.Get("Description"),
+		"CreateTime":  now(),
+	}
+	s.store("cen").put(id, cen)
+	return map[string]interface{}{"Cen": copyValue(cen)}, nil
+}
+
+func describeCens(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("CenId")
+	cens := s.store("cen").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "CenId", ids)
+	})
+	return map[string]interface{}{"CenSet": cens}, nil
+}
+
+func modifyCen(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	cen, err := s.store("cen").get(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range []string{"CenName", "Description"} {
+		if v, ok := p[k]; ok {
+			cen[k] = v
+		}
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func deleteCen(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("cen").get(id); err != nil {
+		return nil, err
+	}
+	for _, instance := range s.store("cen_instance").objects {
+		if instance.data["CenId"] == id {
+			return nil, inUse("cen", id, "instance "+instance.data["InstanceId"].(string))
+		}
+	}
+	for _, bwp := range s.store("cen_bandwidth_package").objects {
+		if bwp.data["CenId"] == id {
+			return nil, inUse("cen", id, "bandwidth package "+bwp.data["CenBandwidthPackageId"].(string))
+		}
+	}
+	s.store("cen").remove(id)
+	return map[string]interface{}{"Return": true}, nil
+}
+
+// cenKey is the key of the objects belonging to the cen, such as the attached instances and the grants
+func cenKey(cenId string, keys ...string) string {
+	for _, k := range keys {
+		cenId += "/" + k
+	}
+	return cenId
+}
+
+func attachCenInstances(s *Server, p Params) (map[string]interface{}, error) {
+	cenId, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("cen").get(cenId); err != nil {
+		return nil, err
+	}
+	for _, instance := range p.set("Instance") {
+		params := Params{}
+		for k, v := range instance.(map[string]interface{}) {
+			params[k] = v.(string)
+		}
+		instanceId, err := params.Require("InstanceId")
+		if err != nil {
+			return nil, err
+		}
+		instanceType := params.Get("InstanceType", "Vpc")
+		accountId := params.Get("InstanceAccountId", DefaultAccountId)
+		// the instance of another account is not kept by the mock server, it must be granted to the cen
+		if accountId == DefaultAccountId {
+			if instanceType == "Vpc" {
+				if _, err = s.store("vpc").get(instanceId); err != nil {
+					return nil, err
+				}
+			}
+		} else if _, err = s.store("cen_grant").get(cenKey(cenId, instanceId)); err != nil {
+			return nil, invalidParam("the instance %s of account %s is not granted to the cen %s", instanceId, accountId, cenId)
+		}
+		if _, err = s.store("cen_instance").get(cenKey(cenId, instanceId)); err == nil {
+			return nil, invalidParam("the instance %s is already attached to the cen %s", instanceId, cenId)
+		}
+		attachment := map[string]interface{}{
+			"CenId":             cenId,
+			"InstanceId":        instanceId,
+			"InstanceType":      instanceType,
+			"InstanceRegion":    params.Get("InstanceRegion", DefaultRegion),
+			"InstanceAccountId": accountId,
+			"Status":            "Attaching",
+			"CreateTime":        now(),
+		}
+		s.store("cen_instance").put(cenKey(cenId, instanceId), attachment, after(s.PendingDescribes, setState("Status", "Attached"))...)
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func describeCenInstances(s *Server, p Params) (map[string]interface{}, error) {
+	cenId, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	filters := p.Filters()
+	instances := s.store("cen_instance").describe(func(data map[string]interface{}) bool {
+		return data["CenId"] == cenId &&
+			matchFilters(data, filters, map[string]string{"instance-id": "InstanceId", "instance-type": "InstanceType"})
+	})
+	return map[string]interface{}{"CenInstanceSet": instances}, nil
+}
+
+func detachCenInstances(s *Server, p Params) (map[string]interface{}, error) {
+	cenId, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range p.set("Instance") {
+		instanceId, _ := instance.(map[string]interface{})["InstanceId"].(string)
+		key := cenKey(cenId, instanceId)
+		attachment, err := s.store("cen_instance").get(key)
+		if err != nil {
+			return nil, err
+		}
+		attachment["Status"] = "Detaching"
+		s.store("cen_instance").transit(key, after(s.PendingDescribes, func(map[string]interface{}) bool { return true })...)
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+// describeCenRoutes returns the cidr blocks of the attached vpcs as the learned routes
+func describeCenRoutes(s *Server, p Params) (map[string]interface{}, error) {
+	cenId, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	filters := p.Filters()
+	routes := make([]interface{}, 0)
+	for _, id := range s.store("cen_instance").ids {
+		instance := s.store("cen_instance").objects[id].data
+		if instance["CenId"] != cenId || instance["InstanceType"] != "Vpc" ||
+			!matchFilters(instance, filters, map[string]string{"instance-id": "InstanceId"}) {
+			continue
+		}
+		vpc, err := s.store("vpc").get(instance["InstanceId"].(string))
+		if err != nil {
+			continue
+		}
+		routes = append(routes, map[string]interface{}{
+			"CenId":                cenId,
+			"DestinationCidrBlock": vpc["CidrBlock"],
+			"InstanceId":           instance["InstanceId"],
+			"InstanceType":         instance["InstanceType"],
+			"InstanceRegion":       instance["InstanceRegion"],
+			"RouteType":            "System",
+			"Status":               "Active",
+		})
+	}
+	return map[string]interface{}{"CenRouteSet": routes}, nil
+}
+
+func createCenBandwidthPackage(s *Server, p Params) (map[string]interface{}, error) {
+	bandWidth := p.Int("BandWidth", 0)
+	if bandWidth <= 0 {
+		return nil, invalidParam("the param BandWidth is invalid")
+	}
+	chargeType, err := p.Require("ChargeType")
+	if err != nil {
+		return nil, err
+	}
+	id := s.newId()
+	bwp := map[string]interface{}{
+		"CenBandwidthPackageId":   id,
+		"CenBandwidthPackageName": p.Get("CenBandwidthPackageName", "cen-bwp-"+id[len(id)-4:]),
+		"BandWidth":               bandWidth,
+		"GeographicRegionA":       p.Get("GeographicRegionA"),
+		"GeographicRegionB":       p.Get("GeographicRegionB"),
+		"ChargeType":              chargeType,
+		"ProjectId":               p.Get("ProjectId", DefaultProjectId),
+		"CenId":                   "",
+		"State":                   "Idle",
+		"CreateTime":              now(),
+		"ExpireTime":              "",
+	}
+	s.store("cen_bandwidth_package").put(id, bwp)
+	return map[string]interface{}{"CenBandwidthPackageId": id}, nil
+}
+
+func describeCenBandwidthPackages(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("CenBandwidthPackageId")
+	packages := s.store("cen_bandwidth_package").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "CenBandwidthPackageId", ids)
+	})
+	return map[string]interface{}{"CenBandwidthPackageSet": packages}, nil
+}
+
+// cenRegionBandwidthUsed returns the sum of the region bandwidth limits of the cen except the one of the key
+func (s *Server) cenRegionBandwidthUsed(cenId, except string) int {
+	used := 0
+	for key, limit := range s.store("cen_region_bandwidth_limit").objects {
+		if limit.data["CenId"] == cenId && key != except {
+			used += limit.data["BandWidth"].(int)
+		}
+	}
+	return used
+}
+
+func modifyCenBandwidthPackage(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("CenBandwidthPackageId")
+	if err != nil {
+		return nil, err
+	}
+	bwp, err := s.store("cen_bandwidth_package").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := p["CenBandwidthPackageName"]; ok {
+		bwp["CenBandwidthPackageName"] = v
+	}
+	if _, ok := p["BandWidth"]; ok {
+		bandWidth := p.Int("BandWidth", 0)
+		if cenId := bwp["CenId"].(string); cenId != "" && bandWidth < s.cenRegionBandwidthUsed(cenId, "") {
+			return nil, invalidParam("the bandwidth %d is less than the region bandwidth limits of the cen %s", bandWidth, cenId)
+		}
+		bwp["BandWidth"] = bandWidth
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func associateCenBandwidthPackage(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("CenBandwidthPackageId")
+	if err != nil {
+		return nil, err
+	}
+	bwp, err := s.store("cen_bandwidth_package").get(id)
+	if err != nil {
+		return nil, err
+	}
+	cenId, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("cen").get(cenId); err != nil {
+		return nil, err
+	}
+	if bwp["CenId"] != "" {
+		return nil, inUse("cen bandwidth package", id, "cen "+bwp["CenId"].(string))
+	}
+	bwp["CenId"] = cenId
+	bwp["State"] = "InUse"
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func disassociateCenBandwidthPackage(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("CenBandwidthPackageId")
+	if err != nil {
+		return nil, err
+	}
+	bwp, err := s.store("cen_bandwidth_package").get(id)
+	if err != nil {
+		return nil, err
+	}
+	cenId := bwp["CenId"].(string)
+	if cenId == "" || cenId != p.Get("CenId") {
+		return nil, invalidParam("the cen bandwidth package %s is not associated with the cen %s", id, p.Get("CenId"))
+	}
+	for _, limit := range s.store("cen_region_bandwidth_limit").objects {
+		if limit.data["CenBandwidthPackageId"] == id {
+			return nil, inUse("cen bandwidth package", id, "region bandwidth limit of "+cenId)
+		}
+	}
+	bwp["CenId"] = ""
+	bwp["State"] = "Idle"
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func deleteCenBandwidthPackage(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("CenBandwidthPackageId")
+	if err != nil {
+		return nil, err
+	}
+	bwp, err := s.store("cen_bandwidth_package").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if bwp["CenId"] != "" {
+		return nil, inUse("cen bandwidth package", id, "cen "+bwp["CenId"].(string))
+	}
+	s.store("cen_bandwidth_package").remove(id)
+	return map[string]interface{}{"Return": true}, nil
+}
+
+// cenBandwidthPackage returns the bandwidth package associated with the cen
+func (s *Server) cenBandwidthPackage(cenId string) (map[string]interface{}, error) {
+	for _, bwp := range s.store("cen_bandwidth_package").objects {
+		if bwp.data["CenId"] == cenId {
+			return bwp.data, nil
+		}
+	}
+	return nil, invalidParam("the cen %s is not associated with any bandwidth package", cenId)
+}
+
+func regionBandwidthLimitParams(s *Server, p Params) (cenId, key string, bandWidth int, bwp map[string]interface{}, err error) {
+	if cenId, err = p.Require("CenId"); err != nil {
+		return
+	}
+	localRegion, err := p.Require("LocalRegion")
+	if err != nil {
+		return
+	}
+	oppositeRegion, err := p.Require("OppositeRegion")
+	if err != nil {
+		return
+	}
+	key = cenKey(cenId, localRegion, oppositeRegion)
+	bandWidth = p.Int("BandWidth", 0)
+	if bwp, err = s.cenBandwidthPackage(cenId); err != nil {
+		return
+	}
+	if bandWidth+s.cenRegionBandwidthUsed(cenId, key) > bwp["BandWidth"].(int) {
+		err = invalidParam("the region bandwidth limits of the cen %s exceed the bandwidth package %v", cenId, bwp["CenBandwidthPackageId"])
+	}
+	return
+}
+
+func createCenRegionBandwidthLimit(s *Server, p Params) (map[string]interface{}, error) {
+	cenId, key, bandWidth, bwp, err := regionBandwidthLimitParams(s, p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("cen_region_bandwidth_limit").get(key); err == nil {
+		return nil, invalidParam("the region bandwidth limit %s already exists", key)
+	}
+	s.store("cen_region_bandwidth_limit").put(key, map[string]interface{}{
+		"CenId":                 cenId,
+		"LocalRegion":           p["LocalRegion"],
+		"OppositeRegion":        p["OppositeRegion"],
+		"BandWidth":             bandWidth,
+		"CenBandwidthPackageId": bwp["CenBandwidthPackageId"],
+		"CreateTime":            now(),
+	})
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func describeCenRegionBandwidthLimits(s *Server, p Params) (map[string]interface{}, error) {
+	cenId, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	limits := s.store("cen_region_bandwidth_limit").describe(func(data map[string]interface{}) bool {
+		return data["CenId"] == cenId
+	})
+	return map[string]interface{}{"CenRegionBandwidthLimitSet": limits}, nil
+}
+
+func modifyCenRegionBandwidthLimit(s *Server, p Params) (map[string]interface{}, error) {
+	_, key, bandWidth, _, err := regionBandwidthLimitParams(s, p)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := s.store("cen_region_bandwidth_limit").get(key)
+	if err != nil {
+		return nil, err
+	}
+	limit["BandWidth"] = bandWidth
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func deleteCenRegionBandwidthLimit(s *Server, p Params) (map[string]interface{}, error) {
+	key := cenKey(p.Get("CenId"), p.Get("LocalRegion"), p.Get("OppositeRegion"))
+	if _, err := s.store("cen_region_bandwidth_limit").get(key); err != nil {
+		return nil, err
+	}
+	s.store("cen_region_bandwidth_limit").remove(key)
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func createCenGrant(s *Server, p Params) (map[string]interface{}, error) {
+	cenId, err := p.Require("CenId")
+	if err != nil {
+		return nil, err
+	}
+	cenAccountId, err := p.Require("CenAccountId")
+	if err != nil {
+		return nil, err
+	}
+	instanceId, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	instanceType := p.Get("InstanceType", "Vpc")
+	if instanceType == "Vpc" {
+		if _, err = s.store("vpc").get(instanceId); err != nil {
+			return nil, err
+		}
+	}
+	s.store("cen_grant").put(cenKey(cenId, instanceId), map[string]interface{}{
+		"CenId":        cenId,
+		"CenAccountId": cenAccountId,
+		"InstanceId":   instanceId,
+		"InstanceType": instanceType,
+		"CreateTime":   now(),
+	})
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func describeCenGrants(s *Server, p Params) (map[string]interface{}, error) {
+	filters := p.Filters()
+	grants := s.store("cen_grant").describe(func(data map[string]interface{}) bool {
+		return matchFilters(data, filters, map[string]string{"instance-id": "InstanceId", "cen-id": "CenId"})
+	})
+	return map[string]interface{}{"CenGrantSet": grants}, nil
+}
+
+func deleteCenGrant(s *Server, p Params) (map[string]interface{}, error) {
+	key := cenKey(p.Get("CenId"), p.Get("InstanceId"))
+	if _, err := s.store("cen_grant").get(key); err != nil {
+		return nil, err
+	}
+	s.store("cen_grant").remove(key)
+	return map[string]interface{}{"Return": true}, nil
+}
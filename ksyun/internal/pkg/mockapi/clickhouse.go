@@ -0,0 +1,346 @@
+package mockapi
+
+import (
+	"strconv"
+)
+
+// clickhouseVersion is the api version of clickhouse, the actions such as CreateInstance are shared with the others
+const clickhouseVersion = "2021-01-01"
+
+func registerClickhouseHandlers(s *Server) {
+	s.handleVersion(clickhouseVersion, "CreateInstance", createClickhouseInstance)
+	s.handleVersion(clickhouseVersion, "DescribeInstance", describeClickhouseInstance)
+	s.handleVersion(clickhouseVersion, "RenameInstance", renameClickhouseInstance)
+	s.handleVersion(clickhouseVersion, "ResizeInstance", resizeClickhouseInstance)
+	s.handleVersion(clickhouseVersion, "DeleteInstance", deleteClickhouseInstance)
+	s.handleVersion(clickhouseVersion, "CreateInstanceAccount", createClickhouseAccount)
+	s.handleVersion(clickhouseVersion, "DescribeInstanceAccounts", describeClickhouseAccounts)
+	s.handleVersion(clickhouseVersion, "ModifyInstanceAccountInfo", modifyClickhouseAccount)
+	s.handleVersion(clickhouseVersion, "DeleteInstanceAccount", deleteClickhouseAccount)
+	s.handleVersion(clickhouseVersion, "DescribeSecurityGroup", describeClickhouseSecurityGroup)
+	s.handleVersion(clickhouseVersion, "CreateSecurityRule", createClickhouseSecurityRule)
+	s.handleVersion(clickhouseVersion, "DeleteSecurityRule", deleteClickhouseSecurityRule)
+}
+
+func clickhouseData(data interface{}) map[string]interface{} {
+	return map[string]interface{}{"Data": data}
+}
+
+// setClickhouseShards sets the shards and the replicas of the instance
+func setClickhouseShards(instance map[string]interface{}, p Params) error {
+	shardNum := p.Int("ShardNum", 1)
+	replicas := p.Int("Replicas", instance["Replicas"].(int))
+	if shardNum < 1 || replicas < 1 {
+		return invalidParam("the ShardNum and the Replicas must be at least 1")
+	}
+	if instance["ProductTypeName"] == "ClickHouse_Single" && replicas != 1 {
+		return invalidParam("the ClickHouse_Single instance has only one replica")
+	}
+	shards := make([]interface{}, 0, shardNum)
+	for i := 1; i <= shardNum; i++ {
+		shards = append(shards, map[string]interface{}{
+			"Id":   instance["InstanceId"].(string) + "-shard-" + strconv.Itoa(i),
+			"Name": "shard" + strconv.Itoa(i),
+		})
+	}
+	instance["ShardList"] = shards
+	instance["Replicas"] = replicas
+	instance["NodeNum"] = shardNum * replicas
+	return nil
+}
+
+func createClickhouseInstance(s *Server, p Params) (map[string]interface{}, error) {
+	for _, k := range []string{"InstanceName", "EngineVersion", "InstanceConfig", "VpcId", "SubnetId", "Az"} {
+		if _, err := p.Require(k); err != nil {
+			return nil, err
+		}
+	}
+	productType, err := p.Require("ProductType")
+	if err != nil {
+		return nil, err
+	}
+	if len(p.Get("AdminPassword")) < 8 {
+		return nil, invalidParam("the AdminPassword must be at least 8 characters")
+	}
+	securityGroupId := p.Get("SecurityGroupId")
+	if securityGroupId == "" {
+		securityGroupId = s.newId()
+		s.store("clickhouse_security_group").put(securityGroupId, map[string]interface{}{
+			"SecurityGroupId":    securityGroupId,
+			"SecurityGroupName":  "default",
+			"SecurityGroupRules": []interface{}{},
+		})
+	} else if _, err = s.store("clickhouse_security_group").get(securityGroupId); err != nil {
+		return nil, err
+	}
+	id := s.newId()
+	replicas := 2
+	productTypeId := 2
+	if productType == "ClickHouse_Single" {
+		replicas = 1
+		productTypeId = 1
+	}
+	instance := map[string]interface{}{
+		"InstanceId":      id,
+		"InstanceName":    p["InstanceName"],
+		"InstacneConfig":  p["InstanceConfig"],
+		"AdminUser":       p.Get("AdminUser", "admin"),
+		"Status":          "creating",
+		"NetworkType":     "VPC",
+		"VpcId":           p["VpcId"],
+		"SubnetId":        p["SubnetId"],
+		"Vip":             "10.0.0.10",
+		"Engine":          "ClickHouse",
+		"EngineVersion":   p["EngineVersion"],
+		"ProjectId":       p.Get("ProjectId", DefaultProjectId),
+		"BillType":        p.Int("BillType", 1),
+		"EbsSize":         p.Int("EbsSize", 100),
+		"EbsType":         p.Get("EbsType", "ESSD_PL1"),
+		"TcpPort":         9000,
+		"HttpPort":        8123,
+		"ProductType":     productTypeId,
+		"ProductTypeName": productType,
+		"CreateDate":      now(),
+		"UpdateDate":      now(),
+		"Region":          DefaultRegion,
+		"Az":              p["Az"],
+		"UserId":          DefaultAccountId,
+		"SecurityGroupId": securityGroupId,
+		"Replicas":        replicas,
+	}
+	if err = setClickhouseShards(instance, p); err != nil {
+		return nil, err
+	}
+	s.store("clickhouse_instance").put(id, instance, after(s.PendingDescribes, setState("Status", "running"))...)
+	return clickhouseData(map[string]interface{}{"InstanceId": id}), nil
+}
+
+func describeClickhouseInstance(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	instances := s.store("clickhouse_instance").describe(func(data map[string]interface{}) bool {
+		return data["InstanceId"] == id
+	})
+	if len(instances) == 0 {
+		return nil, notFound("clickhouse_instance", id)
+	}
+	return clickhouseData(instances[0]), nil
+}
+
+// requireRunningClickhouseInstance returns the instance in the store, the changes are rejected unless it's running
+func (s *Server) requireRunningClickhouseInstance(p Params) (map[string]interface{}, error) {
+	id, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.store("clickhouse_instance").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if instance["Status"] != "running" {
+		return nil, invalidParam("the instance %s is %s", id, instance["Status"])
+	}
+	return instance, nil
+}
+
+func renameClickhouseInstance(s *Server, p Params) (map[string]interface{}, error) {
+	instance, err := s.requireRunningClickhouseInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("InstanceName")
+	if err != nil {
+		return nil, err
+	}
+	instance["InstanceName"] = name
+	instance["UpdateDate"] = now()
+	return clickhouseData(map[string]interface{}{"InstanceId": instance["InstanceId"]}), nil
+}
+
+func resizeClickhouseInstance(s *Server, p Params) (map[string]interface{}, error) {
+	instance, err := s.requireRunningClickhouseInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	if err = setClickhouseShards(instance, p); err != nil {
+		return nil, err
+	}
+	instance["Status"] = "scaling"
+	instance["UpdateDate"] = now()
+	s.store("clickhouse_instance").transit(instance["InstanceId"].(string), after(s.PendingDescribes-1, setState("Status", "running"))...)
+	return clickhouseData(map[string]interface{}{"InstanceId": instance["InstanceId"]}), nil
+}
+
+func deleteClickhouseInstance(s *Server, p Params) (map[string]interface{}, error) {
+	instance, err := s.requireRunningClickhouseInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	id := instance["InstanceId"].(string)
+	for _, account := range s.store("clickhouse_account").describe(nil) {
+		if account.(map[string]interface{})["InstanceId"] == id {
+			s.store("clickhouse_account").remove(krdsKey(id, account.(map[string]interface{})["InstanceAccountName"].(string)))
+		}
+	}
+	instance["Status"] = "deleting"
+	s.store("clickhouse_instance").transit(id, after(s.PendingDescribes-1, removed)...)
+	return clickhouseData(map[string]interface{}{"InstanceId": id}), nil
+}
+
+func createClickhouseAccount(s *Server, p Params) (map[string]interface{}, error) {
+	instance, err := s.requireRunningClickhouseInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("InstanceAccountName")
+	if err != nil {
+		return nil, err
+	}
+	key := krdsKey(instance["InstanceId"].(string), name)
+	if _, err = s.store("clickhouse_account").get(key); err == nil {
+		return nil, invalidParam("the account %s already exists", name)
+	}
+	password, err := accountPassword(p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("clickhouse_account").put(key, map[string]interface{}{
+		"InstanceId":                 instance["InstanceId"],
+		"InstanceAccountName":        name,
+		"InstanceAccountPassword":    password,
+		"InstanceAccountDescription": p.Get("InstanceAccountDescription"),
+		"InstanceAccountType":        "Normal",
+		"InstanceAccountStatus":      "ACTIVE",
+	})
+	return clickhouseData(map[string]interface{}{"InstanceAccountName": name}), nil
+}
+
+func describeClickhouseAccounts(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("clickhouse_instance").get(id); err != nil {
+		return nil, err
+	}
+	name := p.Get("InstanceAccountName")
+	accounts := s.store("clickhouse_account").describe(func(data map[string]interface{}) bool {
+		return data["InstanceId"] == id && (name == "" || data["InstanceAccountName"] == name)
+	})
+	for _, v := range accounts {
+		// the password isn't returned by the api
+		delete(v.(map[string]interface{}), "InstanceAccountPassword")
+	}
+	return clickhouseData(map[string]interface{}{"InstanceAccounts": accounts}), nil
+}
+
+func (s *Server) requireClickhouseAccount(p Params) (map[string]interface{}, error) {
+	id, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("InstanceAccountName")
+	if err != nil {
+		return nil, err
+	}
+	return s.store("clickhouse_account").get(krdsKey(id, name))
+}
+
+func modifyClickhouseAccount(s *Server, p Params) (map[string]interface{}, error) {
+	account, err := s.requireClickhouseAccount(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p["InstanceAccountPassword"]; ok {
+		password, err := accountPassword(p)
+		if err != nil {
+			return nil, err
+		}
+		account["InstanceAccountPassword"] = password
+	}
+	if v, ok := p["InstanceAccountDescription"]; ok {
+		account["InstanceAccountDescription"] = v
+	}
+	return clickhouseData(map[string]interface{}{"InstanceAccountName": account["InstanceAccountName"]}), nil
+}
+
+func deleteClickhouseAccount(s *Server, p Params) (map[string]interface{}, error) {
+	account, err := s.requireClickhouseAccount(p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("clickhouse_account").remove(krdsKey(account["InstanceId"].(string), account["InstanceAccountName"].(string)))
+	return clickhouseData(map[string]interface{}{"InstanceAccountName": account["InstanceAccountName"]}), nil
+}
+
+func (s *Server) requireClickhouseSecurityGroup(p Params) (map[string]interface{}, error) {
+	id, err := p.Require("SecurityGroupId")
+	if err != nil {
+		return nil, err
+	}
+	return s.store("clickhouse_security_group").get(id)
+}
+
+func describeClickhouseSecurityGroup(s *Server, p Params) (map[string]interface{}, error) {
+	group, err := s.requireClickhouseSecurityGroup(p)
+	if err != nil {
+		return nil, err
+	}
+	return clickhouseData(copyValue(group)), nil
+}
+
+func createClickhouseSecurityRule(s *Server, p Params) (map[string]interface{}, error) {
+	group, err := s.requireClickhouseSecurityGroup(p)
+	if err != nil {
+		return nil, err
+	}
+	rules := group["SecurityGroupRules"].([]interface{})
+	for i := 1; ; i++ {
+		item := p.Sub("SecurityGroupRules." + strconv.Itoa(i))
+		if len(item) == 0 {
+			break
+		}
+		cidr, err := item.Require("Cidr")
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range rules {
+			if v.(map[string]interface{})["Cidr"] == cidr {
+				return nil, invalidParam("the cidr %s already exists", cidr)
+			}
+		}
+		rules = append(rules, map[string]interface{}{
+			"SecurityGroupRuleId": s.newId(),
+			"Cidr":                cidr,
+			"Protocol":            item.Get("Protocol", "IPv4"),
+			"Description":         item.Get("Description"),
+			"CreateTime":          now(),
+		})
+	}
+	group["SecurityGroupRules"] = rules
+	return clickhouseData(map[string]interface{}{"SecurityGroupId": group["SecurityGroupId"]}), nil
+}
+
+func deleteClickhouseSecurityRule(s *Server, p Params) (map[string]interface{}, error) {
+	group, err := s.requireClickhouseSecurityGroup(p)
+	if err != nil {
+		return nil, err
+	}
+	ids := p.List("SecurityGroupRuleId")
+	if len(ids) == 0 {
+		return nil, invalidParam("the param SecurityGroupRuleId.1 is required")
+	}
+	rules := make([]interface{}, 0)
+	for _, v := range group["SecurityGroupRules"].([]interface{}) {
+		if !matchIds(v.(map[string]interface{}), "SecurityGroupRuleId", ids) {
+			rules = append(rules, v)
+		}
+	}
+	if len(rules) == len(group["SecurityGroupRules"].([]interface{})) {
+		return nil, notFound("clickhouse_security_rule", p.Get("SecurityGroupRuleId.1"))
+	}
+	group["SecurityGroupRules"] = rules
+	return clickhouseData(map[string]interface{}{"SecurityGroupId": group["SecurityGroupId"]}), nil
+}
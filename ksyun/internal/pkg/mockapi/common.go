@@ -0,0 +1,159 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultProjectId is the default project of the mock account,
+// the other projects are the ones that the resources are created in.
+const DefaultProjectId = "0"
+
+// DefaultAccountId is the id of the mock account
+const DefaultAccountId = "2000000001"
+
+// DefaultRegion is the region of the resources that don't specify one
+const DefaultRegion = "cn-beijing-6"
+
+func registerCommonHandlers(s *Server) {
+	s.handlers["GetAccountAllProjectList"] = getAccountAllProjectList
+	s.handlers["ReplaceResourcesTags"] = replaceResourcesTags
+	s.handlers["ListTagsByResourceIds"] = listTagsByResourceIds
+	s.handlers["UpdateInstanceProjectId"] = updateInstanceProjectId
+}
+
+func getAccountAllProjectList(s *Server, p Params) (map[string]interface{}, error) {
+	projectIds := map[string]bool{DefaultProjectId: true}
+	for _, st := range s.stores {
+		for _, o := range st.objects {
+			if v, ok := o.data["ProjectId"].(string); ok && v != "" {
+				projectIds[v] = true
+			}
+		}
+	}
+	ids := make([]int, 0, len(projectIds))
+	for v := range projectIds {
+		if id, err := strconv.Atoi(v); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	projects := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		name := fmt.Sprintf("project-%d", id)
+		if strconv.Itoa(id) == DefaultProjectId {
+			name = "默认项目"
+		}
+		projects = append(projects, map[string]interface{}{
+			"ProjectId":   id,
+			"ProjectName": name,
+			"Status":      1,
+		})
+	}
+	return map[string]interface{}{
+		"ListProjectResult": map[string]interface{}{
+			"ProjectList": projects,
+		},
+	}, nil
+}
+
+// tagKey is the key of the tags store, the tags are kept by resource type and id
+func tagKey(resourceType, resourceId string) string {
+	return resourceType + "/" + resourceId
+}
+
+func (s *Server) setTags(resourceType, resourceId string, tags map[string]string) {
+	data := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		data[k] = v
+	}
+	s.store("tag").put(tagKey(resourceType, resourceId), data)
+}
+
+func replaceResourcesTags(s *Server, p Params) (map[string]interface{}, error) {
+	resourceType, err := p.Require("ResourceType")
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	// the provider numbers the tags from 0 on creating, and from 1 on updating
+	for i := 0; ; i++ {
+		key, ok := p["Tag_"+strconv.Itoa(i)+"_Key"]
+		if !ok {
+			if i == 0 {
+				continue
+			}
+			break
+		}
+		tags[key] = p["Tag_"+strconv.Itoa(i)+"_Value"]
+	}
+	for _, resource := range p.set("ReplaceTags") {
+		for _, id := range strings.Split(resource.(map[string]interface{})["ResourceUuids"].(string), ",") {
+			s.setTags(resourceType, id, tags)
+		}
+	}
+	return map[string]interface{}{"Result": true}, nil
+}
+
+func listTagsByResourceIds(s *Server, p Params) (map[string]interface{}, error) {
+	resourceType, err := p.Require("ResourceType")
+	if err != nil {
+		return nil, err
+	}
+	ids, err := p.Require("ResourceUuids")
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]interface{}, 0)
+	for _, id := range strings.Split(ids, ",") {
+		data, err := s.store("tag").get(tagKey(resourceType, id))
+		if err != nil {
+			continue
+		}
+		for k, v := range data {
+			tags = append(tags, map[string]interface{}{
+				"ResourceUuid": id,
+				"ResourceType": resourceType,
+				"TagKey":       k,
+				"TagValue":     v,
+			})
+		}
+	}
+	return map[string]interface{}{"Tags": tags}, nil
+}
+
+// updateInstanceProjectId moves the instance of any service keeping the ProjectId to the project
+func updateInstanceProjectId(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	projectId, err := p.Require("ProjectId")
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range s.stores {
+		if o, ok := st.objects[id]; ok {
+			if _, ok = o.data["ProjectId"]; ok {
+				o.data["ProjectId"] = projectId
+				return map[string]interface{}{"Result": true}, nil
+			}
+		}
+	}
+	return nil, notFound("instance", id)
+}
+
+// dryRun responds the DryRun requests with 412 as the real api does when the params are valid
+func dryRun(p Params) error {
+	if p.Bool("DryRun") {
+		return &Error{
+			StatusCode: http.StatusPreconditionFailed,
+			Code:       "DryRunOperation",
+			Message:    "Request would have succeeded, but DryRun flag is set.",
+		}
+	}
+	return nil
+}
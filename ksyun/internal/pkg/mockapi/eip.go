@@ -0,0 +1,146 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DefaultLineId is the BGP line of the mock region
+const DefaultLineId = "5fc2595f-1bfd-481b-bf64-2d08f116d800"
+
+func registerEipHandlers(s *Server) {
+	s.handlers["GetLines"] = getLines
+	s.handlers["AllocateAddress"] = allocateAddress
+	s.handlers["DescribeAddresses"] = describeAddresses
+	s.handlers["ModifyAddress"] = modifyAddress
+	s.handlers["AssociateAddress"] = associateAddress
+	s.handlers["DisassociateAddress"] = disassociateAddress
+	s.handlers["ReleaseAddress"] = releaseAddress
+}
+
+func getLines(s *Server, p Params) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"LineSet": []interface{}{
+			map[string]interface{}{
+				"LineId":    DefaultLineId,
+				"LineName":  "BGP",
+				"LineType":  "BGP",
+				"IpVersion": "ipv4",
+			},
+		},
+	}, nil
+}
+
+func allocateAddress(s *Server, p Params) (map[string]interface{}, error) {
+	bandWidth := p.Int("BandWidth", 0)
+	if bandWidth <= 0 {
+		return nil, invalidParam("the BandWidth %s is invalid", p.Get("BandWidth"))
+	}
+	chargeType, err := p.Require("ChargeType")
+	if err != nil {
+		return nil, err
+	}
+	id := s.newId()
+	eip := map[string]interface{}{
+		"AllocationId":       id,
+		"PublicIp":           fmt.Sprintf("120.92.%d.%d", s.seq/256%256, s.seq%256),
+		"LineId":             p.Get("LineId", DefaultLineId),
+		"BandWidth":          bandWidth,
+		"ChargeType":         chargeType,
+		"ProjectId":          p.Get("ProjectId", DefaultProjectId),
+		"State":              "disassociate",
+		"IpVersion":          "ipv4",
+		"InstanceType":       "",
+		"InstanceId":         "",
+		"NetworkInterfaceId": "",
+		"CreateTime":         now(),
+	}
+	s.store("eip").put(id, eip)
+	return map[string]interface{}{
+		"AllocationId": id,
+		"PublicIp":     eip["PublicIp"],
+	}, nil
+}
+
+func describeAddresses(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("AllocationId")
+	projectIds := p.List("ProjectId")
+	filters := p.Filters()
+	eips := s.store("eip").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "AllocationId", ids) && matchIds(data, "ProjectId", projectIds) &&
+			matchFilters(data, filters, map[string]string{
+				"instance-type":        "InstanceType",
+				"instance-id":          "InstanceId",
+				"network-interface-id": "NetworkInterfaceId",
+				"state":                "State",
+			})
+	})
+	return map[string]interface{}{"AddressesSet": eips}, nil
+}
+
+func modifyAddress(s *Server, p Params) (map[string]interface{}, error) {
+	eip, err := s.requireEip(p)
+	if err != nil {
+		return nil, err
+	}
+	if bandWidth := p.Int("BandWidth", 0); bandWidth > 0 {
+		eip["BandWidth"] = bandWidth
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func associateAddress(s *Server, p Params) (map[string]interface{}, error) {
+	eip, err := s.requireEip(p)
+	if err != nil {
+		return nil, err
+	}
+	if eip["State"] == "associate" {
+		return nil, inUse("eip", eip["AllocationId"].(string), eip["InstanceId"].(string))
+	}
+	instanceId, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	eip["InstanceType"] = p.Get("InstanceType", "Ipfwd")
+	eip["InstanceId"] = instanceId
+	eip["NetworkInterfaceId"] = p.Get("NetworkInterfaceId")
+	eip["State"] = "associate"
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func disassociateAddress(s *Server, p Params) (map[string]interface{}, error) {
+	eip, err := s.requireEip(p)
+	if err != nil {
+		return nil, err
+	}
+	eip["InstanceType"] = ""
+	eip["InstanceId"] = ""
+	eip["NetworkInterfaceId"] = ""
+	eip["State"] = "disassociate"
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func releaseAddress(s *Server, p Params) (map[string]interface{}, error) {
+	eip, err := s.requireEip(p)
+	if err != nil {
+		return nil, err
+	}
+	if eip["State"] == "associate" {
+		return nil, &Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "AddressInUse",
+			Message:    "the eip is associated, disassociate it before releasing",
+		}
+	}
+	s.store("eip").remove(eip["AllocationId"].(string))
+	s.store("tag").remove(tagKey("eip", eip["AllocationId"].(string)))
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func (s *Server) requireEip(p Params) (map[string]interface{}, error) {
+	id, err := p.Require("AllocationId")
+	if err != nil {
+		return nil, err
+	}
+	return s.store("eip").get(id)
+}
@@ -0,0 +1,496 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func registerIamHandlers(s *Server) {
+	s.handlers["CreateUser"] = createUser
+	s.handlers["GetUser"] = getUser
+	s.handlers["ListUsers"] = listUsers
+	s.handlers["DeleteUser"] = deleteUser
+	s.handlers["CreateRole"] = createRole
+	s.handlers["GetRole"] = getRole
+	s.handlers["DeleteRole"] = deleteRole
+	s.handlers["CreateGroup"] = createGroup
+	s.handlers["GetGroup"] = getGroup
+	s.handlers["DeleteGroup"] = deleteGroup
+	s.handlers["AddUserToGroup"] = addUserToGroup
+	s.handlers["RemoveUserFromGroup"] = removeUserFromGroup
+	s.handlers["ListGroupsForUser"] = listGroupsForUser
+	s.handlers["AttachGroupPolicy"] = attachGroupPolicy
+	s.handlers["DetachGroupPolicy"] = detachGroupPolicy
+	s.handlers["ListGroupPolicies"] = listGroupPolicies
+	s.handlers["CreateLoginProfile"] = createLoginProfile
+	s.handlers["GetLoginProfile"] = getLoginProfile
+	s.handlers["UpdateLoginProfile"] = updateLoginProfile
+	s.handlers["DeleteLoginProfile"] = deleteLoginProfile
+	s.handlers["CreateAccessKey"] = createAccessKey
+	s.handlers["ListAccessKeys"] = listAccessKeys
+	s.handlers["UpdateAccessKey"] = updateAccessKey
+	s.handlers["DeleteAccessKey"] = deleteAccessKey
+}
+
+// noSuchEntity is the not found error of iam, such as UserNoSuchEntity
+func noSuchEntity(kind, name string) *Error {
+	return &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       kind + "NoSuchEntity",
+		Message:    fmt.Sprintf("The %s with name %s cannot be found.", strings.ToLower(kind), name),
+	}
+}
+
+func iamConflict(code, format string, a ...interface{}) *Error {
+	return &Error{
+		StatusCode: http.StatusConflict,
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+func iamKrn(kind, name string) string {
+	return fmt.Sprintf("krn:ksc:iam::%s:%s/%s", DefaultAccountId, kind, name)
+}
+
+// requireIamEntity returns the user, group or role named by the param, the iam entities are kept by name
+func (s *Server) requireIamEntity(kind string, p Params) (string, map[string]interface{}, error) {
+	name, err := p.Require(kind + "Name")
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := s.store("iam_" + strings.ToLower(kind)).get(name)
+	if err != nil {
+		return "", nil, noSuchEntity(kind, name)
+	}
+	return name, data, nil
+}
+
+// memberKey is the key of the group members store
+func memberKey(groupName, userName string) string {
+	return groupName + "/" + userName
+}
+
+func createUser(s *Server, p Params) (map[string]interface{}, error) {
+	name, err := p.Require("UserName")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("iam_user").get(name); err == nil {
+		return nil, iamConflict("UserAlreadyExists", "The user with name %s already exists.", name)
+	}
+	user := map[string]interface{}{
+		"UserName":   name,
+		"UserId":     s.newId(),
+		"Krn":        iamKrn("user", name),
+		"RealName":   p.Get("RealName"),
+		"Email":      p.Get("Email"),
+		"Phone":      p.Get("Phone"),
+		"Remark":     p.Get("Remark"),
+		"CreateDate": now(),
+	}
+	s.store("iam_user").put(name, user)
+	// the user created with the password is able to login the console
+	if _, ok := p["Password"]; ok {
+		s.store("iam_login_profile").put(name, loginProfile(name, p))
+	}
+	return map[string]interface{}{
+		"CreateUserResult": map[string]interface{}{"User": copyValue(user)},
+	}, nil
+}
+
+// loginProfile returns the login profile of the user by the params of CreateUser or CreateLoginProfile
+func loginProfile(userName string, p Params) map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":               userName,
+		"PasswordResetRequired":  p.Bool("PasswordResetRequired"),
+		"OpenLoginProtection":    p.Int("OpenLoginProtection", 0),
+		"OpenSecurityProtection": p.Int("OpenSecurityProtection", 0),
+		"ViewAllProject":         p.Int("ViewAllProject", 0),
+		"CreateDate":             now(),
+	}
+}
+
+func getUser(s *Server, p Params) (map[string]interface{}, error) {
+	_, user, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"GetUserResult": map[string]interface{}{"User": copyValue(user)},
+	}, nil
+}
+
+func listUsers(s *Server, p Params) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"ListUserResult": map[string]interface{}{
+			"Users": map[string]interface{}{"member": s.store("iam_user").describe(nil)},
+		},
+	}, nil
+}
+
+func deleteUser(s *Server, p Params) (map[string]interface{}, error) {
+	name, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range s.store("iam_group_member").objects {
+		if member.data["UserName"] == name {
+			return nil, iamConflict("UserGroupDeleteConflict", "The user %s is in the group %s.", name, member.data["GroupName"])
+		}
+	}
+	s.store("iam_user").remove(name)
+	s.store("iam_login_profile").remove(name)
+	for _, key := range s.store("iam_access_key").describe(nil) {
+		if key := key.(map[string]interface{}); key["UserName"] == name {
+			s.store("iam_access_key").remove(key["AccessKeyId"].(string))
+		}
+	}
+	return map[string]interface{}{}, nil
+}
+
+func createRole(s *Server, p Params) (map[string]interface{}, error) {
+	name, err := p.Require("RoleName")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("iam_role").get(name); err == nil {
+		return nil, iamConflict("RoleAlreadyExists", "The role with name %s already exists.", name)
+	}
+	accounts, err := p.Require("TrustAccounts")
+	if err != nil {
+		return nil, err
+	}
+	role := map[string]interface{}{
+		"RoleName":      name,
+		"RoleId":        s.newId(),
+		"Krn":           iamKrn("role", name),
+		"TrustAccounts": accounts,
+		"TrustType":     1,
+		"Description":   p.Get("Description"),
+		"CreateDate":    now(),
+	}
+	s.store("iam_role").put(name, role)
+	return map[string]interface{}{
+		"CreateRoleResult": map[string]interface{}{"Role": copyValue(role)},
+	}, nil
+}
+
+func getRole(s *Server, p Params) (map[string]interface{}, error) {
+	_, role, err := s.requireIamEntity("Role", p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"GetRoleResult": map[string]interface{}{"Role": copyValue(role)},
+	}, nil
+}
+
+func deleteRole(s *Server, p Params) (map[string]interface{}, error) {
+	name, _, err := s.requireIamEntity("Role", p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("iam_role").remove(name)
+	return map[string]interface{}{}, nil
+}
+
+func createGroup(s *Server, p Params) (map[string]interface{}, error) {
+	name, err := p.Require("GroupName")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("iam_group").get(name); err == nil {
+		return nil, iamConflict("GroupAlreadyExists", "The group with name %s already exists.", name)
+	}
+	group := map[string]interface{}{
+		"GroupName":   name,
+		"GroupId":     s.newId(),
+		"Krn":         iamKrn("group", name),
+		"Description": p.Get("Description"),
+		"CreateDate":  now(),
+	}
+	s.store("iam_group").put(name, group)
+	return map[string]interface{}{
+		"CreateGroupResult": map[string]interface{}{"Group": copyValue(group)},
+	}, nil
+}
+
+func getGroup(s *Server, p Params) (map[string]interface{}, error) {
+	_, group, err := s.requireIamEntity("Group", p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"GetGroupResult": map[string]interface{}{"Group": copyValue(group)},
+	}, nil
+}
+
+func deleteGroup(s *Server, p Params) (map[string]interface{}, error) {
+	name, _, err := s.requireIamEntity("Group", p)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range s.store("iam_group_member").objects {
+		if member.data["GroupName"] == name {
+			return nil, iamConflict("GroupUserDeleteConflict", "The group %s has the user %s.", name, member.data["UserName"])
+		}
+	}
+	for _, policy := range s.store("iam_group_policy").objects {
+		if policy.data["GroupName"] == name {
+			return nil, iamConflict("GroupPolicyDeleteConflict", "The group %s has the policy %s.", name, policy.data["PolicyKrn"])
+		}
+	}
+	s.store("iam_group").remove(name)
+	return map[string]interface{}{}, nil
+}
+
+func addUserToGroup(s *Server, p Params) (map[string]interface{}, error) {
+	groupName, _, err := s.requireIamEntity("Group", p)
+	if err != nil {
+		return nil, err
+	}
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("iam_group_member").put(memberKey(groupName, userName), map[string]interface{}{
+		"GroupName": groupName,
+		"UserName":  userName,
+	})
+	return map[string]interface{}{}, nil
+}
+
+func removeUserFromGroup(s *Server, p Params) (map[string]interface{}, error) {
+	groupName, _, err := s.requireIamEntity("Group", p)
+	if err != nil {
+		return nil, err
+	}
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("iam_group_member").get(memberKey(groupName, userName)); err != nil {
+		return nil, noSuchEntity("GroupUser", userName)
+	}
+	s.store("iam_group_member").remove(memberKey(groupName, userName))
+	return map[string]interface{}{}, nil
+}
+
+// listGroupsForUser responds without the Groups when the user is in no group, as the real api does
+func listGroupsForUser(s *Server, p Params) (map[string]interface{}, error) {
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]interface{}, 0)
+	for _, member := range s.store("iam_group_member").describe(nil) {
+		if member := member.(map[string]interface{}); member["UserName"] == userName {
+			group, _ := s.store("iam_group").get(member["GroupName"].(string))
+			groups = append(groups, copyValue(group))
+		}
+	}
+	result := map[string]interface{}{}
+	if len(groups) > 0 {
+		result["Groups"] = map[string]interface{}{"member": groups}
+	}
+	return map[string]interface{}{"ListGroupsForUserResult": result}, nil
+}
+
+func attachGroupPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	groupName, _, err := s.requireIamEntity("Group", p)
+	if err != nil {
+		return nil, err
+	}
+	krn, err := p.Require("PolicyKrn")
+	if err != nil {
+		return nil, err
+	}
+	items := strings.Split(krn, ":policy/")
+	if len(items) != 2 {
+		return nil, invalidParam("the PolicyKrn %s is invalid", krn)
+	}
+	// the system policies are owned by ksc, the type is 1
+	policyType := 2
+	if strings.HasPrefix(krn, "krn:ksc:iam::ksc:") {
+		policyType = 1
+	}
+	s.store("iam_group_policy").put(memberKey(groupName, krn), map[string]interface{}{
+		"GroupName":  groupName,
+		"PolicyKrn":  krn,
+		"PolicyName": items[1],
+		"Type":       policyType,
+		"CreateTime": now(),
+	})
+	return map[string]interface{}{}, nil
+}
+
+func detachGroupPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	groupName, _, err := s.requireIamEntity("Group", p)
+	if err != nil {
+		return nil, err
+	}
+	krn, err := p.Require("PolicyKrn")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("iam_group_policy").get(memberKey(groupName, krn)); err != nil {
+		return nil, noSuchEntity("GroupPolicy", krn)
+	}
+	s.store("iam_group_policy").remove(memberKey(groupName, krn))
+	return map[string]interface{}{}, nil
+}
+
+func listGroupPolicies(s *Server, p Params) (map[string]interface{}, error) {
+	groupName, _, err := s.requireIamEntity("Group", p)
+	if err != nil {
+		return nil, err
+	}
+	policies := s.store("iam_group_policy").describe(func(data map[string]interface{}) bool {
+		return data["GroupName"] == groupName
+	})
+	return map[string]interface{}{
+		"ListGroupPoliciesResult": map[string]interface{}{
+			"AttachedPolicies": map[string]interface{}{"member": policies},
+			"IsTruncated":      false,
+		},
+	}, nil
+}
+
+func createLoginProfile(s *Server, p Params) (map[string]interface{}, error) {
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = p.Require("Password"); err != nil {
+		return nil, err
+	}
+	if _, err = s.store("iam_login_profile").get(userName); err == nil {
+		return nil, iamConflict("LoginProfileAlreadyExists", "The login profile of user %s already exists.", userName)
+	}
+	profile := loginProfile(userName, p)
+	s.store("iam_login_profile").put(userName, profile)
+	return map[string]interface{}{
+		"CreateLoginProfileResult": map[string]interface{}{"LoginProfile": copyValue(profile)},
+	}, nil
+}
+
+func (s *Server) requireLoginProfile(p Params) (map[string]interface{}, error) {
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.store("iam_login_profile").get(userName)
+	if err != nil {
+		return nil, noSuchEntity("LoginProfile", userName)
+	}
+	return profile, nil
+}
+
+func getLoginProfile(s *Server, p Params) (map[string]interface{}, error) {
+	profile, err := s.requireLoginProfile(p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"GetLoginProfileResult": map[string]interface{}{"LoginProfile": copyValue(profile)},
+	}, nil
+}
+
+func updateLoginProfile(s *Server, p Params) (map[string]interface{}, error) {
+	profile, err := s.requireLoginProfile(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p["PasswordResetRequired"]; ok {
+		profile["PasswordResetRequired"] = p.Bool("PasswordResetRequired")
+	}
+	return map[string]interface{}{}, nil
+}
+
+func deleteLoginProfile(s *Server, p Params) (map[string]interface{}, error) {
+	profile, err := s.requireLoginProfile(p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("iam_login_profile").remove(profile["UserName"].(string))
+	return map[string]interface{}{}, nil
+}
+
+func createAccessKey(s *Server, p Params) (map[string]interface{}, error) {
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	seq := s.newId()
+	id := "AKLT" + strings.ToUpper(seq[len(seq)-12:])
+	key := map[string]interface{}{
+		"UserName":    userName,
+		"AccessKeyId": id,
+		"Status":      "Active",
+		"CreateDate":  now(),
+	}
+	s.store("iam_access_key").put(id, key)
+	created := copyValue(key).(map[string]interface{})
+	created["SecretAccessKey"] = "secret-" + id
+	return map[string]interface{}{
+		"CreateAccessKeyResult": map[string]interface{}{"AccessKey": created},
+	}, nil
+}
+
+func listAccessKeys(s *Server, p Params) (map[string]interface{}, error) {
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	keys := s.store("iam_access_key").describe(func(data map[string]interface{}) bool {
+		return data["UserName"] == userName
+	})
+	return map[string]interface{}{
+		"ListAccessKeysResult": map[string]interface{}{
+			"AccessKeyMetadata": map[string]interface{}{"member": keys},
+		},
+	}, nil
+}
+
+func (s *Server) requireAccessKey(p Params) (map[string]interface{}, error) {
+	userName, _, err := s.requireIamEntity("User", p)
+	if err != nil {
+		return nil, err
+	}
+	id, err := p.Require("AccessKeyId")
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.store("iam_access_key").get(id)
+	if err != nil || key["UserName"] != userName {
+		return nil, noSuchEntity("AccessKey", id)
+	}
+	return key, nil
+}
+
+func updateAccessKey(s *Server, p Params) (map[string]interface{}, error) {
+	key, err := s.requireAccessKey(p)
+	if err != nil {
+		return nil, err
+	}
+	status, err := p.Require("Status")
+	if err != nil {
+		return nil, err
+	}
+	if status != "Active" && status != "Inactive" {
+		return nil, invalidParam("the Status %s is invalid", status)
+	}
+	key["Status"] = status
+	return map[string]interface{}{}, nil
+}
+
+func deleteAccessKey(s *Server, p Params) (map[string]interface{}, error) {
+	key, err := s.requireAccessKey(p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("iam_access_key").remove(key["AccessKeyId"].(string))
+	return map[string]interface{}{}, nil
+}
@@ -0,0 +1,190 @@
+package mockapi
+
+import (
+	"sort"
+)
+
+func registerImageHandlers(s *Server) {
+	s.handlers["CreateImage"] = createImage
+	s.handlers["ImportImage"] = importImage
+	s.handlers["CopyImage"] = copyImage
+	s.handlers["DescribeImages"] = describeImages
+	s.handlers["ModifyImageAttribute"] = modifyImageAttribute
+	s.handlers["RemoveImages"] = removeImages
+	s.handlers["DescribeImageSharePermission"] = describeImageSharePermission
+	s.handlers["ModifyImageSharePermission"] = modifyImageSharePermission
+}
+
+// putImage keeps the image as pending, it becomes active after the pending describes
+func (s *Server) putImage(image map[string]interface{}) string {
+	id := "IMG-" + s.newId()
+	image["ImageId"] = id
+	image["ImageState"] = "pending"
+	image["CreationDate"] = now()
+	if _, ok := image["SysDisk"]; !ok {
+		image["SysDisk"] = 20
+	}
+	s.store("image").put(id, image, after(s.PendingDescribes, setState("ImageState", "active"))...)
+	return id
+}
+
+func createImage(s *Server, p Params) (map[string]interface{}, error) {
+	name, err := p.Require("Name")
+	if err != nil {
+		return nil, err
+	}
+	image := map[string]interface{}{
+		"Name":        name,
+		"ImageSource": "system",
+		"Platform":    "centos-7.9",
+	}
+	instanceId, snapshotId := p.Get("InstanceId"), p.Get("SnapshotId")
+	switch {
+	case instanceId != "" && snapshotId != "":
+		return nil, invalidParam("only one of InstanceId and SnapshotId is allowed")
+	case instanceId != "":
+		instance, err := s.requireInstance(instanceId)
+		if err != nil {
+			return nil, err
+		}
+		image["InstanceId"] = instanceId
+		image["SysDisk"] = instance["SystemDisk"].(map[string]interface{})["DiskSize"]
+	case snapshotId != "":
+		image["ImageSource"] = "snapshot"
+	default:
+		return nil, invalidParam("one of InstanceId and SnapshotId is required")
+	}
+	return map[string]interface{}{"ImageId": s.putImage(image)}, nil
+}
+
+func importImage(s *Server, p Params) (map[string]interface{}, error) {
+	for _, k := range []string{"ImageName", "ImageUrl", "ImageFormat", "Platform"} {
+		if _, err := p.Require(k); err != nil {
+			return nil, err
+		}
+	}
+	image := map[string]interface{}{
+		"Name":         p.Get("ImageName"),
+		"ImageSource":  "import",
+		"Platform":     p.Get("Platform"),
+		"Architecture": p.Get("Architecture", "x86_64"),
+	}
+	return map[string]interface{}{"ImageId": s.putImage(image)}, nil
+}
+
+// copyImage copies the image in the same store, the regions are not simulated
+func copyImage(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("ImageId")
+	regions := p.List("DestinationRegion")
+	if len(ids) == 0 || len(regions) == 0 {
+		return nil, invalidParam("the params ImageId.1 and DestinationRegion.1 are required")
+	}
+	images := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		source, err := s.store("image").get(id)
+		if err != nil {
+			return nil, err
+		}
+		image := map[string]interface{}{
+			"Name":        p.Get("DestinationImageName", source["Name"].(string)),
+			"ImageSource": "copy",
+			"Platform":    source["Platform"],
+			"SysDisk":     source["SysDisk"],
+		}
+		images = append(images, map[string]interface{}{"ImageId": s.putImage(image)})
+	}
+	return map[string]interface{}{"ImageIdSet": images}, nil
+}
+
+func describeImages(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("ImageId")
+	images := s.store("image").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "ImageId", ids)
+	})
+	return map[string]interface{}{"ImagesSet": images}, nil
+}
+
+func modifyImageAttribute(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("ImageId")
+	if err != nil {
+		return nil, err
+	}
+	image, err := s.store("image").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if name, ok := p["Name"]; ok {
+		image["Name"] = name
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func removeImages(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("ImageId")
+	set := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		if _, err := s.store("image").get(id); err != nil {
+			return nil, err
+		}
+		s.store("image").remove(id)
+		s.store("image_share").remove(id)
+		set = append(set, map[string]interface{}{"ImageId": id, "Return": true})
+	}
+	return map[string]interface{}{"ReturnSet": set}, nil
+}
+
+func describeImageSharePermission(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("ImageId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("image").get(id); err != nil {
+		return nil, err
+	}
+	accounts := make([]string, 0)
+	if shared, err := s.store("image_share").get(id); err == nil {
+		for account := range shared {
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Strings(accounts)
+	set := make([]interface{}, 0, len(accounts))
+	for _, account := range accounts {
+		set = append(set, map[string]interface{}{"AccountId": account})
+	}
+	return map[string]interface{}{"AccountSet": set}, nil
+}
+
+func modifyImageSharePermission(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("ImageId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("image").get(id); err != nil {
+		return nil, err
+	}
+	permission, err := p.Require("Permission")
+	if err != nil {
+		return nil, err
+	}
+	accounts := p.List("AccountId")
+	if len(accounts) == 0 {
+		return nil, invalidParam("the param AccountId.1 is required")
+	}
+	shared, err := s.store("image_share").get(id)
+	if err != nil {
+		shared = map[string]interface{}{}
+		s.store("image_share").put(id, shared)
+	}
+	for _, account := range accounts {
+		switch permission {
+		case "share":
+			shared[account] = true
+		case "cancel":
+			delete(shared, account)
+		default:
+			return nil, invalidParam("the Permission %s is not supported", permission)
+		}
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
@@ -0,0 +1,306 @@
+package mockapi
+
+import (
+	"strconv"
+)
+
+func registerKceHandlers(s *Server) {
+	s.handlers["CreateNodePool"] = createNodePool
+	s.handlers["DescribeNodePool"] = describeNodePool
+	s.handlers["ModifyNodePool"] = modifyNodePool
+	s.handlers["ModifyNodeTemplate"] = modifyNodeTemplate
+	s.handlers["DeleteNodePool"] = deleteNodePool
+	s.handlers["DescribeClusterInstance"] = describeClusterInstance
+	s.handlers["DeleteClusterInstancesFromNodePool"] = deleteClusterInstancesFromNodePool
+}
+
+// nodeTemplate reads the NodeTemplate.* params, the clusters are not simulated,
+// so the subnets and the images are not checked.
+func nodeTemplate(p Params) (map[string]interface{}, error) {
+	template := make(map[string]interface{})
+	for _, k := range []string{"InstanceType", "ImageId", "SecurityGroupId"} {
+		v, err := p.Require("NodeTemplate." + k)
+		if err != nil {
+			return nil, err
+		}
+		template[k] = v
+	}
+	subnetIds := make([]interface{}, 0)
+	for _, id := range p.List("NodeTemplate.SubnetId") {
+		subnetIds = append(subnetIds, id)
+	}
+	if len(subnetIds) == 0 {
+		return nil, invalidParam("the param NodeTemplate.SubnetId.1 is required")
+	}
+	keys := make([]interface{}, 0)
+	for _, key := range p.List("NodeTemplate.KeyId") {
+		keys = append(keys, key)
+	}
+	dataDisks := make([]interface{}, 0)
+	for _, raw := range p.set("NodeTemplate.DataDisk") {
+		disk := raw.(map[string]interface{})
+		size, _ := strconv.Atoi(disk["Size"].(string))
+		dataDisks = append(dataDisks, map[string]interface{}{"Type": disk["Type"], "Size": size})
+	}
+	template["SubnetId"] = subnetIds
+	template["KeyId"] = keys
+	template["DataDisk"] = dataDisks
+	template["ChargeType"] = p.Get("NodeTemplate.ChargeType", "HourlyInstantSettlement")
+	template["SystemDisk"] = map[string]interface{}{
+		"DiskType": p.Get("NodeTemplate.SystemDisk.DiskType", "SSD3.0"),
+		"DiskSize": p.Int("NodeTemplate.SystemDisk.DiskSize", 20),
+	}
+	return template, nil
+}
+
+// advancedSetting reads the AdvancedSetting.* params, the log limits are returned as strings like the kce api.
+func advancedSetting(p Params) map[string]interface{} {
+	setting := map[string]interface{}{
+		"ContainerRuntime": p.Get("AdvancedSetting.ContainerRuntime", "docker"),
+		"Label":            p.set("AdvancedSetting.Label"),
+		"Taints":           p.set("AdvancedSetting.Taints"),
+	}
+	for _, k := range []string{"DockerPath", "ContainerPath", "UserScript", "PreUserScript", "ContainerLogMaxSize", "ContainerLogMaxFiles"} {
+		if v, ok := p["AdvancedSetting."+k]; ok {
+			setting[k] = v
+		}
+	}
+	if args := p.set("AdvancedSetting.ExtraArg.Kubelet"); len(args) > 0 {
+		extraArgs := make([]interface{}, 0, len(args))
+		for _, arg := range args {
+			extraArgs = append(extraArgs, arg.(map[string]interface{})["CustomArg"])
+		}
+		setting["ExtraArg"] = extraArgs
+	}
+	return setting
+}
+
+func setNodePoolSize(pool map[string]interface{}, p Params) error {
+	for _, k := range []string{"MinSize", "MaxSize", "DesiredCapacity"} {
+		if _, ok := p[k]; ok {
+			pool[k] = p.Int(k, 0)
+		}
+	}
+	if _, ok := p["EnableAutoScale"]; ok {
+		pool["EnableAutoScale"] = p.Bool("EnableAutoScale")
+	}
+	if pool["MaxSize"].(int) < pool["MinSize"].(int) {
+		return invalidParam("the MaxSize %d is less than the MinSize %d", pool["MaxSize"], pool["MinSize"])
+	}
+	return nil
+}
+
+func createNodePool(s *Server, p Params) (map[string]interface{}, error) {
+	clusterId, err := p.Require("ClusterId")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("NodePoolName")
+	if err != nil {
+		return nil, err
+	}
+	template, err := nodeTemplate(p)
+	if err != nil {
+		return nil, err
+	}
+	id := s.newId()
+	pool := map[string]interface{}{
+		"NodePoolId":      id,
+		"NodePoolName":    name,
+		"ClusterId":       clusterId,
+		"EnableAutoScale": false,
+		"MinSize":         0,
+		"MaxSize":         0,
+		"DesiredCapacity": 0,
+		"NodeTemplate":    template,
+		"AdvancedSetting": advancedSetting(p),
+		"Status":          "Creating",
+		"CreateTime":      now(),
+	}
+	if err = setNodePoolSize(pool, p); err != nil {
+		return nil, err
+	}
+	s.store("node_pool").put(id, pool, after(s.PendingDescribes, setState("Status", "Active"))...)
+	s.scaleNodePool(pool)
+	return map[string]interface{}{"NodePoolId": id}, nil
+}
+
+func describeNodePool(s *Server, p Params) (map[string]interface{}, error) {
+	clusterId, err := p.Require("ClusterId")
+	if err != nil {
+		return nil, err
+	}
+	ids := p.List("NodePoolId")
+	pools := s.store("node_pool").describe(func(data map[string]interface{}) bool {
+		return data["ClusterId"] == clusterId && matchIds(data, "NodePoolId", ids)
+	})
+	// the Marker is the offset of the page
+	marker := p.Int("Marker", 0)
+	if marker > len(pools) {
+		marker = len(pools)
+	}
+	pools = pools[marker:]
+	if limit := p.Int("MaxResults", len(pools)); limit < len(pools) {
+		pools = pools[:limit]
+	}
+	return map[string]interface{}{"NodePoolSet": pools}, nil
+}
+
+func (s *Server) requireNodePool(p Params) (map[string]interface{}, error) {
+	if _, err := p.Require("ClusterId"); err != nil {
+		return nil, err
+	}
+	id, err := p.Require("NodePoolId")
+	if err != nil {
+		return nil, err
+	}
+	return s.store("node_pool").get(id)
+}
+
+func modifyNodePool(s *Server, p Params) (map[string]interface{}, error) {
+	pool, err := s.requireNodePool(p)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := p["NodePoolName"]; ok {
+		pool["NodePoolName"] = v
+	}
+	if err = setNodePoolSize(pool, p); err != nil {
+		return nil, err
+	}
+	s.scaleNodePool(pool)
+	return map[string]interface{}{"NodePoolId": pool["NodePoolId"]}, nil
+}
+
+func modifyNodeTemplate(s *Server, p Params) (map[string]interface{}, error) {
+	pool, err := s.requireNodePool(p)
+	if err != nil {
+		return nil, err
+	}
+	template, err := nodeTemplate(p)
+	if err != nil {
+		return nil, err
+	}
+	// the template is replaced as a whole, the existing nodes are not changed
+	pool["NodeTemplate"] = template
+	pool["AdvancedSetting"] = advancedSetting(p)
+	pool["Status"] = "Updating"
+	s.store("node_pool").transit(pool["NodePoolId"].(string), after(s.PendingDescribes-1, setState("Status", "Active"))...)
+	return map[string]interface{}{"NodePoolId": pool["NodePoolId"]}, nil
+}
+
+func deleteNodePool(s *Server, p Params) (map[string]interface{}, error) {
+	if _, err := p.Require("ClusterId"); err != nil {
+		return nil, err
+	}
+	ids := p.List("NodePoolId")
+	if len(ids) == 0 {
+		return nil, invalidParam("the param NodePoolId.1 is required")
+	}
+	for _, id := range ids {
+		pool, err := s.store("node_pool").get(id)
+		if err != nil {
+			return nil, err
+		}
+		pool["Status"] = "Deleting"
+		s.store("node_pool").transit(id, after(s.PendingDescribes-1, removed)...)
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+// nodePoolInstances returns the nodes of the node pool that are not being removed
+func (s *Server) nodePoolInstances(nodePoolId string) []map[string]interface{} {
+	var nodes []map[string]interface{}
+	st := s.store("kce_instance")
+	for _, id := range st.ids {
+		node := st.objects[id].data
+		if node["NodePoolId"] == nodePoolId && node["InstanceStatus"] != "deleting" {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// scaleNodePool creates the nodes from the node template or removes the newest ones until the
+// number of nodes is the desired capacity, the autoscaler is not simulated.
+func (s *Server) scaleNodePool(pool map[string]interface{}) {
+	nodePoolId := pool["NodePoolId"].(string)
+	nodes := s.nodePoolInstances(nodePoolId)
+	capacity := pool["DesiredCapacity"].(int)
+	template := pool["NodeTemplate"].(map[string]interface{})
+	for i := len(nodes); i < capacity; i++ {
+		id := s.newId()
+		s.store("kce_instance").put(id, map[string]interface{}{
+			"InstanceId":     id,
+			"InstanceName":   "kce-node-" + id,
+			"InstanceRole":   "Worker",
+			"InstanceStatus": "creating",
+			"ClusterId":      pool["ClusterId"],
+			"NodePoolId":     nodePoolId,
+			"KecInstancePara": map[string]interface{}{
+				"InstanceType": template["InstanceType"],
+				"ImageId":      template["ImageId"],
+			},
+		}, after(s.PendingDescribes, setState("InstanceStatus", "normal"))...)
+	}
+	for i := len(nodes) - 1; i >= capacity; i-- {
+		s.removeInstance(nodes[i])
+	}
+}
+
+func (s *Server) removeInstance(node map[string]interface{}) {
+	node["InstanceStatus"] = "deleting"
+	s.store("kce_instance").transit(node["InstanceId"].(string), after(s.PendingDescribes-1, removed)...)
+}
+
+func describeClusterInstance(s *Server, p Params) (map[string]interface{}, error) {
+	clusterId, err := p.Require("ClusterId")
+	if err != nil {
+		return nil, err
+	}
+	filters := p.Filters()
+	nodes := s.store("kce_instance").describe(func(data map[string]interface{}) bool {
+		return data["ClusterId"] == clusterId && matchFilters(data, filters, map[string]string{
+			"instance-id":   "InstanceId",
+			"instance-role": "InstanceRole",
+		})
+	})
+	total := len(nodes)
+	marker := p.Int("Marker", 0)
+	if marker > len(nodes) {
+		marker = len(nodes)
+	}
+	nodes = nodes[marker:]
+	if limit := p.Int("MaxResults", len(nodes)); limit < len(nodes) {
+		nodes = nodes[:limit]
+	}
+	return map[string]interface{}{"InstanceSet": nodes, "TotalCount": total}, nil
+}
+
+// deleteClusterInstancesFromNodePool removes the nodes and subtracts them from the desired capacity of the node pool
+func deleteClusterInstancesFromNodePool(s *Server, p Params) (map[string]interface{}, error) {
+	pool, err := s.requireNodePool(p)
+	if err != nil {
+		return nil, err
+	}
+	ids := p.List("InstanceId")
+	if len(ids) == 0 {
+		return nil, invalidParam("the param InstanceId.1 is required")
+	}
+	results := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		node, err := s.store("kce_instance").get(id)
+		if err != nil {
+			return nil, err
+		}
+		if node["NodePoolId"] != pool["NodePoolId"] {
+			return nil, invalidParam("the instance %s is not in the node pool %s", id, pool["NodePoolId"])
+		}
+		if node["InstanceStatus"] != "deleting" {
+			s.removeInstance(node)
+			pool["DesiredCapacity"] = pool["DesiredCapacity"].(int) - 1
+		}
+		results = append(results, map[string]interface{}{"InstanceId": id, "Return": true})
+	}
+	return map[string]interface{}{"InstanceSet": results}, nil
+}
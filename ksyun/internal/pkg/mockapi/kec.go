@@ -0,0 +1,288 @@
+package mockapi
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func registerKecHandlers(s *Server) {
+	s.handlers["RunInstances"] = runInstances
+	s.handlers["DescribeInstances"] = describeInstances
+	s.handlers["ModifyInstanceAttribute"] = modifyInstanceAttribute
+	s.handlers["ModifyNetworkInterfaceAttribute"] = modifyNetworkInterfaceAttribute
+	s.handlers["StopInstances"] = stopInstances
+	s.handlers["StartInstances"] = startInstances
+	s.handlers["TerminateInstances"] = terminateInstances
+}
+
+// instanceSpecs is the vcpu and memory of the instance types, the others are 1C1G
+var instanceSpecs = map[string][2]int{
+	"N3.1A": {1, 1},
+	"N3.1B": {1, 2},
+	"N3.2A": {2, 2},
+	"N3.2B": {2, 4},
+	"S6.1A": {1, 1},
+	"S6.1B": {1, 2},
+	"S6.2A": {2, 2},
+	"S6.2B": {2, 4},
+}
+
+func instanceState(name string) map[string]interface{} {
+	return map[string]interface{}{"Name": name}
+}
+
+func setInstanceState(name string) func(data map[string]interface{}) bool {
+	return func(data map[string]interface{}) bool {
+		data["InstanceState"] = instanceState(name)
+		return false
+	}
+}
+
+func runInstances(s *Server, p Params) (map[string]interface{}, error) {
+	imageId, err := p.Require("ImageId")
+	if err != nil {
+		return nil, err
+	}
+	instanceType, err := p.Require("InstanceType")
+	if err != nil {
+		return nil, err
+	}
+	subnetId, err := p.Require("SubnetId")
+	if err != nil {
+		return nil, err
+	}
+	subnet, err := s.store("subnet").get(subnetId)
+	if err != nil {
+		return nil, err
+	}
+	securityGroupIds := p.List("SecurityGroupId")
+	if len(securityGroupIds) == 0 {
+		return nil, invalidParam("the param SecurityGroupId.1 is required")
+	}
+	count := p.Int("MaxCount", 1)
+	spec, ok := instanceSpecs[instanceType]
+	if !ok {
+		spec = [2]int{1, 1}
+	}
+
+	var instances []interface{}
+	for i := 0; i < count; i++ {
+		id := s.newId()
+		ni := s.createNetworkInterface(subnet, id, "primary", p.Get("PrivateIpAddress"), securityGroupIds)
+		dataDisks := make([]interface{}, 0)
+		for _, raw := range p.set("DataDisk") {
+			disk := raw.(map[string]interface{})
+			size, _ := strconv.Atoi(disk["Size"].(string))
+			dataDisks = append(dataDisks, map[string]interface{}{
+				"DiskId":             s.newId(),
+				"DiskType":           disk["Type"],
+				"DiskSize":           size,
+				"DeleteWithInstance": disk["DeleteWithInstance"] != "false",
+			})
+		}
+		keys := make([]interface{}, 0)
+		for _, key := range p.List("KeyId") {
+			keys = append(keys, key)
+		}
+		instance := map[string]interface{}{
+			"InstanceId":   id,
+			"InstanceName": p.Get("InstanceName", "ksc_instance"),
+			"HostName":     p.Get("HostName", "vm"+id[len(id)-8:]),
+			// the kec api returns the project id as a number
+			"ProjectId":        p.Int("ProjectId", 0),
+			"ImageId":          imageId,
+			"InstanceType":     instanceType,
+			"ChargeType":       p.Get("ChargeType", "Daily"),
+			"SubnetId":         subnetId,
+			"PrivateIpAddress": ni["PrivateIpAddress"],
+			"SriovNetSupport":  p.Get("SriovNetSupport", "false"),
+			"InstanceConfigure": map[string]interface{}{
+				"VCPU":       spec[0],
+				"MemoryGb":   spec[1],
+				"DataDiskGb": p.Int("DataDiskGb", 0),
+			},
+			"InstanceState": instanceState("scheduling"),
+			"SystemDisk": map[string]interface{}{
+				"DiskType": p.Get("SystemDisk.DiskType", "Local_SSD"),
+				"DiskSize": p.Int("SystemDisk.DiskSize", 20),
+			},
+			"DataDisks":           dataDisks,
+			"KeySet":              keys,
+			"NetworkInterfaceSet": []interface{}{copyValue(ni)},
+			"CreationDate":        now(),
+		}
+		s.store("instance").put(id, instance, after(s.PendingDescribes, setInstanceState("active"))...)
+		if tags := p.Tags(); len(tags) > 0 {
+			s.setTags("instance", id, tags)
+		}
+		instances = append(instances, map[string]interface{}{
+			"InstanceId":   id,
+			"InstanceName": instance["InstanceName"],
+		})
+	}
+	return map[string]interface{}{"InstancesSet": instances}, nil
+}
+
+func describeInstances(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("InstanceId")
+	projectIds := p.List("ProjectId")
+	filters := p.Filters()
+	instances := s.store("instance").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "InstanceId", ids) && matchIds(data, "ProjectId", projectIds) &&
+			matchFilters(data, filters, map[string]string{
+				"subnet-id":     "SubnetId",
+				"instance-type": "InstanceType",
+			})
+	})
+	return map[string]interface{}{
+		"InstanceCount": len(instances),
+		"InstancesSet":  instances,
+	}, nil
+}
+
+func (s *Server) requireInstance(id string) (map[string]interface{}, error) {
+	instance, err := s.store("instance").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if state := instance["InstanceState"].(map[string]interface{})["Name"]; state != "active" && state != "stopped" {
+		return nil, &Error{
+			StatusCode: http.StatusConflict,
+			Code:       "InvalidInstanceState",
+			Message:    "the instance " + id + " is " + state.(string),
+		}
+	}
+	return instance, nil
+}
+
+func modifyInstanceAttribute(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.requireInstance(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range []string{"InstanceName", "HostName"} {
+		if v, ok := p[k]; ok {
+			instance[k] = v
+		}
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func modifyNetworkInterfaceAttribute(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("InstanceId")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.requireInstance(id)
+	if err != nil {
+		return nil, err
+	}
+	niId, err := p.Require("NetworkInterfaceId")
+	if err != nil {
+		return nil, err
+	}
+	ni, err := s.store("network_interface").get(niId)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range []string{"DNS1", "DNS2", "PrivateIpAddress"} {
+		if v, ok := p[k]; ok {
+			ni[k] = v
+		}
+	}
+	if subnetId, ok := p["SubnetId"]; ok && subnetId != ni["SubnetId"] {
+		subnet, err := s.store("subnet").get(subnetId)
+		if err != nil {
+			return nil, err
+		}
+		ni["SubnetId"] = subnetId
+		if _, ok = p["PrivateIpAddress"]; !ok {
+			ni["PrivateIpAddress"] = s.allocateIp(subnet)
+		}
+	}
+	if sgs := p.List("SecurityGroupId"); len(sgs) > 0 {
+		securityGroups := make([]interface{}, 0, len(sgs))
+		for _, sg := range sgs {
+			securityGroups = append(securityGroups, map[string]interface{}{"SecurityGroupId": sg})
+		}
+		ni["SecurityGroupSet"] = securityGroups
+	}
+	interfaces := instance["NetworkInterfaceSet"].([]interface{})
+	for i, v := range interfaces {
+		if v.(map[string]interface{})["NetworkInterfaceId"] == niId {
+			interfaces[i] = copyValue(ni)
+		}
+	}
+	if ni["NetworkInterfaceType"] == "primary" {
+		instance["SubnetId"] = ni["SubnetId"]
+		instance["PrivateIpAddress"] = ni["PrivateIpAddress"]
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+// instancesSet returns the response of the batch operations on the instances
+func instancesSet(ids []string) map[string]interface{} {
+	set := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		set = append(set, map[string]interface{}{"InstanceId": id, "Return": true})
+	}
+	return map[string]interface{}{"InstancesSet": set}
+}
+
+func stopInstances(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("InstanceId")
+	for _, id := range ids {
+		instance, err := s.requireInstance(id)
+		if err != nil {
+			return nil, err
+		}
+		instance["InstanceState"] = instanceState("stopping")
+		s.store("instance").transit(id, after(s.PendingDescribes-1, setInstanceState("stopped"))...)
+	}
+	return instancesSet(ids), nil
+}
+
+func startInstances(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("InstanceId")
+	for _, id := range ids {
+		instance, err := s.requireInstance(id)
+		if err != nil {
+			return nil, err
+		}
+		instance["InstanceState"] = instanceState("starting")
+		s.store("instance").transit(id, after(s.PendingDescribes-1, setInstanceState("active"))...)
+	}
+	return instancesSet(ids), nil
+}
+
+func terminateInstances(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("InstanceId")
+	for _, id := range ids {
+		instance, err := s.store("instance").get(id)
+		if err != nil {
+			return nil, err
+		}
+		// the network interfaces are released at once, so the subnet is able to be deleted
+		// without waiting for the instance to disappear.
+		s.releaseInstance(instance)
+		instance["InstanceState"] = instanceState("deleting")
+		s.store("instance").transit(id, after(s.PendingDescribes-1, removed)...)
+	}
+	return instancesSet(ids), nil
+}
+
+// releaseInstance removes the network interfaces and the tags of the instance
+func (s *Server) releaseInstance(instance map[string]interface{}) {
+	for _, v := range instance["NetworkInterfaceSet"].([]interface{}) {
+		ni := v.(map[string]interface{})
+		if subnet, err := s.store("subnet").get(ni["SubnetId"].(string)); err == nil {
+			adjustAvailableIps(subnet, 1)
+		}
+		s.store("network_interface").remove(ni["NetworkInterfaceId"].(string))
+	}
+	s.store("tag").remove(tagKey("instance", instance["InstanceId"].(string)))
+}
@@ -0,0 +1,362 @@
+package mockapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func registerKlogHandlers(s *Server) {
+	s.handlers["CreateProject"] = createKlogProject
+	s.handlers["DescribeProject"] = describeKlogProject
+	s.handlers["UpdateProject"] = updateKlogProject
+	s.handlers["DeleteProject"] = deleteKlogProject
+	s.handlers["ListProjects"] = listKlogProjects
+	s.handlers["CreateLogPool"] = createKlogLogPool
+	s.handlers["UpdateLogPool"] = updateKlogLogPool
+	s.handlers["DeleteLogPool"] = deleteKlogLogPool
+	s.handlers["ListLogPools"] = listKlogLogPools
+	s.handlers["CreateIndex"] = createKlogIndex
+	s.handlers["DescribeIndex"] = describeKlogIndex
+	s.handlers["UpdateIndex"] = updateKlogIndex
+	s.handlers["DeleteIndex"] = deleteKlogIndex
+}
+
+func klogConflict(kind, name string) *Error {
+	return &Error{
+		StatusCode: http.StatusConflict,
+		Code:       kind + "AlreadyExist",
+		Message:    "the " + kind + " " + name + " already exists",
+	}
+}
+
+// klogPage returns the page of the items, the Page starts from 0
+func klogPage(items []interface{}, p Params) []interface{} {
+	size := p.Int("Size", 10)
+	start := p.Int("Page", 0) * size
+	if start > len(items) {
+		start = len(items)
+	}
+	items = items[start:]
+	if size < len(items) {
+		items = items[:size]
+	}
+	return items
+}
+
+func createKlogProject(s *Server, p Params) (map[string]interface{}, error) {
+	name, err := p.Require("ProjectName")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("klog_project").get(name); err == nil {
+		return nil, klogConflict("Project", name)
+	}
+	project := map[string]interface{}{
+		"ProjectName":    name,
+		"Description":    p.Get("Description"),
+		"IamProjectId":   p.Int("IamProjectId", 0),
+		"IamProjectName": "default",
+		"Region":         DefaultRegion,
+		"Status":         "Normal",
+		"CreateTime":     now(),
+		"UpdateTime":     now(),
+		"Tags":           []interface{}{},
+	}
+	s.store("klog_project").put(name, project)
+	return map[string]interface{}{"Res": name}, nil
+}
+
+func (s *Server) requireKlogProject(p Params) (map[string]interface{}, error) {
+	name, err := p.Require("ProjectName")
+	if err != nil {
+		return nil, err
+	}
+	return s.store("klog_project").get(name)
+}
+
+func describeKlogProject(s *Server, p Params) (map[string]interface{}, error) {
+	project, err := s.requireKlogProject(p)
+	if err != nil {
+		return nil, err
+	}
+	// the description isn't returned by the api
+	result := copyValue(project).(map[string]interface{})
+	delete(result, "Description")
+	return result, nil
+}
+
+func updateKlogProject(s *Server, p Params) (map[string]interface{}, error) {
+	project, err := s.requireKlogProject(p)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := p["Description"]; ok {
+		project["Description"] = v
+	}
+	if _, ok := p["IamProjectId"]; ok {
+		project["IamProjectId"] = p.Int("IamProjectId", 0)
+	}
+	project["UpdateTime"] = now()
+	return map[string]interface{}{"Res": "success"}, nil
+}
+
+func deleteKlogProject(s *Server, p Params) (map[string]interface{}, error) {
+	project, err := s.requireKlogProject(p)
+	if err != nil {
+		return nil, err
+	}
+	name := project["ProjectName"].(string)
+	for _, pool := range s.store("klog_log_pool").objects {
+		if pool.data["ProjectName"] == name {
+			return nil, inUse("project", name, "log pool "+pool.data["LogPoolName"].(string))
+		}
+	}
+	s.store("klog_project").remove(name)
+	return map[string]interface{}{"Res": "success"}, nil
+}
+
+func listKlogProjects(s *Server, p Params) (map[string]interface{}, error) {
+	name := p.Get("ProjectName")
+	projects := s.store("klog_project").describe(func(data map[string]interface{}) bool {
+		return strings.Contains(data["ProjectName"].(string), name)
+	})
+	total := len(projects)
+	projects = klogPage(projects, p)
+	for _, v := range projects {
+		project := v.(map[string]interface{})
+		delete(project, "Description")
+		n := 0
+		for _, pool := range s.store("klog_log_pool").objects {
+			if pool.data["ProjectName"] == project["ProjectName"] {
+				n++
+			}
+		}
+		project["LogPoolNum"] = n
+	}
+	return map[string]interface{}{"Total": total, "Count": len(projects), "Projects": projects}, nil
+}
+
+func createKlogLogPool(s *Server, p Params) (map[string]interface{}, error) {
+	project, err := s.requireKlogProject(p)
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("LogPoolName")
+	if err != nil {
+		return nil, err
+	}
+	if s.findKlogLogPool(project["ProjectName"].(string), name) != nil {
+		return nil, klogConflict("LogPool", name)
+	}
+	id := s.newId()
+	pool := map[string]interface{}{
+		"LogPoolId":     id,
+		"LogPoolName":   name,
+		"ProjectName":   project["ProjectName"],
+		"Description":   p.Get("Description"),
+		"RetentionDays": p.Int("RetentionDays", 7),
+		"Partitions":    p.Int("Partitions", 1),
+		"Status":        "Normal",
+		"Scene":         "default",
+		"WebTracking":   false,
+		"CreateTime":    now(),
+		"UpdateTime":    now(),
+		"Tags":          []interface{}{},
+	}
+	s.store("klog_log_pool").put(id, pool)
+	return map[string]interface{}{"Res": id}, nil
+}
+
+func (s *Server) findKlogLogPool(projectName, name string) map[string]interface{} {
+	for _, pool := range s.store("klog_log_pool").objects {
+		if pool.data["ProjectName"] == projectName && pool.data["LogPoolName"] == name {
+			return pool.data
+		}
+	}
+	return nil
+}
+
+func (s *Server) requireKlogLogPoolById(p Params) (map[string]interface{}, error) {
+	if _, err := s.requireKlogProject(p); err != nil {
+		return nil, err
+	}
+	id, err := p.Require("LogPoolId")
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.store("klog_log_pool").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if pool["ProjectName"] != p["ProjectName"] {
+		return nil, notFound("klog_log_pool", id)
+	}
+	return pool, nil
+}
+
+func updateKlogLogPool(s *Server, p Params) (map[string]interface{}, error) {
+	pool, err := s.requireKlogLogPoolById(p)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range []string{"RetentionDays", "Partitions"} {
+		if _, ok := p[k]; ok {
+			v := p.Int(k, 0)
+			if v < 1 {
+				return nil, invalidParam("the %s %s is invalid", k, p[k])
+			}
+			pool[k] = v
+		}
+	}
+	if v, ok := p["Description"]; ok {
+		pool["Description"] = v
+	}
+	pool["UpdateTime"] = now()
+	return map[string]interface{}{"Res": "success"}, nil
+}
+
+func deleteKlogLogPool(s *Server, p Params) (map[string]interface{}, error) {
+	pool, err := s.requireKlogLogPoolById(p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("klog_index").remove(pool["ProjectName"].(string) + ":" + pool["LogPoolName"].(string))
+	s.store("klog_log_pool").remove(pool["LogPoolId"].(string))
+	return map[string]interface{}{"Res": "success"}, nil
+}
+
+func listKlogLogPools(s *Server, p Params) (map[string]interface{}, error) {
+	project, err := s.requireKlogProject(p)
+	if err != nil {
+		return nil, err
+	}
+	name := p.Get("LogPoolName")
+	pools := s.store("klog_log_pool").describe(func(data map[string]interface{}) bool {
+		return data["ProjectName"] == project["ProjectName"] && strings.Contains(data["LogPoolName"].(string), name)
+	})
+	total := len(pools)
+	pools = klogPage(pools, p)
+	for _, v := range pools {
+		// the description isn't returned by the api
+		delete(v.(map[string]interface{}), "Description")
+	}
+	return map[string]interface{}{
+		"ProjectName": project["ProjectName"],
+		"Total":       total,
+		"Count":       len(pools),
+		"LogPools":    pools,
+	}, nil
+}
+
+// klogIndexKey returns the key of the index in the store, it's the same as the id of the resource
+func (s *Server) klogIndexKey(p Params) (string, error) {
+	project, err := s.requireKlogProject(p)
+	if err != nil {
+		return "", err
+	}
+	name, err := p.Require("LogPoolName")
+	if err != nil {
+		return "", err
+	}
+	if s.findKlogLogPool(project["ProjectName"].(string), name) == nil {
+		return "", notFound("klog_log_pool", name)
+	}
+	return project["ProjectName"].(string) + ":" + name, nil
+}
+
+func klogTextIndex(p Params) map[string]interface{} {
+	return map[string]interface{}{
+		"CaseSensitive":  p.Bool("CaseSensitive"),
+		"IncludeChinese": p.Bool("IncludeChinese"),
+		"Delimiters":     p.Get("Delimiters"),
+	}
+}
+
+// setKlogIndex sets the full-text index and the field indexes of the params
+func setKlogIndex(index map[string]interface{}, p Params) error {
+	delete(index, "FullTextIndex")
+	if fullText := p.Sub("FullTextIndex"); len(fullText) > 0 {
+		index["FullTextIndex"] = klogTextIndex(fullText)
+	}
+	fields := make([]interface{}, 0)
+	names := make(map[string]bool)
+	for i := 1; ; i++ {
+		field := p.Sub("FieldIndex." + strconv.Itoa(i))
+		if len(field) == 0 {
+			break
+		}
+		name, err := field.Require("FieldName")
+		if err != nil {
+			return err
+		}
+		if names[name] {
+			return invalidParam("the field %s is duplicated", name)
+		}
+		names[name] = true
+		item := klogTextIndex(field)
+		item["FieldName"] = name
+		item["FieldType"] = field.Get("FieldType", "text")
+		fields = append(fields, item)
+	}
+	index["FieldIndex"] = fields
+	return nil
+}
+
+func createKlogIndex(s *Server, p Params) (map[string]interface{}, error) {
+	key, err := s.klogIndexKey(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("klog_index").get(key); err == nil {
+		return nil, klogConflict("Index", key)
+	}
+	index := map[string]interface{}{
+		"ProjectName": p["ProjectName"],
+		"LogPoolName": p["LogPoolName"],
+	}
+	if err = setKlogIndex(index, p); err != nil {
+		return nil, err
+	}
+	s.store("klog_index").put(key, index)
+	return map[string]interface{}{"Res": "success"}, nil
+}
+
+func describeKlogIndex(s *Server, p Params) (map[string]interface{}, error) {
+	key, err := s.klogIndexKey(p)
+	if err != nil {
+		return nil, err
+	}
+	index, err := s.store("klog_index").get(key)
+	if err != nil {
+		return nil, err
+	}
+	return copyValue(index).(map[string]interface{}), nil
+}
+
+func updateKlogIndex(s *Server, p Params) (map[string]interface{}, error) {
+	key, err := s.klogIndexKey(p)
+	if err != nil {
+		return nil, err
+	}
+	index, err := s.store("klog_index").get(key)
+	if err != nil {
+		return nil, err
+	}
+	if err = setKlogIndex(index, p); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"Res": "success"}, nil
+}
+
+func deleteKlogIndex(s *Server, p Params) (map[string]interface{}, error) {
+	key, err := s.klogIndexKey(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("klog_index").get(key); err != nil {
+		return nil, err
+	}
+	s.store("klog_index").remove(key)
+	return map[string]interface{}{"Res": "success"}, nil
+}
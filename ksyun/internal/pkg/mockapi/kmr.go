@@ -0,0 +1,261 @@
+package mockapi
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func registerKmrHandlers(s *Server) {
+	s.handlers["LaunchCluster"] = launchKmrCluster
+	s.handlers["DescribeCluster"] = describeKmrCluster
+	s.handlers["ListClusters"] = listKmrClusters
+	s.handlers["ScaleOutInstanceGroups"] = scaleOutKmrInstanceGroups
+	s.handlers["ScaleInInstanceGroups"] = scaleInKmrInstanceGroups
+	s.handlers["TerminateCluster"] = terminateKmrCluster
+}
+
+func kmrInstanceGroup(s *Server, groupType, instanceType string, p Params) map[string]interface{} {
+	group := map[string]interface{}{
+		"Id":                s.newId(),
+		"InstanceGroupType": groupType,
+		"InstanceType":      instanceType,
+		"ResourceType":      p.Get("ResourceType", "KEC"),
+		"VolumeType":        p.Get("VolumeType", "EHDD"),
+		"VolumeSize":        p.Int("VolumeSize", 500),
+		"InstanceIds":       []interface{}{},
+	}
+	addKmrInstances(s, group, p.Int("InstanceCount", 0))
+	return group
+}
+
+func addKmrInstances(s *Server, group map[string]interface{}, count int) {
+	for i := 0; i < count; i++ {
+		group["InstanceIds"] = append(group["InstanceIds"].([]interface{}), "i-"+s.newId())
+	}
+}
+
+// setKmrClusterScaling marks the cluster as Scaling until the following describe calls
+func (s *Server) setKmrClusterScaling(cluster map[string]interface{}) {
+	cluster["ClusterStatus"] = "Scaling"
+	cluster["UpdateTime"] = now()
+	s.store("kmr_cluster").transit(cluster["ClusterId"].(string), after(s.PendingDescribes-1, setState("ClusterStatus", "Running"))...)
+}
+
+func launchKmrCluster(s *Server, p Params) (map[string]interface{}, error) {
+	for _, k := range []string{"ClusterName", "MainVersion", "VpcDomainId", "SecurityGroupId"} {
+		if _, err := p.Require(k); err != nil {
+			return nil, err
+		}
+	}
+	groups := make([]interface{}, 0)
+	masters := 0
+	for i := 1; ; i++ {
+		item := p.Sub("InstanceGroups." + strconv.Itoa(i))
+		if len(item) == 0 {
+			break
+		}
+		groupType, err := item.Require("InstanceGroupType")
+		if err != nil {
+			return nil, err
+		}
+		instanceType, err := item.Require("InstanceType")
+		if err != nil {
+			return nil, err
+		}
+		if groupType == "MASTER" {
+			masters++
+		}
+		groups = append(groups, kmrInstanceGroup(s, groupType, instanceType, item))
+	}
+	if masters != 1 {
+		return nil, invalidParam("the cluster must have one MASTER instance group")
+	}
+	id := s.newId()
+	cluster := map[string]interface{}{
+		"ClusterId":      id,
+		"ClusterName":    p["ClusterName"],
+		"ClusterType":    "Hadoop",
+		"MainVersion":    p["MainVersion"],
+		"EnableEip":      false,
+		"Region":         DefaultRegion,
+		"VpcDomainId":    p["VpcDomainId"],
+		"ChargeType":     p.Get("ChargeType", "PostPaidByHour"),
+		"ClusterStatus":  "Launching",
+		"CreateTime":     now(),
+		"UpdateTime":     now(),
+		"ServingMinutes": 0,
+		"InstanceGroups": groups,
+		"Tags":           []interface{}{},
+	}
+	s.store("kmr_cluster").put(id, cluster, after(s.PendingDescribes, setState("ClusterStatus", "Running"))...)
+	return map[string]interface{}{"ClusterId": id}, nil
+}
+
+func (s *Server) describeKmrCluster(id string) (map[string]interface{}, error) {
+	clusters := s.store("kmr_cluster").describe(func(data map[string]interface{}) bool {
+		return data["ClusterId"] == id
+	})
+	if len(clusters) == 0 {
+		return nil, notFound("kmr_cluster", id)
+	}
+	return clusters[0].(map[string]interface{}), nil
+}
+
+func describeKmrCluster(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("ClusterId")
+	if err != nil {
+		return nil, err
+	}
+	return s.describeKmrCluster(id)
+}
+
+func listKmrClusters(s *Server, p Params) (map[string]interface{}, error) {
+	clusters := s.store("kmr_cluster").describe(nil)
+	return map[string]interface{}{"Clusters": clusters, "Marker": ""}, nil
+}
+
+// requireRunningKmrCluster returns the cluster in the store, the operations are rejected unless it's running
+func (s *Server) requireRunningKmrCluster(p Params) (map[string]interface{}, error) {
+	id, err := p.Require("ClusterId")
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := s.store("kmr_cluster").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if cluster["ClusterStatus"] != "Running" {
+		return nil, &Error{
+			StatusCode: http.StatusConflict,
+			Code:       "InvalidClusterStatus",
+			Message:    "the cluster " + id + " is " + cluster["ClusterStatus"].(string),
+		}
+	}
+	return cluster, nil
+}
+
+// scaleOutKmrInstanceGroups adds instances to the group identified by the type and the InstanceGroupIndex,
+// the index is the order in the groups of the same type, a new TASK group is added by the next index.
+func scaleOutKmrInstanceGroups(s *Server, p Params) (map[string]interface{}, error) {
+	cluster, err := s.requireRunningKmrCluster(p)
+	if err != nil {
+		return nil, err
+	}
+	groups := cluster["InstanceGroups"].([]interface{})
+	for i := 1; ; i++ {
+		item := p.Sub("InstanceGroups." + strconv.Itoa(i))
+		if len(item) == 0 {
+			break
+		}
+		groupType, err := item.Require("InstanceGroupType")
+		if err != nil {
+			return nil, err
+		}
+		if groupType == "MASTER" {
+			return nil, invalidParam("the MASTER instance group can't be scaled out")
+		}
+		index := item.Int("InstanceGroupIndex", 0)
+		var group map[string]interface{}
+		n := 0
+		for _, v := range groups {
+			if v.(map[string]interface{})["InstanceGroupType"] != groupType {
+				continue
+			}
+			if n == index {
+				group = v.(map[string]interface{})
+				break
+			}
+			n++
+		}
+		switch {
+		case group != nil:
+			addKmrInstances(s, group, item.Int("InstanceCount", 0))
+		case groupType == "TASK" && index == n:
+			instanceType, err := item.Require("InstanceType")
+			if err != nil {
+				return nil, err
+			}
+			groups = append(groups, kmrInstanceGroup(s, groupType, instanceType, item))
+		default:
+			return nil, invalidParam("the %s instance group %d is not found", groupType, index)
+		}
+	}
+	cluster["InstanceGroups"] = groups
+	s.setKmrClusterScaling(cluster)
+	return map[string]interface{}{"ClusterId": cluster["ClusterId"]}, nil
+}
+
+// scaleInKmrInstanceGroups removes the instances from the groups, the empty TASK groups are removed
+func scaleInKmrInstanceGroups(s *Server, p Params) (map[string]interface{}, error) {
+	cluster, err := s.requireRunningKmrCluster(p)
+	if err != nil {
+		return nil, err
+	}
+	groups := cluster["InstanceGroups"].([]interface{})
+	for i := 1; ; i++ {
+		item := p.Sub("InstanceGroups." + strconv.Itoa(i))
+		if len(item) == 0 {
+			break
+		}
+		id, err := item.Require("Id")
+		if err != nil {
+			return nil, err
+		}
+		var group map[string]interface{}
+		for _, v := range groups {
+			if v.(map[string]interface{})["Id"] == id {
+				group = v.(map[string]interface{})
+			}
+		}
+		if group == nil {
+			return nil, notFound("kmr_instance_group", id)
+		}
+		if group["InstanceGroupType"] == "MASTER" {
+			return nil, invalidParam("the MASTER instance group can't be scaled in")
+		}
+		for j := 1; ; j++ {
+			instance := item.Sub("instances." + strconv.Itoa(j))
+			if len(instance) == 0 {
+				break
+			}
+			instanceId, err := instance.Require("InstanceId")
+			if err != nil {
+				return nil, err
+			}
+			instanceIds := make([]interface{}, 0)
+			found := false
+			for _, v := range group["InstanceIds"].([]interface{}) {
+				if v == instanceId {
+					found = true
+					continue
+				}
+				instanceIds = append(instanceIds, v)
+			}
+			if !found {
+				return nil, notFound("kmr_instance", instanceId)
+			}
+			group["InstanceIds"] = instanceIds
+		}
+	}
+	result := make([]interface{}, 0, len(groups))
+	for _, v := range groups {
+		group := v.(map[string]interface{})
+		if group["InstanceGroupType"] == "TASK" && len(group["InstanceIds"].([]interface{})) == 0 {
+			continue
+		}
+		result = append(result, group)
+	}
+	cluster["InstanceGroups"] = result
+	s.setKmrClusterScaling(cluster)
+	return map[string]interface{}{"ClusterId": cluster["ClusterId"]}, nil
+}
+
+func terminateKmrCluster(s *Server, p Params) (map[string]interface{}, error) {
+	cluster, err := s.requireRunningKmrCluster(p)
+	if err != nil {
+		return nil, err
+	}
+	cluster["ClusterStatus"] = "Terminating"
+	s.store("kmr_cluster").transit(cluster["ClusterId"].(string), after(s.PendingDescribes-1, removed)...)
+	return map[string]interface{}{"ClusterId": cluster["ClusterId"]}, nil
+}
@@ -0,0 +1,649 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+func registerKrdsHandlers(s *Server) {
+	s.handlers["CreateDBInstance"] = createDBInstance
+	s.handlers["DescribeDBInstances"] = describeDBInstances
+	s.handlers["ModifyDBInstance"] = modifyDBInstance
+	s.handlers["DeleteDBInstance"] = deleteDBInstance
+	s.handlers["RestoreDBInstanceFromDBBackup"] = restoreDBInstanceFromDBBackup
+	s.handlers["RestoreDBInstanceToPointInTime"] = restoreDBInstanceToPointInTime
+	s.handlers["DescribeDBInstanceParameters"] = describeDBInstanceParameters
+	s.handlers["DescribeEngineDefaultParameters"] = describeEngineDefaultParameters
+	s.handlers["DeleteDBParameterGroup"] = deleteDBParameterGroup
+	s.handlers["CreateInstanceAccount"] = createInstanceAccount
+	s.handlers["DescribeInstanceAccounts"] = describeInstanceAccounts
+	s.handlers["ModifyInstanceAccountInfo"] = modifyInstanceAccountInfo
+	s.handlers["ModifyInstanceAccountPrivileges"] = modifyInstanceAccountPrivileges
+	s.handlers["DeleteInstanceAccount"] = deleteInstanceAccount
+	s.handlers["CreateInstanceDatabase"] = createInstanceDatabase
+	s.handlers["DescribeInstanceDatabases"] = describeInstanceDatabases
+	s.handlers["DeleteInstanceDatabaseAction"] = deleteInstanceDatabase
+	s.handlers["CreateDBBackup"] = createDBBackup
+	s.handlers["DescribeDBBackups"] = describeDBBackups
+	s.handlers["DeleteDBBackup"] = deleteDBBackup
+	s.handlers["DescribeDBBackupPolicy"] = describeDBBackupPolicy
+	s.handlers["ModifyDBBackupPolicy"] = modifyDBBackupPolicy
+}
+
+// the accounts and the databases are keyed by the instance id and the name,
+// they don't look up the instance, so any instance id is accepted.
+func krdsKey(instanceId, name string) string {
+	return instanceId + ":" + name
+}
+
+func krdsData(key string, items []interface{}) map[string]interface{} {
+	return map[string]interface{}{"Data": map[string]interface{}{key: items}}
+}
+
+func accountPassword(p Params) (string, error) {
+	password, err := p.Require("InstanceAccountPassword")
+	if err != nil {
+		return "", err
+	}
+	if len(password) < 8 {
+		return "", invalidParam("the InstanceAccountPassword must be at least 8 characters")
+	}
+	return password, nil
+}
+
+// accountPrivileges reads the InstanceAccountPrivileges.N.* params, the databases must exist.
+func (s *Server) accountPrivileges(instanceId string, p Params) ([]interface{}, error) {
+	privileges := make([]interface{}, 0)
+	for _, raw := range p.set("InstanceAccountPrivileges") {
+		privilege := raw.(map[string]interface{})
+		if _, err := s.store("krds_database").get(krdsKey(instanceId, privilege["Schema"].(string))); err != nil {
+			return nil, err
+		}
+		privileges = append(privileges, map[string]interface{}{
+			"Schema":    privilege["Schema"],
+			"Privilege": privilege["Privilege"],
+		})
+	}
+	sort.Slice(privileges, func(i, j int) bool {
+		return privileges[i].(map[string]interface{})["Schema"].(string) < privileges[j].(map[string]interface{})["Schema"].(string)
+	})
+	return privileges, nil
+}
+
+func createInstanceAccount(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("InstanceAccountName")
+	if err != nil {
+		return nil, err
+	}
+	key := krdsKey(instanceId, name)
+	if _, err = s.store("krds_account").get(key); err == nil {
+		return nil, invalidParam("the account %s already exists", name)
+	}
+	password, err := accountPassword(p)
+	if err != nil {
+		return nil, err
+	}
+	privileges, err := s.accountPrivileges(instanceId, p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("krds_account").put(key, map[string]interface{}{
+		"DBInstanceIdentifier":       instanceId,
+		"InstanceAccountName":        name,
+		"InstanceAccountDescription": p.Get("InstanceAccountDescription"),
+		"InstanceAccountType":        "Normal",
+		"InstanceAccountStatus":      "ACTIVE",
+		"InstanceAccountPrivileges":  privileges,
+		// the password is kept for the tests, it's never returned by the api
+		"password": password,
+	})
+	return krdsData("InstanceAccounts", nil), nil
+}
+
+func describeInstanceAccounts(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	name := p.Get("InstanceAccountName")
+	accounts := s.store("krds_account").describe(func(data map[string]interface{}) bool {
+		return data["DBInstanceIdentifier"] == instanceId && (name == "" || data["InstanceAccountName"] == name)
+	})
+	for _, account := range accounts {
+		delete(account.(map[string]interface{}), "password")
+	}
+	return krdsData("InstanceAccounts", accounts), nil
+}
+
+func (s *Server) requireAccount(p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("InstanceAccountName")
+	if err != nil {
+		return nil, err
+	}
+	return s.store("krds_account").get(krdsKey(instanceId, name))
+}
+
+func modifyInstanceAccountInfo(s *Server, p Params) (map[string]interface{}, error) {
+	account, err := s.requireAccount(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p["InstanceAccountPassword"]; ok {
+		password, err := accountPassword(p)
+		if err != nil {
+			return nil, err
+		}
+		account["password"] = password
+	}
+	if v, ok := p["InstanceAccountDescription"]; ok {
+		account["InstanceAccountDescription"] = v
+	}
+	return krdsData("InstanceAccounts", nil), nil
+}
+
+// modifyInstanceAccountPrivileges replaces the privileges of the account as a whole
+func modifyInstanceAccountPrivileges(s *Server, p Params) (map[string]interface{}, error) {
+	account, err := s.requireAccount(p)
+	if err != nil {
+		return nil, err
+	}
+	privileges, err := s.accountPrivileges(account["DBInstanceIdentifier"].(string), p)
+	if err != nil {
+		return nil, err
+	}
+	account["InstanceAccountPrivileges"] = privileges
+	return krdsData("InstanceAccounts", nil), nil
+}
+
+func deleteInstanceAccount(s *Server, p Params) (map[string]interface{}, error) {
+	account, err := s.requireAccount(p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("krds_account").remove(krdsKey(account["DBInstanceIdentifier"].(string), account["InstanceAccountName"].(string)))
+	return krdsData("InstanceAccounts", nil), nil
+}
+
+func createInstanceDatabase(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("InstanceDatabaseName")
+	if err != nil {
+		return nil, err
+	}
+	key := krdsKey(instanceId, name)
+	if _, err = s.store("krds_database").get(key); err == nil {
+		return nil, invalidParam("the database %s already exists", name)
+	}
+	charset := p.Get("CharacterSetName", "utf8mb4")
+	s.store("krds_database").put(key, map[string]interface{}{
+		"DBInstanceIdentifier":        instanceId,
+		"InstanceDatabaseName":        name,
+		"CharacterSetName":            charset,
+		"CollationName":               p.Get("CollationName", charset+"_general_ci"),
+		"InstanceDatabaseDescription": p.Get("InstanceDatabaseDescription"),
+		"InstanceDatabaseStatus":      "CREATING",
+	}, after(s.PendingDescribes, setState("InstanceDatabaseStatus", "ACTIVE"))...)
+	return krdsData("InstanceDatabases", nil), nil
+}
+
+func describeInstanceDatabases(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	name := p.Get("InstanceDatabaseName")
+	databases := s.store("krds_database").describe(func(data map[string]interface{}) bool {
+		return data["DBInstanceIdentifier"] == instanceId && (name == "" || data["InstanceDatabaseName"] == name)
+	})
+	return krdsData("InstanceDatabases", databases), nil
+}
+
+// deleteInstanceDatabase drops the database and the privileges of the accounts on it
+func deleteInstanceDatabase(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("InstanceDatabaseName")
+	if err != nil {
+		return nil, err
+	}
+	key := krdsKey(instanceId, name)
+	if _, err = s.store("krds_database").get(key); err != nil {
+		return nil, err
+	}
+	s.store("krds_database").remove(key)
+	for _, id := range s.store("krds_account").ids {
+		account := s.store("krds_account").objects[id].data
+		if account["DBInstanceIdentifier"] != instanceId {
+			continue
+		}
+		privileges := make([]interface{}, 0)
+		for _, privilege := range account["InstanceAccountPrivileges"].([]interface{}) {
+			if privilege.(map[string]interface{})["Schema"] != name {
+				privileges = append(privileges, privilege)
+			}
+		}
+		account["InstanceAccountPrivileges"] = privileges
+	}
+	return krdsData("InstanceDatabases", nil), nil
+}
+
+func createDBBackup(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("DBBackupName")
+	if err != nil {
+		return nil, err
+	}
+	id := s.newId()
+	s.store("krds_backup").put(id, map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"DBBackupIdentifier":   id,
+		"DBBackupName":         name,
+		"BackupMode":           "Manual",
+		"BackupType":           "PhysicalBackup",
+		"BackupSize":           0,
+		"Status":               "RUNNING",
+		"BackupCreateTime":     now(),
+		"BackupUpdatedTime":    "",
+	}, after(s.PendingDescribes, func(data map[string]interface{}) bool {
+		data["Status"] = "COMPLETED"
+		data["BackupSize"] = 1048576
+		data["BackupUpdatedTime"] = now()
+		return false
+	})...)
+	return map[string]interface{}{"Data": map[string]interface{}{"DBBackupIdentifier": id}}, nil
+}
+
+func describeDBBackups(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	id := p.Get("DBBackupIdentifier")
+	mode := p.Get("BackupMode")
+	backups := s.store("krds_backup").describe(func(data map[string]interface{}) bool {
+		return data["DBInstanceIdentifier"] == instanceId &&
+			(id == "" || data["DBBackupIdentifier"] == id) &&
+			(mode == "" || data["BackupMode"] == mode)
+	})
+	return krdsData("Backups", backups), nil
+}
+
+func deleteDBBackup(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("DBBackupIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("krds_backup").get(id); err != nil {
+		return nil, err
+	}
+	s.store("krds_backup").remove(id)
+	return map[string]interface{}{"Data": map[string]interface{}{}}, nil
+}
+
+// backupPolicy returns the backup policy of the instance, the default one is used until it's modified
+func (s *Server) backupPolicy(instanceId string) map[string]interface{} {
+	policy, err := s.store("krds_backup_policy").get(instanceId)
+	if err != nil {
+		policy = map[string]interface{}{
+			"DBInstanceIdentifier":  instanceId,
+			"PreferredBackupTime":   "00:00-01:00",
+			"BackupRetentionPeriod": 7,
+		}
+		s.store("krds_backup_policy").put(instanceId, policy)
+	}
+	return policy
+}
+
+func describeDBBackupPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"Data": map[string]interface{}{"DBBackupPolicy": copyValue(s.backupPolicy(instanceId))}}, nil
+}
+
+func modifyDBBackupPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	instanceId, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	policy := s.backupPolicy(instanceId)
+	if v, ok := p["PreferredBackupTime"]; ok {
+		policy["PreferredBackupTime"] = v
+	}
+	if _, ok := p["BackupRetentionPeriod"]; ok {
+		period := p.Int("BackupRetentionPeriod", 0)
+		if period < 1 || period > 730 {
+			return nil, invalidParam("the BackupRetentionPeriod %d is out of range", period)
+		}
+		policy["BackupRetentionPeriod"] = period
+	}
+	return map[string]interface{}{"Data": map[string]interface{}{}}, nil
+}
+
+// krdsRestorableTimeLayout is the format of RestorableTime, in the time zone of Beijing
+const krdsRestorableTimeLayout = "2006-01-02 15:04:05"
+
+var krdsTimeZone = time.FixedZone("CST", 8*3600)
+
+func krdsInstanceNotFound(id string) *Error {
+	return &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       "NOT_FOUND",
+		Message:    fmt.Sprintf("the instance %s is not found", id),
+	}
+}
+
+// krdsDefaultParameters are the default parameters of all the engines
+func krdsDefaultParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"max_connections": map[string]interface{}{
+			"Type":            "integer",
+			"Default":         2000,
+			"Min":             1,
+			"Max":             100000,
+			"RestartRequired": false,
+		},
+		"character_set_server": map[string]interface{}{
+			"Type":            "string",
+			"Default":         "utf8mb4",
+			"Enums":           []interface{}{"utf8", "utf8mb4"},
+			"RestartRequired": true,
+		},
+	}
+}
+
+// putKrdsInstance keeps the instance with a parameter group of its own, the instance is ACTIVE after created
+func (s *Server) putKrdsInstance(instance map[string]interface{}) map[string]interface{} {
+	id := s.newId()
+	groupId := s.newId()
+	parameters := make(map[string]interface{})
+	for name, v := range krdsDefaultParameters() {
+		parameters[name] = v.(map[string]interface{})["Default"]
+	}
+	s.store("krds_parameter_group").put(groupId, map[string]interface{}{
+		"DBParameterGroupId": groupId,
+		"Parameters":         parameters,
+	})
+	instance["DBInstanceIdentifier"] = id
+	instance["DBParameterGroupId"] = groupId
+	instance["DBInstanceStatus"] = "CREATING"
+	instance["InstanceCreateTime"] = time.Now().In(krdsTimeZone).Format(krdsRestorableTimeLayout)
+	instance["Region"] = DefaultRegion
+	instance["Vip"] = fmt.Sprintf("10.0.0.%d", len(s.store("krds_instance").ids)+10)
+	if _, ok := instance["PreferredBackupTime"]; !ok {
+		instance["PreferredBackupTime"] = "00:00-01:00"
+	}
+	s.store("krds_instance").put(id, instance, after(s.PendingDescribes, setState("DBInstanceStatus", "ACTIVE"))...)
+	return krdsInstanceView(instance)
+}
+
+// krdsInstanceView is the instance returned by the api, the password is never returned
+func krdsInstanceView(instance map[string]interface{}) map[string]interface{} {
+	view := copyValue(instance).(map[string]interface{})
+	delete(view, "MasterUserPassword")
+	return view
+}
+
+// krdsInstanceFields sets the optional fields of the create and restore requests
+func krdsInstanceFields(instance map[string]interface{}, p Params) {
+	for _, key := range []string{"BillType", "SecurityGroupId", "PreferredBackupTime"} {
+		if v, ok := p[key]; ok {
+			instance[key] = v
+		}
+	}
+	if v, ok := p["AvailabilityZone"]; ok {
+		instance["MasterAvailabilityZone"] = v
+	}
+	if v, ok := p["AvailabilityZone.1"]; ok {
+		instance["MasterAvailabilityZone"] = v
+	}
+	if v, ok := p["AvailabilityZone.2"]; ok {
+		instance["SlaveAvailabilityZone"] = v
+	}
+	if _, ok := p["Port"]; ok {
+		instance["Port"] = p.Int("Port", 3306)
+	}
+	if _, ok := p["ProjectId"]; ok {
+		instance["ProjectId"] = p.Int("ProjectId", 0)
+	}
+}
+
+func createDBInstance(s *Server, p Params) (map[string]interface{}, error) {
+	instance := map[string]interface{}{
+		"BillType":               "DAY",
+		"Port":                   3306,
+		"ProjectId":              0,
+		"MasterAvailabilityZone": DefaultRegion + "a",
+		"SlaveAvailabilityZone":  DefaultRegion + "b",
+		"SecurityGroupId":        "",
+	}
+	for _, key := range []string{"DBInstanceName", "DBInstanceType", "Engine", "EngineVersion", "MasterUserName",
+		"MasterUserPassword", "VpcId", "SubnetId"} {
+		v, err := p.Require(key)
+		if err != nil {
+			return nil, err
+		}
+		instance[key] = v
+	}
+	class, err := p.Require("DBInstanceClass")
+	if err != nil {
+		return nil, err
+	}
+	var ram, disk int
+	if _, err = fmt.Sscanf(class, "db.ram.%d|db.disk.%d", &ram, &disk); err != nil {
+		return nil, invalidParam("the DBInstanceClass %s is invalid", class)
+	}
+	instance["DBInstanceClass"] = map[string]interface{}{
+		"Id":   class,
+		"Ram":  ram,
+		"Disk": disk,
+	}
+	krdsInstanceFields(instance, p)
+	return map[string]interface{}{"Data": map[string]interface{}{"DBInstance": s.putKrdsInstance(instance)}}, nil
+}
+
+func describeDBInstances(s *Server, p Params) (map[string]interface{}, error) {
+	id := p.Get("DBInstanceIdentifier")
+	if id != "" {
+		if _, err := s.store("krds_instance").get(id); err != nil {
+			return nil, krdsInstanceNotFound(id)
+		}
+	}
+	instances := s.store("krds_instance").describe(func(data map[string]interface{}) bool {
+		return id == "" || data["DBInstanceIdentifier"] == id
+	})
+	for i, instance := range instances {
+		instances[i] = krdsInstanceView(instance.(map[string]interface{}))
+	}
+	return krdsData("Instances", instances), nil
+}
+
+func (s *Server) requireKrdsInstance(p Params) (map[string]interface{}, error) {
+	id, err := p.Require("DBInstanceIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.store("krds_instance").get(id)
+	if err != nil {
+		return nil, krdsInstanceNotFound(id)
+	}
+	return instance, nil
+}
+
+func modifyDBInstance(s *Server, p Params) (map[string]interface{}, error) {
+	instance, err := s.requireKrdsInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	if instance["DBInstanceStatus"] != "ACTIVE" {
+		return nil, invalidParam("the instance %s is %s", instance["DBInstanceIdentifier"], instance["DBInstanceStatus"])
+	}
+	for _, key := range []string{"DBInstanceName", "MasterUserPassword", "SecurityGroupId", "PreferredBackupTime"} {
+		if v, ok := p[key]; ok {
+			instance[key] = v
+		}
+	}
+	return krdsData("Instances", nil), nil
+}
+
+func deleteDBInstance(s *Server, p Params) (map[string]interface{}, error) {
+	instance, err := s.requireKrdsInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	s.store("krds_instance").remove(instance["DBInstanceIdentifier"].(string))
+	return krdsData("Instances", nil), nil
+}
+
+// restoredKrdsInstance copies the source instance to restore, the restored instance keeps the class, the engine,
+// the network, the master user and the parameters of the source.
+func (s *Server) restoredKrdsInstance(source map[string]interface{}) map[string]interface{} {
+	instance := make(map[string]interface{})
+	for _, key := range []string{"DBInstanceClass", "DBInstanceName", "DBInstanceType", "Engine", "EngineVersion",
+		"MasterUserName", "MasterUserPassword", "VpcId", "SubnetId", "BillType", "Port", "ProjectId",
+		"MasterAvailabilityZone", "SlaveAvailabilityZone", "SecurityGroupId", "PreferredBackupTime"} {
+		instance[key] = copyValue(source[key])
+	}
+	return instance
+}
+
+func (s *Server) krdsParameterGroupOf(instance map[string]interface{}) map[string]interface{} {
+	group, err := s.store("krds_parameter_group").get(instance["DBParameterGroupId"].(string))
+	if err != nil {
+		return map[string]interface{}{}
+	}
+	return group
+}
+
+// restoreDBInstanceFromDBBackup restores a completed backup to a new instance,
+// it only takes the name, the type, the zone, the project, the port and the bill of the new instance.
+func restoreDBInstanceFromDBBackup(s *Server, p Params) (map[string]interface{}, error) {
+	backupId, err := p.Require("DBBackupIdentifier")
+	if err != nil {
+		return nil, err
+	}
+	backup, err := s.store("krds_backup").get(backupId)
+	if err != nil {
+		return nil, err
+	}
+	if backup["Status"] != "COMPLETED" {
+		return nil, invalidParam("the backup %s is %s", backupId, backup["Status"])
+	}
+	for _, key := range []string{"DBInstanceClass", "Engine", "EngineVersion", "VpcId", "SubnetId", "MasterUserName",
+		"MasterUserPassword", "DBParameterGroupId"} {
+		if _, ok := p[key]; ok {
+			return nil, invalidParam("the %s is not supported by RestoreDBInstanceFromDBBackup", key)
+		}
+	}
+	source, err := s.store("krds_instance").get(backup["DBInstanceIdentifier"].(string))
+	if err != nil {
+		return nil, krdsInstanceNotFound(backup["DBInstanceIdentifier"].(string))
+	}
+	instance := s.restoredKrdsInstance(source)
+	for _, key := range []string{"DBInstanceName", "DBInstanceType"} {
+		if instance[key], err = p.Require(key); err != nil {
+			return nil, err
+		}
+	}
+	krdsInstanceFields(instance, p)
+	parameters := copyValue(s.krdsParameterGroupOf(source)["Parameters"])
+	restored := s.putKrdsInstance(instance)
+	s.krdsParameterGroupOf(instance)["Parameters"] = parameters
+	return map[string]interface{}{"Data": map[string]interface{}{"DBInstance": restored}}, nil
+}
+
+// restoreDBInstanceToPointInTime restores the instance to a new one, the new instance is named after the source.
+// The RestorableTime is in the time zone of Beijing, it must be between the creation of the source and now.
+func restoreDBInstanceToPointInTime(s *Server, p Params) (map[string]interface{}, error) {
+	source, err := s.requireKrdsInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	for key := range p {
+		if key != "DBInstanceIdentifier" && key != "RestorableTime" {
+			return nil, invalidParam("the %s is not supported by RestoreDBInstanceToPointInTime", key)
+		}
+	}
+	restorableTime, err := p.Require("RestorableTime")
+	if err != nil {
+		return nil, err
+	}
+	pointInTime, err := time.ParseInLocation(krdsRestorableTimeLayout, restorableTime, krdsTimeZone)
+	if err != nil {
+		return nil, invalidParam("the RestorableTime %s is invalid", restorableTime)
+	}
+	created, _ := time.ParseInLocation(krdsRestorableTimeLayout, source["InstanceCreateTime"].(string), krdsTimeZone)
+	if pointInTime.Before(created) || pointInTime.After(time.Now()) {
+		return nil, invalidParam("the RestorableTime %s is out of the restorable time of the instance", restorableTime)
+	}
+	instance := s.restoredKrdsInstance(source)
+	instance["DBInstanceName"] = fmt.Sprintf("%s-restored", source["DBInstanceName"])
+	parameters := copyValue(s.krdsParameterGroupOf(source)["Parameters"])
+	restored := s.putKrdsInstance(instance)
+	s.krdsParameterGroupOf(instance)["Parameters"] = parameters
+	return krdsData("Instances", []interface{}{restored}), nil
+}
+
+func describeDBInstanceParameters(s *Server, p Params) (map[string]interface{}, error) {
+	instance, err := s.requireKrdsInstance(p)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"Data": map[string]interface{}{
+		"DBInstanceIdentifier": instance["DBInstanceIdentifier"],
+		"Parameters":           copyValue(s.krdsParameterGroupOf(instance)["Parameters"]),
+	}}, nil
+}
+
+func describeEngineDefaultParameters(s *Server, p Params) (map[string]interface{}, error) {
+	engine, err := p.Require("Engine")
+	if err != nil {
+		return nil, err
+	}
+	version, err := p.Require("EngineVersion")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"Data": map[string]interface{}{
+		"Engine":        engine,
+		"EngineVersion": version,
+		"Parameters":    krdsDefaultParameters(),
+	}}, nil
+}
+
+// deleteDBParameterGroup deletes the parameter group, the one of an instance is in use until the instance is deleted
+func deleteDBParameterGroup(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("DBParameterGroupId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("krds_parameter_group").get(id); err != nil {
+		return nil, err
+	}
+	for _, instanceId := range s.store("krds_instance").ids {
+		if s.store("krds_instance").objects[instanceId].data["DBParameterGroupId"] == id {
+			return nil, inUse("parameter group", id, instanceId)
+		}
+	}
+	s.store("krds_parameter_group").remove(id)
+	return map[string]interface{}{"Data": map[string]interface{}{}}, nil
+}
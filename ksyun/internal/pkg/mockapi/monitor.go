@@ -0,0 +1,427 @@
+package mockapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	monitorVersion   = "2010-05-25"
+	monitorV2Version = "2018-11-14"
+	monitorV4Version = "2021-01-01"
+)
+
+func registerMonitorHandlers(s *Server) {
+	s.handleVersion(monitorV4Version, "CreateAlarmPolicy", createMonitorAlarmPolicy)
+	s.handleVersion(monitorV4Version, "DescribeAlarmPolicy", describeMonitorAlarmPolicy)
+	s.handleVersion(monitorV4Version, "DeleteAlarmPolicy", deleteMonitorAlarmPolicy)
+	s.handleVersion(monitorV4Version, "ListAlarmPolicy", listMonitorAlarmPolicy)
+	s.handleVersion(monitorV4Version, "AddUserGroup", addMonitorUserGroup)
+	s.handleVersion(monitorV4Version, "UpdateUserGroup", updateMonitorUserGroup)
+	s.handleVersion(monitorV4Version, "DeleteUserGroup", deleteMonitorUserGroup)
+	s.handleVersion(monitorV4Version, "GetUserGroup", getMonitorUserGroup)
+	s.handleVersion(monitorV4Version, "AddAlertUser", addMonitorAlertUser)
+	s.handleVersion(monitorV4Version, "UpdateAlertUser", updateMonitorAlertUser)
+	s.handleVersion(monitorV4Version, "UpdateAlertUserStatus", updateMonitorAlertUserStatus)
+	s.handleVersion(monitorV4Version, "DeleteAlertUser", deleteMonitorAlertUser)
+	s.handleVersion(monitorV4Version, "GetAlertUser", getMonitorAlertUser)
+	s.handleVersion(monitorVersion, "ListMetrics", listMonitorMetrics)
+	s.handleVersion(monitorV2Version, "GetMetricStatisticsBatch", getMonitorMetricStatisticsBatch)
+}
+
+// PutMetricData sets the values of the metric of an instance, the latest value comes last.
+// The values are returned as the Average of the datapoints ending at the EndTime of the query,
+// with the Max and Min 10 above and below. An instance without values has no datapoints.
+func (s *Server) PutMetricData(namespace, instanceId, metricName string, values ...float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	series := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		series = append(series, v)
+	}
+	s.store("metricData").put(strings.Join([]string{namespace, instanceId, metricName}, ":"),
+		map[string]interface{}{"Values": series})
+}
+
+// monitorMetrics is the metric catalog of each namespace
+var monitorMetrics = map[string][]map[string]interface{}{
+	"KEC": {
+		{"MetricName": "cpu.utilizition.total", "MetricDesc": "CPU utilization", "Interval": "1", "Type": "GAUGE", "Unit": "Percent"},
+		{"MetricName": "memory.utilizition.total", "MetricDesc": "Memory utilization", "Interval": "1", "Type": "GAUGE", "Unit": "Percent"},
+		{"MetricName": "net.if.in", "MetricDesc": "Inbound bandwidth", "Interval": "1", "Type": "COUNTER", "Unit": "Bits/Second"},
+		{"MetricName": "net.if.out", "MetricDesc": "Outbound bandwidth", "Interval": "1", "Type": "COUNTER", "Unit": "Bits/Second"},
+	},
+	"EIP": {
+		{"MetricName": "eip.bps.in", "MetricDesc": "Inbound bandwidth", "Interval": "1", "Type": "GAUGE", "Unit": "Bits/Second"},
+		{"MetricName": "eip.bps.out", "MetricDesc": "Outbound bandwidth", "Interval": "1", "Type": "GAUGE", "Unit": "Bits/Second"},
+	},
+}
+
+// monitorAlertUserKeys maps the params of the alert user to the keys of the response
+var monitorAlertUserKeys = map[string]string{
+	"UserName":  "userName",
+	"UserEmail": "userEmail",
+	"UserPhone": "userPhone",
+}
+
+// newMonitorId returns a numeric id as the monitor api does
+func (s *Server) newMonitorId() int {
+	s.seq++
+	return 1000 + s.seq
+}
+
+// monitorPage returns the page of the items, the PageIndex starts from 1
+func monitorPage(items []interface{}, p Params) []interface{} {
+	size := p.Int("PageSize", 10)
+	start := (p.Int("PageIndex", 1) - 1) * size
+	if start < 0 {
+		start = 0
+	}
+	if start > len(items) {
+		start = len(items)
+	}
+	items = items[start:]
+	if size < len(items) {
+		items = items[:size]
+	}
+	return items
+}
+
+func createMonitorAlarmPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	name, err := p.Require("PolicyName")
+	if err != nil {
+		return nil, err
+	}
+	triggerRules := p.set("TriggerRules")
+	if len(triggerRules) == 0 {
+		return nil, invalidParam("the param TriggerRules is required")
+	}
+	urls := p.List("URLNotice")
+	callbackUrl := ""
+	if len(urls) > 0 {
+		callbackUrl = urls[0]
+	}
+	id := s.newMonitorId()
+	s.store("monitor_alarm_policy").put(strconv.Itoa(id), map[string]interface{}{
+		"policyId":          id,
+		"policyName":        name,
+		"productType":       p.Int("ProductType", 0),
+		"policyType":        p.Int("PolicyType", 0),
+		"resourceBindType":  p.Int("ResourceBindType", 1),
+		"enabled":           1,
+		"instanceInfoCount": len(p.List("InstanceIds")),
+		"triggerRuleCount":  len(triggerRules),
+		"contactInfoCount":  len(p.set("UserNotice")),
+		"callbackUrl":       callbackUrl,
+	})
+	return map[string]interface{}{"policyId": id}, nil
+}
+
+func describeMonitorAlarmPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("PolicyId")
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.store("monitor_alarm_policy").get(id)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"data": copyValue(policy)}, nil
+}
+
+func deleteMonitorAlarmPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("PolicyIds")
+	if len(ids) == 0 {
+		return nil, invalidParam("the param PolicyIds is required")
+	}
+	for _, id := range ids {
+		if _, err := s.store("monitor_alarm_policy").get(id); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range ids {
+		s.store("monitor_alarm_policy").remove(id)
+	}
+	return map[string]interface{}{"data": true}, nil
+}
+
+func listMonitorAlarmPolicy(s *Server, p Params) (map[string]interface{}, error) {
+	policies := s.store("monitor_alarm_policy").describe(nil)
+	return map[string]interface{}{
+		"Data":       map[string]interface{}{"AlarmPolicyList": monitorPage(policies, p)},
+		"totalCount": len(policies),
+	}, nil
+}
+
+func addMonitorUserGroup(s *Server, p Params) (map[string]interface{}, error) {
+	name, err := p.Require("UserGrpName")
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range s.store("monitor_user_group").describe(nil) {
+		if v.(map[string]interface{})["name"] == name {
+			return nil, invalidParam("the user group %s already exists", name)
+		}
+	}
+	id := s.newMonitorId()
+	s.store("monitor_user_group").put(strconv.Itoa(id), map[string]interface{}{
+		"userGrpId": id,
+		"name":      name,
+		"userCount": 0,
+	})
+	return map[string]interface{}{"Data": map[string]interface{}{"UserGrpId": id}}, nil
+}
+
+func (s *Server) requireMonitorUserGroup(id string) (map[string]interface{}, error) {
+	return s.store("monitor_user_group").get(id)
+}
+
+func updateMonitorUserGroup(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("UserGrpId")
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.requireMonitorUserGroup(id)
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.Require("UserGrpName")
+	if err != nil {
+		return nil, err
+	}
+	group["name"] = name
+	// the group names kept by the users are renamed too
+	for _, v := range s.store("monitor_alert_user").objects {
+		for _, g := range v.data["UserGroups"].([]interface{}) {
+			if g := g.(map[string]interface{}); strconv.Itoa(g["UserGrpId"].(int)) == id {
+				g["GroupName"] = name
+			}
+		}
+	}
+	return map[string]interface{}{"Data": true}, nil
+}
+
+func deleteMonitorUserGroup(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("UserGrpId")
+	if len(ids) == 0 {
+		return nil, invalidParam("the param UserGrpId is required")
+	}
+	for _, id := range ids {
+		group, err := s.requireMonitorUserGroup(id)
+		if err != nil {
+			return nil, err
+		}
+		if group["userCount"].(int) > 0 {
+			return nil, invalidParam("the user group %s is not empty", id)
+		}
+	}
+	for _, id := range ids {
+		s.store("monitor_user_group").remove(id)
+	}
+	return map[string]interface{}{"Data": true}, nil
+}
+
+func getMonitorUserGroup(s *Server, p Params) (map[string]interface{}, error) {
+	groups := s.store("monitor_user_group").describe(nil)
+	return map[string]interface{}{
+		"Data":       map[string]interface{}{"UserGrpList": groups},
+		"totalCount": len(groups),
+	}, nil
+}
+
+// setMonitorUserGroups replaces the groups of the user and counts the users of the groups
+func (s *Server) setMonitorUserGroups(user map[string]interface{}, groupIds []string) error {
+	groups := make([]interface{}, 0, len(groupIds))
+	for _, id := range groupIds {
+		group, err := s.requireMonitorUserGroup(id)
+		if err != nil {
+			return err
+		}
+		groups = append(groups, map[string]interface{}{
+			"UserGrpId": group["userGrpId"],
+			"GroupName": group["name"],
+		})
+	}
+	if old, ok := user["UserGroups"].([]interface{}); ok {
+		for _, g := range old {
+			if group, err := s.requireMonitorUserGroup(strconv.Itoa(g.(map[string]interface{})["UserGrpId"].(int))); err == nil {
+				group["userCount"] = group["userCount"].(int) - 1
+			}
+		}
+	}
+	for _, g := range groups {
+		group, _ := s.requireMonitorUserGroup(strconv.Itoa(g.(map[string]interface{})["UserGrpId"].(int)))
+		group["userCount"] = group["userCount"].(int) + 1
+	}
+	user["UserGroups"] = groups
+	return nil
+}
+
+func addMonitorAlertUser(s *Server, p Params) (map[string]interface{}, error) {
+	user := map[string]interface{}{}
+	for param, key := range monitorAlertUserKeys {
+		v, err := p.Require(param)
+		if err != nil {
+			return nil, err
+		}
+		user[key] = v
+	}
+	id := s.newMonitorId()
+	user["userId"] = id
+	user["userStatus"] = 1
+	if err := s.setMonitorUserGroups(user, p.List("UserGrpId")); err != nil {
+		return nil, err
+	}
+	s.store("monitor_alert_user").put(strconv.Itoa(id), user)
+	return map[string]interface{}{"Data": map[string]interface{}{"UserId": id}}, nil
+}
+
+func updateMonitorAlertUser(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("UserId")
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.store("monitor_alert_user").get(id)
+	if err != nil {
+		return nil, err
+	}
+	for param, key := range monitorAlertUserKeys {
+		if v := p.Get(param); v != "" {
+			user[key] = v
+		}
+	}
+	if err = s.setMonitorUserGroups(user, p.List("UserGrpId")); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"Data": true}, nil
+}
+
+func updateMonitorAlertUserStatus(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("UserId")
+	if len(ids) == 0 {
+		return nil, invalidParam("the param UserId is required")
+	}
+	status, err := p.Require("UserStatus")
+	if err != nil {
+		return nil, err
+	}
+	if status != "0" && status != "1" {
+		return nil, invalidParam("the UserStatus must be 0 or 1")
+	}
+	users := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		user, err := s.store("monitor_alert_user").get(id)
+		if err != nil {
+			return nil, err
+		}
+		user["userStatus"] = p.Int("UserStatus", 1)
+		users = append(users, copyValue(user))
+	}
+	return map[string]interface{}{"Data": map[string]interface{}{"UserList": users}}, nil
+}
+
+func deleteMonitorAlertUser(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("UserId")
+	if len(ids) == 0 {
+		return nil, invalidParam("the param UserId is required")
+	}
+	for _, id := range ids {
+		if _, err := s.store("monitor_alert_user").get(id); err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range ids {
+		user, _ := s.store("monitor_alert_user").get(id)
+		_ = s.setMonitorUserGroups(user, nil)
+		s.store("monitor_alert_user").remove(id)
+	}
+	return map[string]interface{}{"Data": true}, nil
+}
+
+func getMonitorAlertUser(s *Server, p Params) (map[string]interface{}, error) {
+	groupIds := p.List("UserGrpId")
+	users := s.store("monitor_alert_user").describe(func(data map[string]interface{}) bool {
+		if len(groupIds) == 0 {
+			return true
+		}
+		for _, g := range data["UserGroups"].([]interface{}) {
+			if matchIds(g.(map[string]interface{}), "UserGrpId", groupIds) {
+				return true
+			}
+		}
+		return false
+	})
+	return map[string]interface{}{
+		"Data":       map[string]interface{}{"UserList": users},
+		"totalCount": len(users),
+	}, nil
+}
+
+func listMonitorMetrics(s *Server, p Params) (map[string]interface{}, error) {
+	namespace, err := p.Require("Namespace")
+	if err != nil {
+		return nil, err
+	}
+	metricName := p.Get("MetricName")
+	metrics := make([]interface{}, 0)
+	for _, v := range monitorMetrics[namespace] {
+		if metricName != "" && v["MetricName"] != metricName {
+			continue
+		}
+		metric := copyValue(v).(map[string]interface{})
+		metric["Namespace"] = namespace
+		metric["InstanceId"] = p.Get("InstanceID")
+		metrics = append(metrics, metric)
+	}
+	return map[string]interface{}{
+		"ListMetricsResult": map[string]interface{}{
+			"Metrics": map[string]interface{}{"Member": monitorPage(metrics, p)},
+		},
+	}, nil
+}
+
+func getMonitorMetricStatisticsBatch(s *Server, p Params) (map[string]interface{}, error) {
+	namespace, err := p.Require("Namespace")
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := time.Parse(time.RFC3339, p.Get("EndTime"))
+	if err != nil {
+		return nil, invalidParam("the param EndTime %q is invalid", p.Get("EndTime"))
+	}
+	period := time.Duration(p.Int("Period", 300)) * time.Second
+	results := make([]interface{}, 0)
+	for _, metric := range p.set("Metrics") {
+		metric := metric.(map[string]interface{})
+		instanceId := fmt.Sprintf("%v", metric["InstanceID"])
+		metricName := fmt.Sprintf("%v", metric["MetricName"])
+		points := make([]interface{}, 0)
+		if data, err := s.store("metricData").get(strings.Join([]string{namespace, instanceId, metricName}, ":")); err == nil {
+			values := data["Values"].([]interface{})
+			for i, v := range values {
+				ts := endTime.Add(-time.Duration(len(values)-1-i) * period)
+				value := v.(float64)
+				points = append(points, map[string]interface{}{
+					"Timestamp":     ts.Format(time.RFC3339),
+					"UnixTimestamp": strconv.FormatInt(ts.Unix()*1000, 10),
+					"Average":       strconv.FormatFloat(value, 'f', -1, 64),
+					"Max":           strconv.FormatFloat(value+10, 'f', -1, 64),
+					"Min":           strconv.FormatFloat(value-10, 'f', -1, 64),
+				})
+			}
+		}
+		results = append(results, map[string]interface{}{
+			"Instance":   instanceId,
+			"Label":      metricName,
+			"Datapoints": map[string]interface{}{"Member": points},
+		})
+	}
+	if len(results) == 0 {
+		return nil, invalidParam("the param Metrics is required")
+	}
+	return map[string]interface{}{
+		"GetMetricStatisticsBatchResults": results,
+	}, nil
+}
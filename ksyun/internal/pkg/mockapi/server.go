@@ -0,0 +1,525 @@
+// Package mockapi is a local emulator of the Ksyun OpenAPI for the unit tests of the provider.
+// It keeps the resources in memory and simulates the async state transitions,
+// the provider is pointed to it by the domain and ignore_service settings.
+package mockapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// HandlerFunc handles an action with the request params, it returns the response body,
+// or an *Error that is written as the api error response.
+type HandlerFunc func(s *Server, p Params) (map[string]interface{}, error)
+
+// Error is the api error
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func notFound(kind, id string) *Error {
+	return &Error{
+		StatusCode: http.StatusNotFound,
+		Code:       "NotFound",
+		Message:    fmt.Sprintf("the %s %s is not found", kind, id),
+	}
+}
+
+func invalidParam(format string, a ...interface{}) *Error {
+	return &Error{
+		StatusCode: http.StatusBadRequest,
+		Code:       "InvalidParameter",
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+// Server is the mock api server
+type Server struct {
+	*httptest.Server
+
+	// PendingDescribes is the number of describe calls that a resource stays in
+	// the intermediate state after created, 1 by default.
+	PendingDescribes int
+
+	mu       sync.Mutex
+	handlers map[string]HandlerFunc
+	// versioned are the handlers of the actions shared by several services, keyed by the api version
+	versioned map[string]map[string]HandlerFunc
+	stores    map[string]*store
+	seq       int
+	requests  []Request
+	// allocated is the number of allocated private ips of each subnet
+	allocated map[string]int
+}
+
+// Request is a received api call
+type Request struct {
+	Action string
+	Params Params
+}
+
+// NewServer starts a mock api server with the built-in actions,
+// the caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		PendingDescribes: 1,
+		handlers:         make(map[string]HandlerFunc),
+		versioned:        make(map[string]map[string]HandlerFunc),
+		stores:           make(map[string]*store),
+		allocated:        make(map[string]int),
+	}
+	registerCommonHandlers(s)
+	registerVpcHandlers(s)
+	registerVpcPeeringHandlers(s)
+	registerEipHandlers(s)
+	registerKecHandlers(s)
+	registerSlbHandlers(s)
+	registerKceHandlers(s)
+	registerKrdsHandlers(s)
+	registerImageHandlers(s)
+	registerCenHandlers(s)
+	registerIamHandlers(s)
+	registerKlogHandlers(s)
+	registerKmrHandlers(s)
+	registerClickhouseHandlers(s)
+	registerMonitorHandlers(s)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Domain returns the address used as the domain of the provider,
+// together with ignore_service = true and force_https = false.
+func (s *Server) Domain() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// Handle registers the handler of an action, it overrides the built-in one.
+func (s *Server) Handle(action string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[action] = h
+}
+
+// Handler returns the handler of an action, so that a test is able to wrap the built-in one.
+func (s *Server) Handler(action string) HandlerFunc {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.handlers[action]
+}
+
+// Requests returns the received api calls in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// handleVersion registers the handler of an action of the api version,
+// it's used by the services sharing the action names with the others.
+// Several services may share an api version too, so a duplicated registration panics.
+func (s *Server) handleVersion(version, action string, h HandlerFunc) {
+	if s.versioned[version] == nil {
+		s.versioned[version] = make(map[string]HandlerFunc)
+	}
+	if _, ok := s.versioned[version][action]; ok {
+		panic(fmt.Sprintf("the action %s of version %s is registered twice", action, version))
+	}
+	s.versioned[version][action] = h
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	p, err := readParams(r)
+	if err != nil {
+		writeError(w, invalidParam("%s", err))
+		return
+	}
+	action := r.URL.Query().Get("Action")
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Action: action, Params: p})
+	h, ok := s.versioned[r.URL.Query().Get("Version")][action]
+	if !ok {
+		h, ok = s.handlers[action]
+	}
+	if !ok {
+		s.mu.Unlock()
+		writeError(w, &Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "UnsupportedOperation",
+			Message:    fmt.Sprintf("the action %s is not supported by the mock server", action),
+		})
+		return
+	}
+	// the handlers are called one at a time, so they are free to access the stores
+	var resp map[string]interface{}
+	if err = dryRun(p); err == nil {
+		resp, err = h(s, p)
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		if e, ok := err.(*Error); ok {
+			writeError(w, e)
+		} else {
+			writeError(w, &Error{StatusCode: http.StatusInternalServerError, Code: "InternalError", Message: err.Error()})
+		}
+		return
+	}
+	if resp == nil {
+		resp = map[string]interface{}{}
+	}
+	resp["RequestId"] = s.nextRequestId()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) nextRequestId() string {
+	s.seq++
+	return fmt.Sprintf("mock-request-%d", s.seq)
+}
+
+// newId returns a resource id in uuid format
+func (s *Server) newId() string {
+	s.seq++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", s.seq)
+}
+
+func writeError(w http.ResponseWriter, e *Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.StatusCode)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"RequestID": "mock-error",
+		"Error": map[string]interface{}{
+			"Code":    e.Code,
+			"Message": e.Message,
+		},
+	})
+}
+
+// Params is the flattened request params, such as InstanceId.1, Filter.1.Name
+type Params map[string]string
+
+func readParams(r *http.Request) (Params, error) {
+	p := make(Params)
+	for k, v := range r.URL.Query() {
+		if k != "Action" && k != "Version" && len(v) > 0 {
+			p[k] = v[0]
+		}
+	}
+	if r.Body == nil {
+		return p, nil
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		return p, err
+	}
+	if strings.Contains(r.Header.Get("Content-Type"), "json") {
+		var m map[string]interface{}
+		if err = json.Unmarshal(body, &m); err != nil {
+			return p, err
+		}
+		flatten("", m, p)
+		return p, nil
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return p, err
+	}
+	for k, v := range form {
+		if len(v) > 0 {
+			p[k] = v[0]
+		}
+	}
+	return p, nil
+}
+
+// flatten converts the json body into the flattened form, the list index starts from 1.
+func flatten(prefix string, v interface{}, p Params) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if prefix != "" {
+				k = prefix + "." + k
+			}
+			flatten(k, value, p)
+		}
+	case []interface{}:
+		for i, value := range v {
+			flatten(prefix+"."+strconv.Itoa(i+1), value, p)
+		}
+	default:
+		p[prefix] = fmt.Sprintf("%v", v)
+	}
+}
+
+// Get returns the param, or the default value if it's not set
+func (p Params) Get(name string, defaultValue ...string) string {
+	if v, ok := p[name]; ok {
+		return v
+	}
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+	return ""
+}
+
+// Bool returns the param as a bool value
+func (p Params) Bool(name string) bool {
+	b, _ := strconv.ParseBool(p[name])
+	return b
+}
+
+// Int returns the param as an int value, or the default value if it's not set or invalid
+func (p Params) Int(name string, defaultValue int) int {
+	if i, err := strconv.Atoi(p[name]); err == nil {
+		return i
+	}
+	return defaultValue
+}
+
+// Require returns the param, or an error if it's missing
+func (p Params) Require(name string) (string, error) {
+	if v := p[name]; v != "" {
+		return v, nil
+	}
+	return "", invalidParam("the param %s is required", name)
+}
+
+// List returns the values of name.1, name.2 ... in order
+func (p Params) List(name string) []string {
+	var values []string
+	for i := 1; ; i++ {
+		v, ok := p[name+"."+strconv.Itoa(i)]
+		if !ok {
+			return values
+		}
+		values = append(values, v)
+	}
+}
+
+// Filters returns the values of Filter.N.Name and Filter.N.Value.M grouped by name
+func (p Params) Filters() map[string][]string {
+	filters := make(map[string][]string)
+	for i := 1; ; i++ {
+		name, ok := p["Filter."+strconv.Itoa(i)+".Name"]
+		if !ok {
+			return filters
+		}
+		filters[name] = append(filters[name], p.List("Filter."+strconv.Itoa(i)+".Value")...)
+	}
+}
+
+// Tags returns the values of Tag.N.Key and Tag.N.Value
+func (p Params) Tags() map[string]string {
+	tags := make(map[string]string)
+	for i := 1; ; i++ {
+		key, ok := p["Tag."+strconv.Itoa(i)+".Key"]
+		if !ok {
+			return tags
+		}
+		tags[key] = p["Tag."+strconv.Itoa(i)+".Value"]
+	}
+}
+
+// Sub returns the params under the prefix with the prefix trimmed, such as Config.Name for the prefix Config
+func (p Params) Sub(prefix string) Params {
+	sub := make(Params)
+	for k, v := range p {
+		if strings.HasPrefix(k, prefix+".") {
+			sub[strings.TrimPrefix(k, prefix+".")] = v
+		}
+	}
+	return sub
+}
+
+// object is a resource kept in the store
+type object struct {
+	data map[string]interface{}
+	// transitions are applied one by one on each describe, simulating the async operations.
+	// A transition returning true removes the object.
+	transitions []func(data map[string]interface{}) bool
+}
+
+// store keeps the objects of a kind of resource in creation order
+type store struct {
+	kind    string
+	ids     []string
+	objects map[string]*object
+}
+
+func (s *Server) store(kind string) *store {
+	st, ok := s.stores[kind]
+	if !ok {
+		st = &store{kind: kind, objects: make(map[string]*object)}
+		s.stores[kind] = st
+	}
+	return st
+}
+
+// put adds an object with the transitions applied on the following describe calls
+func (st *store) put(id string, data map[string]interface{}, transitions ...func(data map[string]interface{}) bool) {
+	if _, ok := st.objects[id]; !ok {
+		st.ids = append(st.ids, id)
+	}
+	st.objects[id] = &object{data: data, transitions: transitions}
+}
+
+func (st *store) get(id string) (map[string]interface{}, error) {
+	o, ok := st.objects[id]
+	if !ok {
+		return nil, notFound(st.kind, id)
+	}
+	return o.data, nil
+}
+
+// transit appends transitions to the object
+func (st *store) transit(id string, transitions ...func(data map[string]interface{}) bool) {
+	if o, ok := st.objects[id]; ok {
+		o.transitions = append(o.transitions, transitions...)
+	}
+}
+
+func (st *store) remove(id string) {
+	if _, ok := st.objects[id]; !ok {
+		return
+	}
+	delete(st.objects, id)
+	for i, v := range st.ids {
+		if v == id {
+			st.ids = append(st.ids[:i], st.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// describe returns the objects matched by the filter in creation order,
+// a transition of each matched object is applied before it's returned.
+func (st *store) describe(match func(data map[string]interface{}) bool) []interface{} {
+	result := make([]interface{}, 0)
+	for _, id := range append([]string(nil), st.ids...) {
+		o := st.objects[id]
+		if match != nil && !match(o.data) {
+			continue
+		}
+		if len(o.transitions) > 0 {
+			next := o.transitions[0]
+			o.transitions = o.transitions[1:]
+			if next(o.data) {
+				st.remove(id)
+				continue
+			}
+		}
+		result = append(result, copyValue(o.data))
+	}
+	return result
+}
+
+// after returns n transitions, only the last one applies fn, the others keep the state.
+func after(n int, fn func(data map[string]interface{}) bool) []func(data map[string]interface{}) bool {
+	transitions := make([]func(data map[string]interface{}) bool, 0, n+1)
+	for i := 0; i < n; i++ {
+		transitions = append(transitions, func(map[string]interface{}) bool { return false })
+	}
+	return append(transitions, fn)
+}
+
+func setState(key, state string) func(data map[string]interface{}) bool {
+	return func(data map[string]interface{}) bool {
+		data[key] = state
+		return false
+	}
+}
+
+func removed(map[string]interface{}) bool {
+	return true
+}
+
+// matchIds matches the objects whose key is in the ids, an empty ids matches all.
+func matchIds(data map[string]interface{}, key string, ids []string) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, id := range ids {
+		if fmt.Sprintf("%v", data[key]) == id {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFilters matches the objects by the filters, the filter name is mapped to the key of the object.
+func matchFilters(data map[string]interface{}, filters map[string][]string, keys map[string]string) bool {
+	for name, values := range filters {
+		key, ok := keys[name]
+		if !ok {
+			continue
+		}
+		if !matchIds(data, key, values) {
+			return false
+		}
+	}
+	return true
+}
+
+// copyValue deep copies the value, so the response is not changed by later operations
+func copyValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			m[k] = copyValue(value)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			s[i] = copyValue(value)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+// set returns the params of prefix, such as DataDisk.1.Type, as a list of maps
+func (p Params) set(prefix string) []interface{} {
+	groups := make(map[int]map[string]interface{})
+	for k, v := range p {
+		if !strings.HasPrefix(k, prefix+".") {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(k, prefix+"."), ".", 2)
+		index, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts) != 2 {
+			continue
+		}
+		if groups[index] == nil {
+			groups[index] = make(map[string]interface{})
+		}
+		groups[index][parts[1]] = v
+	}
+	indexes := make([]int, 0, len(groups))
+	for i := range groups {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	result := make([]interface{}, 0, len(indexes))
+	for _, i := range indexes {
+		result = append(result, groups[i])
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package mockapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func call(t *testing.T, s *Server, action string, params url.Values) (int, map[string]interface{}) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("Action", action)
+	resp, err := http.Get(s.URL + "/?" + params.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body := make(map[string]interface{})
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, body
+}
+
+func TestVpcLifecycle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	status, body := call(t, s, "CreateVpc", url.Values{"VpcName": {"foo"}, "CidrBlock": {"10.1.0.0/16"}})
+	if status != http.StatusOK {
+		t.Fatalf("create vpc: %d %v", status, body)
+	}
+	vpcId := body["Vpc"].(map[string]interface{})["VpcId"].(string)
+
+	status, body = call(t, s, "CreateSubnet", url.Values{
+		"VpcId":            {vpcId},
+		"CidrBlock":        {"10.1.0.0/24"},
+		"AvailabilityZone": {"cn-beijing-6a"},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("create subnet: %d %v", status, body)
+	}
+	subnetId := body["Subnet"].(map[string]interface{})["SubnetId"].(string)
+	if v := body["Subnet"].(map[string]interface{})["AvailableIpNumber"]; v != "253" {
+		t.Errorf("available ip number: %v", v)
+	}
+
+	if status, _ = call(t, s, "DeleteVpc", url.Values{"VpcId": {vpcId}}); status != http.StatusBadRequest {
+		t.Errorf("delete vpc in use: %d", status)
+	}
+	if status, _ = call(t, s, "DeleteSubnet", url.Values{"SubnetId": {subnetId}}); status != http.StatusOK {
+		t.Errorf("delete subnet: %d", status)
+	}
+	if status, _ = call(t, s, "DeleteVpc", url.Values{"VpcId": {vpcId}}); status != http.StatusOK {
+		t.Errorf("delete vpc: %d", status)
+	}
+	_, body = call(t, s, "DescribeVpcs", url.Values{"VpcId.1": {vpcId}})
+	if vpcs := body["VpcSet"].([]interface{}); len(vpcs) != 0 {
+		t.Errorf("vpc not deleted: %v", vpcs)
+	}
+}
+
+func TestInstanceTransitions(t *testing.T) {
+	s := NewServer()
+	s.PendingDescribes = 2
+	defer s.Close()
+
+	_, body := call(t, s, "CreateVpc", nil)
+	vpcId := body["Vpc"].(map[string]interface{})["VpcId"].(string)
+	_, body = call(t, s, "CreateSubnet", url.Values{"VpcId": {vpcId}, "CidrBlock": {"10.0.0.0/24"}})
+	subnetId := body["Subnet"].(map[string]interface{})["SubnetId"].(string)
+
+	status, body := call(t, s, "RunInstances", url.Values{
+		"ImageId":           {"IMG-test"},
+		"InstanceType":      {"N3.2B"},
+		"SubnetId":          {subnetId},
+		"SecurityGroupId.1": {"sg-test"},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("run instances: %d %v", status, body)
+	}
+	instanceId := body["InstancesSet"].([]interface{})[0].(map[string]interface{})["InstanceId"].(string)
+
+	state := func() string {
+		_, body := call(t, s, "DescribeInstances", url.Values{"InstanceId.1": {instanceId}})
+		instances := body["InstancesSet"].([]interface{})
+		if len(instances) == 0 {
+			return ""
+		}
+		return instances[0].(map[string]interface{})["InstanceState"].(map[string]interface{})["Name"].(string)
+	}
+	for i, expected := range []string{"scheduling", "scheduling", "active", "active"} {
+		if actual := state(); actual != expected {
+			t.Fatalf("describe %d: expected %s, got %s", i, expected, actual)
+		}
+	}
+
+	if status, _ = call(t, s, "TerminateInstances", url.Values{"InstanceId.1": {instanceId}}); status != http.StatusOK {
+		t.Fatalf("terminate instances: %d", status)
+	}
+	if status, _ = call(t, s, "DeleteSubnet", url.Values{"SubnetId": {subnetId}}); status != http.StatusOK {
+		t.Errorf("delete subnet after terminating: %d", status)
+	}
+	for i := 0; state() != ""; i++ {
+		if i > s.PendingDescribes {
+			t.Fatal("instance not removed after terminating")
+		}
+	}
+}
+
+func TestUnsupportedAction(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	status, body := call(t, s, "DescribeNothing", nil)
+	if status != http.StatusBadRequest {
+		t.Errorf("status: %d", status)
+	}
+	if code := body["Error"].(map[string]interface{})["Code"]; code != "UnsupportedOperation" {
+		t.Errorf("code: %v", code)
+	}
+}
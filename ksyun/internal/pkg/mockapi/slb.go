@@ -0,0 +1,157 @@
+package mockapi
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func registerSlbHandlers(s *Server) {
+	s.handlers["CreateLoadBalancer"] = createLoadBalancer
+	s.handlers["DescribeLoadBalancers"] = describeLoadBalancers
+	s.handlers["ModifyLoadBalancer"] = modifyLoadBalancer
+	s.handlers["DeleteLoadBalancer"] = deleteLoadBalancer
+	s.handlers["DescribeLoadBalancerAttributes"] = describeLoadBalancerAttributes
+	s.handlers["ModifyLoadBalancerAttributes"] = modifyLoadBalancerAttributes
+}
+
+func loadBalancerState(adminStateUp string) string {
+	if up, _ := strconv.ParseBool(adminStateUp); up {
+		return "start"
+	}
+	return "stop"
+}
+
+func createLoadBalancer(s *Server, p Params) (map[string]interface{}, error) {
+	vpcId, err := p.Require("VpcId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("vpc").get(vpcId); err != nil {
+		return nil, err
+	}
+	lbType := p.Get("Type", "public")
+	id := s.newId()
+	lb := map[string]interface{}{
+		"LoadBalancerId":    id,
+		"LoadBalancerName":  p.Get("LoadBalancerName", "lb-"+id[len(id)-4:]),
+		"VpcId":             vpcId,
+		"Type":              lbType,
+		"SubnetId":          "",
+		"PrivateIpAddress":  "",
+		"PublicIp":          "",
+		"ProjectId":         p.Get("ProjectId", DefaultProjectId),
+		"LoadBalancerState": loadBalancerState(p.Get("AdminStateUp", "true")),
+		"IpVersion":         p.Get("IpVersion", "ipv4"),
+		"State":             "associate",
+		"IsWaf":             false,
+		"CreateTime":        now(),
+	}
+	if lbType == "internal" {
+		subnetId, err := p.Require("SubnetId")
+		if err != nil {
+			return nil, err
+		}
+		subnet, err := s.store("subnet").get(subnetId)
+		if err != nil {
+			return nil, err
+		}
+		lb["SubnetId"] = subnetId
+		lb["PrivateIpAddress"] = p.Get("PrivateIpAddress")
+		if lb["PrivateIpAddress"] == "" {
+			lb["PrivateIpAddress"] = s.allocateIp(subnet)
+		}
+	} else {
+		lb["PublicIp"] = fmt.Sprintf("120.131.%d.%d", s.seq/256%256, s.seq%256)
+	}
+	s.store("lb").put(id, lb)
+	s.store("lb_attributes").put(id, map[string]interface{}{
+		"access_logs.s3.enabled": "false",
+		"access_logs.s3.bucket":  "",
+	})
+	return map[string]interface{}{
+		"LoadBalancerId": id,
+		"PublicIp":       lb["PublicIp"],
+	}, nil
+}
+
+func describeLoadBalancers(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("LoadBalancerId")
+	projectIds := p.List("ProjectId")
+	filters := p.Filters()
+	lbs := s.store("lb").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "LoadBalancerId", ids) && matchIds(data, "ProjectId", projectIds) &&
+			matchFilters(data, filters, map[string]string{
+				"vpc-id": "VpcId",
+			})
+	})
+	return map[string]interface{}{"LoadBalancerDescriptions": lbs}, nil
+}
+
+func (s *Server) requireLoadBalancer(p Params) (map[string]interface{}, error) {
+	id, err := p.Require("LoadBalancerId")
+	if err != nil {
+		return nil, err
+	}
+	return s.store("lb").get(id)
+}
+
+func modifyLoadBalancer(s *Server, p Params) (map[string]interface{}, error) {
+	lb, err := s.requireLoadBalancer(p)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := p["LoadBalancerName"]; ok {
+		lb["LoadBalancerName"] = v
+	}
+	if v, ok := p["LoadBalancerState"]; ok {
+		lb["LoadBalancerState"] = v
+	}
+	if v, ok := p["AdminStateUp"]; ok {
+		lb["LoadBalancerState"] = loadBalancerState(v)
+	}
+	return copyValue(lb).(map[string]interface{}), nil
+}
+
+func deleteLoadBalancer(s *Server, p Params) (map[string]interface{}, error) {
+	lb, err := s.requireLoadBalancer(p)
+	if err != nil {
+		return nil, err
+	}
+	id := lb["LoadBalancerId"].(string)
+	s.store("lb").remove(id)
+	s.store("lb_attributes").remove(id)
+	s.store("tag").remove(tagKey("loadbalancer", id))
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func describeLoadBalancerAttributes(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("LoadBalancerId")
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := s.store("lb_attributes").get(id)
+	if err != nil {
+		return nil, err
+	}
+	set := make([]interface{}, 0, len(attributes))
+	for _, k := range []string{"access_logs.s3.enabled", "access_logs.s3.bucket"} {
+		set = append(set, map[string]interface{}{"Key": k, "Value": attributes[k]})
+	}
+	return map[string]interface{}{"LoadBalancerAttributeSet": set}, nil
+}
+
+func modifyLoadBalancerAttributes(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("LoadBalancerId")
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := s.store("lb_attributes").get(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range p.set("Attributes.member") {
+		m := attr.(map[string]interface{})
+		attributes[m["Key"].(string)] = m["Value"]
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
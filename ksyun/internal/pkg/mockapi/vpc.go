@@ -0,0 +1,343 @@
+package mockapi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func registerVpcHandlers(s *Server) {
+	s.handlers["CreateVpc"] = createVpc
+	s.handlers["DescribeVpcs"] = describeVpcs
+	s.handlers["DescribeAvailabilityZones"] = describeAvailabilityZones
+	s.handlers["ModifyVpc"] = modifyVpc
+	s.handlers["DeleteVpc"] = deleteVpc
+	s.handlers["CreateRoute"] = createRoute
+	s.handlers["DescribeRoutes"] = describeRoutes
+	s.handlers["DeleteRoute"] = deleteRoute
+	s.handlers["CreateSubnet"] = createSubnet
+	s.handlers["DescribeSubnets"] = describeSubnets
+	s.handlers["ModifySubnet"] = modifySubnet
+	s.handlers["DeleteSubnet"] = deleteSubnet
+	s.handlers["DescribeNetworkInterfaces"] = describeNetworkInterfaces
+}
+
+func now() string {
+	return time.Now().Format("2006-01-02 15:04:05")
+}
+
+func inUse(kind, id, by string) *Error {
+	return &Error{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ResourceInUse",
+		Message:    fmt.Sprintf("the %s %s is in use by %s", kind, id, by),
+	}
+}
+
+func createVpc(s *Server, p Params) (map[string]interface{}, error) {
+	cidr := p.Get("CidrBlock", "10.0.0.0/16")
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		return nil, invalidParam("the CidrBlock %s is invalid", cidr)
+	}
+	id := s.newId()
+	vpc := map[string]interface{}{
+		"VpcId":      id,
+		"VpcName":    p.Get("VpcName", "vpc-"+id[len(id)-4:]),
+		"CidrBlock":  cidr,
+		"IsDefault":  p.Bool("IsDefault"),
+		"CreateTime": now(),
+	}
+	if p.Bool("ProvidedIpv6CidrBlock") {
+		vpc["Ipv6CidrBlockAssociationSet"] = []interface{}{
+			map[string]interface{}{"Ipv6CidrBlock": "2400:b400:1::/56"},
+		}
+	}
+	s.store("vpc").put(id, vpc)
+	return map[string]interface{}{"Vpc": copyValue(vpc)}, nil
+}
+
+func describeVpcs(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("VpcId")
+	vpcs := s.store("vpc").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "VpcId", ids)
+	})
+	return map[string]interface{}{"VpcSet": vpcs}, nil
+}
+
+// describeAvailabilityZones returns the zones of the DefaultRegion, the mock doesn't tell the regions apart
+func describeAvailabilityZones(s *Server, p Params) (map[string]interface{}, error) {
+	zones := make([]interface{}, 0)
+	for _, suffix := range []string{"a", "b"} {
+		zones = append(zones, map[string]interface{}{
+			"AvailabilityZoneName": DefaultRegion + suffix,
+		})
+	}
+	return map[string]interface{}{"AvailabilityZoneInfo": zones}, nil
+}
+
+func modifyVpc(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("VpcId")
+	if err != nil {
+		return nil, err
+	}
+	vpc, err := s.store("vpc").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := p["VpcName"]; ok {
+		vpc["VpcName"] = v
+	}
+	return map[string]interface{}{"Vpc": copyValue(vpc)}, nil
+}
+
+func deleteVpc(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("VpcId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("vpc").get(id); err != nil {
+		return nil, err
+	}
+	for _, subnet := range s.store("subnet").objects {
+		if subnet.data["VpcId"] == id {
+			return nil, inUse("vpc", id, "subnet "+subnet.data["SubnetId"].(string))
+		}
+	}
+	for _, instance := range s.store("cen_instance").objects {
+		if instance.data["InstanceId"] == id {
+			return nil, inUse("vpc", id, "cen "+instance.data["CenId"].(string))
+		}
+	}
+	s.store("vpc").remove(id)
+	return map[string]interface{}{"Return": true}, nil
+}
+
+// routeTargets are the params of the next hop of each route type
+var routeTargets = map[string]string{
+	"Tunnel":        "TunnelId",
+	"Host":          "InstanceId",
+	"Peering":       "VpcPeeringConnectionId",
+	"DirectConnect": "DirectConnectGatewayId",
+	"Vpn":           "VpnTunnelId",
+}
+
+func createRoute(s *Server, p Params) (map[string]interface{}, error) {
+	vpcId, err := p.Require("VpcId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("vpc").get(vpcId); err != nil {
+		return nil, err
+	}
+	cidr, err := p.Require("DestinationCidrBlock")
+	if err != nil {
+		return nil, err
+	}
+	routeType, err := p.Require("RouteType")
+	if err != nil {
+		return nil, err
+	}
+	id := s.newId()
+	route := map[string]interface{}{
+		"RouteId":              id,
+		"VpcId":                vpcId,
+		"DestinationCidrBlock": cidr,
+		"RouteType":            routeType,
+		"NextHopSet":           []interface{}{},
+		"CreateTime":           now(),
+	}
+	if key, ok := routeTargets[routeType]; ok {
+		target, err := p.Require(key)
+		if err != nil {
+			return nil, err
+		}
+		if routeType == "Peering" {
+			peering, err := s.store("vpc_peering").get(target)
+			if err != nil {
+				return nil, err
+			}
+			if peering["State"] != "active" {
+				return nil, invalidParam("the vpc peering connection %s is not active", target)
+			}
+		}
+		route[key] = target
+		route["NextHopSet"] = []interface{}{
+			map[string]interface{}{"GatewayId": target},
+		}
+	}
+	s.store("route").put(id, route)
+	return map[string]interface{}{"RouteId": id}, nil
+}
+
+func describeRoutes(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("RouteId")
+	filters := p.Filters()
+	routes := s.store("route").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "RouteId", ids) && matchFilters(data, filters, map[string]string{
+			"vpc-id":                 "VpcId",
+			"instance-id":            "InstanceId",
+			"destination-cidr-block": "DestinationCidrBlock",
+		})
+	})
+	return map[string]interface{}{"RouteSet": routes}, nil
+}
+
+func deleteRoute(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("RouteId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("route").get(id); err != nil {
+		return nil, err
+	}
+	s.store("route").remove(id)
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func createSubnet(s *Server, p Params) (map[string]interface{}, error) {
+	vpcId, err := p.Require("VpcId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("vpc").get(vpcId); err != nil {
+		return nil, err
+	}
+	cidr, err := p.Require("CidrBlock")
+	if err != nil {
+		return nil, err
+	}
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, invalidParam("the CidrBlock %s is invalid", cidr)
+	}
+	ones, bits := ipNet.Mask.Size()
+	id := s.newId()
+	subnet := map[string]interface{}{
+		"SubnetId":              id,
+		"VpcId":                 vpcId,
+		"SubnetName":            p.Get("SubnetName", "subnet-"+id[len(id)-4:]),
+		"CidrBlock":             cidr,
+		"SubnetType":            p.Get("SubnetType", "Normal"),
+		"AvailabilityZoneName":  p.Get("AvailabilityZone"),
+		"GatewayIp":             p.Get("GatewayIp"),
+		"DhcpIpFrom":            p.Get("DhcpIpFrom"),
+		"DhcpIpTo":              p.Get("DhcpIpTo"),
+		"Dns1":                  p.Get("Dns1", "198.18.254.41"),
+		"Dns2":                  p.Get("Dns2", "198.18.254.40"),
+		"VisitInternet":         p.Get("VisitInternet", "true") == "true",
+		"ProvidedIpv6CidrBlock": p.Bool("ProvidedIpv6CidrBlock"),
+		"AvailableIpNumber":     strconv.Itoa((1 << uint(bits-ones)) - 3),
+		"CreateTime":            now(),
+	}
+	s.store("subnet").put(id, subnet)
+	return map[string]interface{}{"Subnet": copyValue(subnet)}, nil
+}
+
+func describeSubnets(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("SubnetId")
+	filters := p.Filters()
+	subnets := s.store("subnet").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "SubnetId", ids) && matchFilters(data, filters, map[string]string{
+			"vpc-id":                 "VpcId",
+			"subnet-type":            "SubnetType",
+			"availability-zone-name": "AvailabilityZoneName",
+		})
+	})
+	return map[string]interface{}{"SubnetSet": subnets}, nil
+}
+
+func modifySubnet(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("SubnetId")
+	if err != nil {
+		return nil, err
+	}
+	subnet, err := s.store("subnet").get(id)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range []string{"SubnetName", "Dns1", "Dns2"} {
+		if v, ok := p[k]; ok {
+			subnet[k] = v
+		}
+	}
+	return map[string]interface{}{"Subnet": copyValue(subnet)}, nil
+}
+
+func deleteSubnet(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("SubnetId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("subnet").get(id); err != nil {
+		return nil, err
+	}
+	for _, ni := range s.store("network_interface").objects {
+		if ni.data["SubnetId"] == id {
+			return nil, inUse("subnet", id, "network interface "+ni.data["NetworkInterfaceId"].(string))
+		}
+	}
+	s.store("subnet").remove(id)
+	return map[string]interface{}{"Return": true}, nil
+}
+
+// allocateIp returns the next private ip of the subnet, the first two ips are reserved
+func (s *Server) allocateIp(subnet map[string]interface{}) string {
+	_, ipNet, _ := net.ParseCIDR(subnet["CidrBlock"].(string))
+	id := subnet["SubnetId"].(string)
+	used := s.allocated[id]
+	s.allocated[id] = used + 1
+	adjustAvailableIps(subnet, -1)
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(ipNet.IP.To4())+uint32(used)+2)
+	return ip.String()
+}
+
+// adjustAvailableIps changes the AvailableIpNumber of the subnet, which is a string in the api
+func adjustAvailableIps(subnet map[string]interface{}, delta int) {
+	n, _ := strconv.Atoi(subnet["AvailableIpNumber"].(string))
+	subnet["AvailableIpNumber"] = strconv.Itoa(n + delta)
+}
+
+// createNetworkInterface creates the network interface attached to the instance
+func (s *Server) createNetworkInterface(subnet map[string]interface{}, instanceId, niType, ip string, securityGroupIds []string) map[string]interface{} {
+	if ip == "" {
+		ip = s.allocateIp(subnet)
+	}
+	securityGroups := make([]interface{}, 0, len(securityGroupIds))
+	for _, sg := range securityGroupIds {
+		securityGroups = append(securityGroups, map[string]interface{}{"SecurityGroupId": sg})
+	}
+	id := s.newId()
+	ni := map[string]interface{}{
+		"NetworkInterfaceId":   id,
+		"NetworkInterfaceType": niType,
+		"VpcId":                subnet["VpcId"],
+		"SubnetId":             subnet["SubnetId"],
+		"PrivateIpAddress":     ip,
+		"MacAddress":           fmt.Sprintf("fa:16:3e:00:%02x:%02x", s.seq/256%256, s.seq%256),
+		"InstanceId":           instanceId,
+		"InstanceType":         "kec",
+		"SecurityGroupSet":     securityGroups,
+		"DNS1":                 subnet["Dns1"],
+		"DNS2":                 subnet["Dns2"],
+	}
+	s.store("network_interface").put(id, ni)
+	return ni
+}
+
+func describeNetworkInterfaces(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("NetworkInterfaceId")
+	filters := p.Filters()
+	interfaces := s.store("network_interface").describe(func(data map[string]interface{}) bool {
+		return matchIds(data, "NetworkInterfaceId", ids) && matchFilters(data, filters, map[string]string{
+			"instance-type": "InstanceType",
+			"instance-id":   "InstanceId",
+			"subnet-id":     "SubnetId",
+			"vpc-id":        "VpcId",
+		})
+	})
+	return map[string]interface{}{"NetworkInterfaceSet": interfaces}, nil
+}
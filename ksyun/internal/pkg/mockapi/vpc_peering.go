@@ -0,0 +1,129 @@
+package mockapi
+
+import "net/http"
+
+func registerVpcPeeringHandlers(s *Server) {
+	s.handlers["CreateVpcPeeringConnection"] = createVpcPeeringConnection
+	s.handlers["DescribeVpcPeeringConnections"] = describeVpcPeeringConnections
+	s.handlers["ModifyVpcPeeringConnection"] = modifyVpcPeeringConnection
+	s.handlers["AcceptVpcPeeringConnection"] = acceptVpcPeeringConnection
+	s.handlers["DeleteVpcPeeringConnection"] = deleteVpcPeeringConnection
+}
+
+func vpcInfo(vpc map[string]interface{}, region, accountId string) map[string]interface{} {
+	return map[string]interface{}{
+		"VpcId":      vpc["VpcId"],
+		"CidrBlock":  vpc["CidrBlock"],
+		"RegionName": region,
+		"AccountId":  accountId,
+	}
+}
+
+func createVpcPeeringConnection(s *Server, p Params) (map[string]interface{}, error) {
+	vpcId, err := p.Require("VpcId")
+	if err != nil {
+		return nil, err
+	}
+	vpc, err := s.store("vpc").get(vpcId)
+	if err != nil {
+		return nil, err
+	}
+	peerVpcId, err := p.Require("PeerVpcId")
+	if err != nil {
+		return nil, err
+	}
+	peerAccountId := p.Get("PeerAccountId", DefaultAccountId)
+	peerVpc := map[string]interface{}{"VpcId": peerVpcId}
+	// the peer vpc of another account is not kept by the mock server
+	if peerAccountId == DefaultAccountId {
+		if peerVpc, err = s.store("vpc").get(peerVpcId); err != nil {
+			return nil, err
+		}
+	}
+	peerRegion := p.Get("PeerRegion", DefaultRegion)
+	bandWidth := 0
+	if peerRegion != DefaultRegion {
+		bandWidth = p.Int("BandWidth", 10)
+	}
+	id := s.newId()
+	peering := map[string]interface{}{
+		"VpcPeeringConnectionId": id,
+		"PeeringName":            p.Get("PeeringName", "peering-"+id[len(id)-4:]),
+		"RequesterVpcInfo":       vpcInfo(vpc, DefaultRegion, DefaultAccountId),
+		"AccepterVpcInfo":        vpcInfo(peerVpc, peerRegion, peerAccountId),
+		"BandWidth":              bandWidth,
+		"State":                  "provisioning",
+		"CreateTime":             now(),
+	}
+	s.store("vpc_peering").put(id, peering, after(s.PendingDescribes, setState("State", "pending-acceptance"))...)
+	return map[string]interface{}{"VpcPeeringConnection": copyValue(peering)}, nil
+}
+
+func describeVpcPeeringConnections(s *Server, p Params) (map[string]interface{}, error) {
+	ids := p.List("VpcPeeringConnectionId")
+	filters := p.Filters()
+	peerings := s.store("vpc_peering").describe(func(data map[string]interface{}) bool {
+		requester := data["RequesterVpcInfo"].(map[string]interface{})
+		accepter := data["AccepterVpcInfo"].(map[string]interface{})
+		return matchIds(data, "VpcPeeringConnectionId", ids) &&
+			matchFilters(requester, filters, map[string]string{"vpc-id": "VpcId"}) &&
+			matchFilters(accepter, filters, map[string]string{"peer-vpc-id": "VpcId"}) &&
+			matchFilters(data, filters, map[string]string{"state": "State"})
+	})
+	return map[string]interface{}{"VpcPeeringConnectionSet": peerings}, nil
+}
+
+func modifyVpcPeeringConnection(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("VpcPeeringConnectionId")
+	if err != nil {
+		return nil, err
+	}
+	peering, err := s.store("vpc_peering").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := p["PeeringName"]; ok {
+		peering["PeeringName"] = v
+	}
+	if _, ok := p["BandWidth"]; ok {
+		peering["BandWidth"] = p.Int("BandWidth", 0)
+	}
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func acceptVpcPeeringConnection(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("VpcPeeringConnectionId")
+	if err != nil {
+		return nil, err
+	}
+	peering, err := s.store("vpc_peering").get(id)
+	if err != nil {
+		return nil, err
+	}
+	if peering["State"] != "pending-acceptance" {
+		return nil, &Error{
+			StatusCode: http.StatusBadRequest,
+			Code:       "InvalidStateTransition",
+			Message:    "the vpc peering connection " + id + " is not pending acceptance",
+		}
+	}
+	peering["State"] = "active"
+	return map[string]interface{}{"Return": true}, nil
+}
+
+func deleteVpcPeeringConnection(s *Server, p Params) (map[string]interface{}, error) {
+	id, err := p.Require("VpcPeeringConnectionId")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.store("vpc_peering").get(id); err != nil {
+		return nil, err
+	}
+	for _, route := range s.store("route").objects {
+		if route.data["VpcPeeringConnectionId"] == id {
+			return nil, inUse("vpc peering connection", id, "route "+route.data["RouteId"].(string))
+		}
+	}
+	s.store("vpc_peering").remove(id)
+	return map[string]interface{}{"Return": true}, nil
+}
@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig is the requests per second limit of api calls,
+// zero means unlimited.
+type RateLimitConfig struct {
+	// QPS is shared by all services
+	QPS int
+	// Burst is the max requests sent at once, defaults to QPS
+	Burst int
+	// ServiceQPS is the limit of each service, such as vpc, kec
+	ServiceQPS map[string]int
+}
+
+// RateLimitMetric is the statistics of the requests of a service
+type RateLimitMetric struct {
+	Requests  int64
+	Delayed   int64
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+// RateLimiter is a token bucket limiter shared by all service connections
+type RateLimiter struct {
+	global   *rate.Limiter
+	services map[string]*rate.Limiter
+
+	mu      sync.Mutex
+	metrics map[string]*RateLimitMetric
+}
+
+func NewRateLimiter(config *RateLimitConfig) *RateLimiter {
+	l := &RateLimiter{
+		services: make(map[string]*rate.Limiter),
+		metrics:  make(map[string]*RateLimitMetric),
+	}
+	if config == nil {
+		return l
+	}
+	if config.QPS > 0 {
+		l.global = newTokenBucket(config.QPS, config.Burst)
+	}
+	for service, qps := range config.ServiceQPS {
+		if qps > 0 {
+			l.services[strings.ToLower(service)] = newTokenBucket(qps, 0)
+		}
+	}
+	return l
+}
+
+func newTokenBucket(qps, burst int) *rate.Limiter {
+	if burst <= 0 {
+		burst = qps
+	}
+	return rate.NewLimiter(rate.Limit(qps), burst)
+}
+
+// Wait blocks until the request of the service is allowed, returns the waited time.
+func (l *RateLimiter) Wait(ctx context.Context, service string) (time.Duration, error) {
+	start := time.Now()
+	if limiter, ok := l.services[strings.ToLower(service)]; ok {
+		if err := limiter.Wait(ctx); err != nil {
+			return time.Since(start), err
+		}
+	}
+	if l.global != nil {
+		if err := l.global.Wait(ctx); err != nil {
+			return time.Since(start), err
+		}
+	}
+	waited := time.Since(start)
+	l.record(service, waited)
+	return waited, nil
+}
+
+// rateLimitDelayThreshold ignores the tiny time spent on taking a token without waiting
+const rateLimitDelayThreshold = time.Millisecond
+
+func (l *RateLimiter) record(service string, waited time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	m, ok := l.metrics[service]
+	if !ok {
+		m = &RateLimitMetric{}
+		l.metrics[service] = m
+	}
+	m.Requests++
+	if waited >= rateLimitDelayThreshold {
+		m.Delayed++
+		m.TotalWait += waited
+		if waited > m.MaxWait {
+			m.MaxWait = waited
+		}
+	}
+}
+
+// Metrics returns a copy of the statistics grouped by service
+func (l *RateLimiter) Metrics() map[string]RateLimitMetric {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	metrics := make(map[string]RateLimitMetric, len(l.metrics))
+	for service, m := range l.metrics {
+		metrics[service] = *m
+	}
+	return metrics
+}
+
+// Summary returns the statistics in lines, one line for each service
+func (l *RateLimiter) Summary() []string {
+	metrics := l.Metrics()
+	services := make([]string, 0, len(metrics))
+	for service := range metrics {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	lines := make([]string, 0, len(services))
+	for _, service := range services {
+		m := metrics[service]
+		lines = append(lines, fmt.Sprintf("service=%s requests=%d delayed=%d total_wait=%s max_wait=%s",
+			service, m.Requests, m.Delayed, m.TotalWait.Round(time.Millisecond), m.MaxWait.Round(time.Millisecond)))
+	}
+	return lines
+}
+
+// Handler returns a Send handler that waits for the rate limiter before sending the request,
+// retried requests are limited as well.
+func (l *RateLimiter) Handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "ksyun.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			if _, err := l.Wait(r.Context(), r.ClientInfo.ServiceName); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request canceled while waiting for rate limiter", err)
+			}
+		},
+	}
+}
+
+// Transport returns a http transport that waits for the rate limiter before sending the requests of the service,
+// it's used by the sdk clients that don't send by the aws session.
+func (l *RateLimiter) Transport(service string, base http.RoundTripper) http.RoundTripper {
+	return &rateLimitTransport{limiter: l, service: service, base: base}
+}
+
+type rateLimitTransport struct {
+	limiter *RateLimiter
+	service string
+	base    http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if _, err := t.limiter.Wait(r.Context(), t.service); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(r)
+}
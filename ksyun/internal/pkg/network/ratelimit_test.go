@@ -0,0 +1,96 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(&RateLimitConfig{
+		QPS:        100,
+		ServiceQPS: map[string]int{"VPC": 10},
+	})
+
+	// the burst of vpc is 10, the 11th request waits for about 100ms
+	start := time.Now()
+	for i := 0; i < 11; i++ {
+		if _, err := limiter.Wait(context.Background(), "vpc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected vpc requests to be limited, elapsed %v", elapsed)
+	}
+
+	// kec is only limited by the global limit
+	if waited, err := limiter.Wait(context.Background(), "kec"); err != nil || waited > 50*time.Millisecond {
+		t.Errorf("expected kec request not to wait, waited %v, err %v", waited, err)
+	}
+
+	metrics := limiter.Metrics()
+	if metrics["vpc"].Requests != 11 || metrics["vpc"].Delayed < 1 {
+		t.Errorf("unexpected vpc metrics %+v", metrics["vpc"])
+	}
+	if metrics["kec"].Requests != 1 {
+		t.Errorf("unexpected kec metrics %+v", metrics["kec"])
+	}
+	if lines := limiter.Summary(); len(lines) != 2 {
+		t.Errorf("expected 2 lines of summary, got %v", lines)
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	limiter := NewRateLimiter(&RateLimitConfig{QPS: 1})
+	if _, err := limiter.Wait(context.Background(), "vpc"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Wait(ctx, "vpc"); err == nil {
+		t.Error("expected error when the context is canceled before a token is available")
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter := NewRateLimiter(nil)
+	for i := 0; i < 1000; i++ {
+		if _, err := limiter.Wait(context.Background(), "vpc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if metrics := limiter.Metrics(); metrics["vpc"].Delayed != 0 {
+		t.Errorf("expected no delayed request, got %+v", metrics["vpc"])
+	}
+}
+
+func TestRateLimiterTransport(t *testing.T) {
+	limiter := NewRateLimiter(&RateLimitConfig{ServiceQPS: map[string]int{"klog": 10}})
+	var sent int
+	transport := limiter.Transport("klog", roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	}))
+
+	// the requests of the transport share the bucket of the service with the aws session clients
+	start := time.Now()
+	for i := 0; i < 11; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "http://klog.example.com/", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected klog requests to be limited, elapsed %v", elapsed)
+	}
+	if metrics := limiter.Metrics(); sent != 11 || metrics["klog"].Requests != 11 {
+		t.Errorf("expected 11 klog requests, sent %d, metrics %+v", sent, metrics["klog"])
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -1,8 +1,11 @@
 package network
 
 import (
+	"math/rand"
 	"net"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,30 +32,152 @@ type temporary interface {
 	Temporary() bool
 }
 
+// throttlingErrorCodes is a list of error code that indicates the request is throttled
+var throttlingErrorCodes = []string{
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequests",
+	"TooManyRequestsException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+}
+
+// serverErrorCodes is a list of error code that indicates the request failed on the server,
+// it may have been applied before the failure.
+var serverErrorCodes = []string{
+	"ServiceUnavailable",
+	InternalError,
+	"InternalError",
+}
+
+// idempotentActionPrefixes are the prefixes of the read-only actions
+var idempotentActionPrefixes = []string{"Describe", "List", "Get", "Query"}
+
+const (
+	DefaultRetryMaxRetries = 5
+	DefaultRetryBaseDelay  = 500 * time.Millisecond
+	DefaultRetryMaxDelay   = 20 * time.Second
+
+	// maxRetryAfterDelay limits the delay that the server asks for by Retry-After header
+	maxRetryAfterDelay = 2 * time.Minute
+)
+
+// RetryPolicy is the policy for retrying the requests that are throttled or failed with server error.
+// The throttled requests are rejected before they are handled, so they are always retried.
+// A request failed with server error may have been applied, so it's retried only if the action is read-only.
+type RetryPolicy struct {
+	// MaxRetries is the max retry attempts for the retryable error codes and server errors
+	MaxRetries int
+	// BaseDelay is the delay of the first retry, it doubles for each retry
+	BaseDelay time.Duration
+	// MaxDelay is the upper limit of the delay
+	MaxDelay time.Duration
+	// Jitter randomizes the delay in [delay/2, delay)
+	Jitter bool
+	// RetryableCodes is the extra error codes that should be retried
+	RetryableCodes []string
+}
+
+// DefaultRetryPolicy returns the retry policy used when the provider has no retry block
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: DefaultRetryMaxRetries,
+		BaseDelay:  DefaultRetryBaseDelay,
+		MaxDelay:   DefaultRetryMaxDelay,
+		Jitter:     true,
+	}
+}
+
 // custom retry
 type KsyunRetryer struct {
-	// ...
+	// NumMaxRetries is the max retry attempts for the network errors
 	NumMaxRetries int
+	Policy        *RetryPolicy
 }
 
 var _ request.Retryer = (*KsyunRetryer)(nil)
 
 func GetKsyunRetryer(maxRetries int) request.Retryer {
+	return NewKsyunRetryer(maxRetries, nil)
+}
+
+// NewKsyunRetryer returns a retryer that retries the network errors up to maxRetries,
+// and the throttled requests according to the policy, the default policy is used if it's nil.
+func NewKsyunRetryer(maxRetries int, policy *RetryPolicy) request.Retryer {
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
 	return &KsyunRetryer{
 		NumMaxRetries: maxRetries,
+		Policy:        policy,
 	}
 }
 
 func (k *KsyunRetryer) RetryRules(r *request.Request) time.Duration {
 	// retry delay
-	return 500 * time.Millisecond
+	delay := k.backoff(r.RetryCount)
+	if retryAfter, ok := getRetryAfter(r); ok && retryAfter > delay {
+		delay = retryAfter
+	}
+	return delay
+}
+
+// backoff returns the exponential delay of the retry count
+func (k *KsyunRetryer) backoff(retryCount int) time.Duration {
+	delay := k.Policy.BaseDelay
+	for i := 0; i < retryCount && delay < k.Policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	if k.Policy.MaxDelay > 0 && delay > k.Policy.MaxDelay {
+		delay = k.Policy.MaxDelay
+	}
+	if k.Policy.Jitter && delay > 1 {
+		half := delay / 2
+		delay = half + time.Duration(rand.Int63n(int64(delay-half)))
+	}
+	return delay
+}
+
+// getRetryAfter parses the Retry-After header, it may be seconds or a http date.
+func getRetryAfter(r *request.Request) (time.Duration, bool) {
+	if r.HTTPResponse == nil || r.HTTPResponse.Header == nil {
+		return 0, false
+	}
+	value := strings.TrimSpace(r.HTTPResponse.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if t, err := http.ParseTime(value); err == nil {
+		delay = time.Until(t)
+	} else {
+		return 0, false
+	}
+	if delay <= 0 {
+		return 0, false
+	}
+	if delay > maxRetryAfterDelay {
+		delay = maxRetryAfterDelay
+	}
+	return delay, true
 }
 
 func (k *KsyunRetryer) ShouldRetry(r *request.Request) bool {
 	// indicates whether retry the request
 
+	if r.Error == nil {
+		return false
+	}
+
+	// throttled requests and server errors of the read-only actions are retried by the policy
+	if k.isThrottled(r) || (isServerError(r) && isIdempotent(r)) {
+		return r.RetryCount < k.Policy.MaxRetries
+	}
+
 	// ShouldRetry returns false if number of max retries is 0.
-	if k.NumMaxRetries == 0 {
+	if k.NumMaxRetries == 0 || r.RetryCount >= k.NumMaxRetries {
 		return false
 	}
 
@@ -62,14 +187,50 @@ func (k *KsyunRetryer) ShouldRetry(r *request.Request) bool {
 		return *r.Retryable
 	}
 
-	if isErrCode(r.Error, r.RetryErrorCodes) {
+	// customs retry condition
+	return shouldRetryError(r.Error) || isErrConnectionReset(r.Error)
+}
+
+// isThrottled returns whether the request is throttled or failed with the retryable codes of the policy
+func (k *KsyunRetryer) isThrottled(r *request.Request) bool {
+	if isErrCode(r.Error, r.RetryErrorCodes) ||
+		isErrCode(r.Error, r.ThrottleErrorCodes) ||
+		isErrCode(r.Error, throttlingErrorCodes) ||
+		isErrCode(r.Error, k.Policy.RetryableCodes) {
 		return true
 	}
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode == http.StatusTooManyRequests
+}
 
-	// customs retry condition
-	return shouldRetryError(r.Error) || isErrConnectionReset(r.Error)
+// isServerError returns whether the request failed with a server error
+func isServerError(r *request.Request) bool {
+	if isErrCode(r.Error, serverErrorCodes) {
+		return true
+	}
+	if r.HTTPResponse != nil {
+		code := r.HTTPResponse.StatusCode
+		return code >= http.StatusInternalServerError && code != http.StatusNotImplemented
+	}
+	return false
+}
+
+// isIdempotent returns whether the action of the request is read-only, so that it's safe to be sent again
+func isIdempotent(r *request.Request) bool {
+	if r.Operation == nil {
+		return false
+	}
+	for _, prefix := range idempotentActionPrefixes {
+		if strings.HasPrefix(r.Operation.Name, prefix) {
+			return true
+		}
+	}
+	return false
 }
+
 func (k *KsyunRetryer) MaxRetries() int {
+	if k.Policy.MaxRetries > k.NumMaxRetries {
+		return k.Policy.MaxRetries
+	}
 	return k.NumMaxRetries
 }
 
@@ -1,11 +1,14 @@
 package network
 
 import (
+	"errors"
 	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -36,3 +39,135 @@ func TestShouldRetry(t *testing.T) {
 	}
 
 }
+
+func newFakeRequest(err error, statusCode int, header http.Header) *request.Request {
+	return newFakeActionRequest("DescribeInstances", err, statusCode, header)
+}
+
+func newFakeActionRequest(action string, err error, statusCode int, header http.Header) *request.Request {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &request.Request{
+		Operation: &request.Operation{Name: action},
+		Error:     err,
+		HTTPResponse: &http.Response{
+			StatusCode: statusCode,
+			Header:     header,
+		},
+	}
+}
+
+func TestShouldRetryThrottling(t *testing.T) {
+	retryer := NewKsyunRetryer(0, &RetryPolicy{
+		MaxRetries:     3,
+		BaseDelay:      time.Millisecond,
+		MaxDelay:       time.Second,
+		RetryableCodes: []string{"CustomBusy"},
+	})
+
+	cases := map[string]struct {
+		Action     string
+		Err        error
+		StatusCode int
+		Expect     bool
+	}{
+		"throttling code":        {"DescribeInstances", awserr.New("Throttling", "", nil), 400, true},
+		"throttled create":       {"RunInstances", awserr.New("Throttling", "", nil), 400, true},
+		"too many requests":      {"DescribeInstances", awserr.New("TooManyRequests", "", nil), 400, true},
+		"custom code":            {"CreateVpc", awserr.New("CustomBusy", "", nil), 400, true},
+		"status 429":             {"AllocateAddress", awserr.New("Unknown", "", nil), 429, true},
+		"status 503":             {"DescribeInstances", awserr.New("Unknown", "", nil), 503, true},
+		"status 503 on list":     {"ListMetrics", awserr.New("Unknown", "", nil), 503, true},
+		"status 500 on create":   {"CreateVpc", awserr.New("Unknown", "", nil), 500, false},
+		"internal error on get":  {"GetUser", awserr.New("InternalError", "", nil), 400, true},
+		"internal error on run":  {"RunInstances", awserr.New("InternalError", "", nil), 400, false},
+		"unavailable on release": {"ReleaseAddress", awserr.New("ServiceUnavailable", "", nil), 503, false},
+		"status 501":             {"DescribeInstances", awserr.New("Unknown", "", nil), 501, false},
+		"client error":           {"DescribeInstances", awserr.New("InvalidParameter", "", nil), 400, false},
+		"network with 0 retry":   {"DescribeInstances", awserr.New("RequestError", "", errors.New("connection reset")), 0, false},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newFakeActionRequest(c.Action, c.Err, c.StatusCode, nil)
+			if a := retryer.ShouldRetry(r); a != c.Expect {
+				t.Errorf("expected %v, got %v", c.Expect, a)
+			}
+		})
+	}
+
+	r := newFakeRequest(awserr.New("Throttling", "", nil), 400, nil)
+	r.RetryCount = 3
+	if retryer.ShouldRetry(r) {
+		t.Errorf("expected no retry when the policy max retries is exceeded")
+	}
+	if retryer.MaxRetries() != 3 {
+		t.Errorf("expected max retries 3, got %d", retryer.MaxRetries())
+	}
+}
+
+func TestRetryRules(t *testing.T) {
+	retryer := NewKsyunRetryer(0, &RetryPolicy{
+		MaxRetries: 10,
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+	})
+	expects := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for i, expect := range expects {
+		r := newFakeRequest(awserr.New("Throttling", "", nil), 400, nil)
+		r.RetryCount = i
+		if a := retryer.RetryRules(r); a != expect {
+			t.Errorf("retry %d: expected delay %v, got %v", i, expect, a)
+		}
+	}
+
+	jitter := NewKsyunRetryer(0, &RetryPolicy{
+		MaxRetries: 10,
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+		Jitter:     true,
+	})
+	for i := 0; i < 100; i++ {
+		r := newFakeRequest(awserr.New("Throttling", "", nil), 400, nil)
+		r.RetryCount = 2
+		if a := jitter.RetryRules(r); a < 200*time.Millisecond || a >= 400*time.Millisecond {
+			t.Fatalf("expected jitter delay in [200ms, 400ms), got %v", a)
+		}
+	}
+}
+
+func TestRetryRulesRetryAfter(t *testing.T) {
+	retryer := NewKsyunRetryer(0, &RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+	})
+
+	r := newFakeRequest(awserr.New("TooManyRequests", "", nil), 429, http.Header{"Retry-After": []string{"3"}})
+	if a := retryer.RetryRules(r); a != 3*time.Second {
+		t.Errorf("expected delay 3s, got %v", a)
+	}
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	r = newFakeRequest(awserr.New("TooManyRequests", "", nil), 429, http.Header{"Retry-After": []string{date}})
+	if a := retryer.RetryRules(r); a <= 5*time.Second || a > 10*time.Second {
+		t.Errorf("expected delay about 10s, got %v", a)
+	}
+
+	r = newFakeRequest(awserr.New("TooManyRequests", "", nil), 429, http.Header{"Retry-After": []string{"3600"}})
+	if a := retryer.RetryRules(r); a != maxRetryAfterDelay {
+		t.Errorf("expected delay %v, got %v", maxRetryAfterDelay, a)
+	}
+
+	r = newFakeRequest(awserr.New("TooManyRequests", "", nil), 429, http.Header{"Retry-After": []string{"invalid"}})
+	if a := retryer.RetryRules(r); a != 100*time.Millisecond {
+		t.Errorf("expected delay 100ms, got %v", a)
+	}
+}
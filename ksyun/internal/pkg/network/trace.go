@@ -0,0 +1,65 @@
+package network
+
+import (
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+// requestIdHeader is the header of request id returned by ksyun api
+const requestIdHeader = "X-Ksc-Request-Id"
+
+// TraceHandler returns a CompleteAttempt handler that writes a trace record for each api attempt.
+func TraceHandler(tracer *logger.Tracer) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "ksyun.TraceHandler",
+		Fn: func(r *request.Request) {
+			if err := tracer.Trace(newTraceRecord(r)); err != nil {
+				log.Printf("[WARN] failed to write trace record: %s", err)
+			}
+		},
+	}
+}
+
+func newTraceRecord(r *request.Request) logger.TraceRecord {
+	record := logger.TraceRecord{
+		Time:    r.AttemptTime,
+		Service: r.ClientInfo.ServiceName,
+		Retry:   r.RetryCount,
+	}
+	if r.Operation != nil {
+		record.Action = r.Operation.Name
+	}
+	if !r.AttemptTime.IsZero() {
+		record.LatencyMs = time.Since(r.AttemptTime).Milliseconds()
+	}
+	// only the map params are traced, which are able to be redacted
+	if params, ok := r.Params.(*map[string]interface{}); ok {
+		record.Params = params
+	}
+	if r.HTTPResponse != nil {
+		record.HttpStatus = r.HTTPResponse.StatusCode
+		if r.HTTPResponse.Header != nil {
+			record.RequestId = r.HTTPResponse.Header.Get(requestIdHeader)
+		}
+	}
+
+	if r.Error != nil {
+		record.ErrorMessage = r.Error.Error()
+		if aerr, ok := r.Error.(awserr.Error); ok {
+			record.ErrorCode = aerr.Code()
+			record.ErrorMessage = aerr.Message()
+		}
+		if reqErr, ok := r.Error.(awserr.RequestFailure); ok && reqErr.RequestID() != "" {
+			record.RequestId = reqErr.RequestID()
+		}
+	} else if data, ok := r.Data.(*map[string]interface{}); ok && data != nil {
+		if requestId, ok := (*data)["RequestId"].(string); ok && requestId != "" {
+			record.RequestId = requestId
+		}
+	}
+	return record
+}
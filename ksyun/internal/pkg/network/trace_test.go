@@ -0,0 +1,44 @@
+package network
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestNewTraceRecord(t *testing.T) {
+	r := &request.Request{
+		ClientInfo:  metadata.ClientInfo{ServiceName: "vpc"},
+		Operation:   &request.Operation{Name: "CreateVpc"},
+		Params:      &map[string]interface{}{"VpcName": "tf"},
+		AttemptTime: time.Now().Add(-20 * time.Millisecond),
+		RetryCount:  2,
+		HTTPResponse: &http.Response{
+			StatusCode: 400,
+			Header:     http.Header{},
+		},
+		Error: awserr.NewRequestFailure(awserr.New("InvalidParameter", "bad cidr", nil), 400, "req-1"),
+	}
+	record := newTraceRecord(r)
+	if record.Service != "vpc" || record.Action != "CreateVpc" || record.Retry != 2 {
+		t.Errorf("unexpected record %+v", record)
+	}
+	if record.RequestId != "req-1" || record.HttpStatus != 400 || record.ErrorCode != "InvalidParameter" || record.ErrorMessage != "bad cidr" {
+		t.Errorf("unexpected record %+v", record)
+	}
+	if record.LatencyMs < 20 {
+		t.Errorf("expected latency at least 20ms, got %d", record.LatencyMs)
+	}
+
+	r.Error = nil
+	r.HTTPResponse.StatusCode = 200
+	r.Data = &map[string]interface{}{"RequestId": "req-2"}
+	record = newTraceRecord(r)
+	if record.RequestId != "req-2" || record.ErrorCode != "" {
+		t.Errorf("unexpected record %+v", record)
+	}
+}
@@ -67,6 +67,7 @@ VPC
 		ksyun_private_dns_records
 		ksyun_private_dns_zones
 		ksyun_direct_connects
+		ksyun_vpc_peering_connections
 
 	Resource
 		ksyun_vpc
@@ -91,6 +92,8 @@ VPC
 		ksyun_direct_connect_interface
 		ksyun_direct_connect_bfd_config
 		ksyun_dc_interface_associate
+		ksyun_vpc_peering_connection
+		ksyun_vpc_peering_connection_accepter
 
 VPN
 
@@ -154,9 +157,15 @@ CEN
 
 	Data Source
 		ksyun_cens
+		ksyun_cen_instances
+		ksyun_cen_routes
 
 	Resource
 		ksyun_cen
+		ksyun_cen_instance_attachment
+		ksyun_cen_bandwidth_package
+		ksyun_cen_region_bandwidth_limit
+		ksyun_cen_grant
 
 SSH key
 
@@ -183,6 +192,10 @@ Instance(KEC)
 		ksyun_auto_snapshot_policy
 		ksyun_auto_snapshot_volume_association
 		ksyun_data_guard_group
+		ksyun_image
+		ksyun_image_copy
+		ksyun_image_import
+		ksyun_image_share_permission
 
 Volume(EBS)
 
@@ -211,12 +224,14 @@ KCE
 	Data Source
 		ksyun_kce_clusters
 		ksyun_kce_instance_images
+		ksyun_kce_node_pools
 
 	Resource
 		ksyun_kce_cluster
 		ksyun_kce_cluster_attach_existence
 		ksyun_kce_cluster_attachment
 		ksyun_kce_auth_attachment
+		ksyun_kce_node_pool
 
 KCR
 
@@ -247,6 +262,9 @@ KRDS
 		ksyun_krds
 		ksyun_krds_security_groups
 		ksyun_krds_parameter_group
+		ksyun_krds_accounts
+		ksyun_krds_databases
+		ksyun_krds_backups
 
 	Resource
 		ksyun_krds
@@ -254,12 +272,21 @@ KRDS
 		ksyun_krds_security_group
 		ksyun_krds_security_group_rule
 		ksyun_krds_parameter_group
+		ksyun_krds_account
+		ksyun_krds_database
+		ksyun_krds_backup
+		ksyun_krds_backup_policy
 
 Clickhouse
 
 	Data Source
 		ksyun_clickhouse
 
+	Resource
+		ksyun_clickhouse_instance
+		ksyun_clickhouse_account
+		ksyun_clickhouse_security_rule
+
 SQLServer
 
 	Data Source
@@ -326,9 +353,12 @@ KS3
 
 	Data Source
 		ksyun_ks3_buckets
+		ksyun_ks3_object
+		ksyun_ks3_objects
 
 	Resource
 		ksyun_ks3_bucket
+		ksyun_ks3_object
 
 KNAD
 
@@ -345,6 +375,7 @@ IAM
 		ksyun_iam_users
 		ksyun_iam_roles
 		ksyun_iam_groups
+		ksyun_iam_policy_document
 
 	Resource
 		ksyun_iam_user
@@ -352,6 +383,9 @@ IAM
 		ksyun_iam_group
 		ksyun_iam_policy
 		ksyun_iam_relation_policy
+		ksyun_iam_group_membership
+		ksyun_iam_login_profile
+		ksyun_iam_access_key
 KPFS
 
 	Data Source
@@ -381,32 +415,51 @@ KFW
 
 Monitor
 
+	Data Source
+		ksyun_monitor_alarm_policies
+		ksyun_monitor_alarm_contacts
+		ksyun_monitor_metrics
+		ksyun_monitor_metric_data
+
 	Resource
 		ksyun_monitor_alarm_policy
+		ksyun_monitor_alarm_contact
+		ksyun_monitor_alarm_contact_group
 
 KMR
 
 	Data Source
     ksyun_kmr_clusters
 
+	Resource
+    ksyun_kmr_cluster
+    ksyun_kmr_instance_group
+
 KLog
 
 	Data Source
     ksyun_klog_projects
+    ksyun_klog_log_pools
+
+	Resource
+    ksyun_klog_project
+    ksyun_klog_log_pool
+    ksyun_klog_index
 */
 
 package ksyun
 
 import (
 	"net/url"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/network"
 )
 
-// Provider returns a terraform.ResourceProvider.
-func Provider() terraform.ResourceProvider {
+// Provider returns the schema.Provider of ksyun.
+func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
@@ -488,6 +541,141 @@ func Provider() terraform.ResourceProvider {
 					return
 				},
 			},
+			"endpoints": endpointsSchema(),
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions["default_tags_tags"],
+						},
+					},
+				},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["retry"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      network.DefaultRetryMaxRetries,
+							ValidateFunc: validation.IntBetween(0, 99),
+							Description:  descriptions["retry_max_retries"],
+						},
+						"base_delay_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      int(network.DefaultRetryBaseDelay / time.Millisecond),
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  descriptions["retry_base_delay_ms"],
+						},
+						"max_delay_ms": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      int(network.DefaultRetryMaxDelay / time.Millisecond),
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  descriptions["retry_max_delay_ms"],
+						},
+						"jitter": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: descriptions["retry_jitter"],
+						},
+						"retryable_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: descriptions["retry_retryable_codes"],
+						},
+					},
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["rate_limit"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"qps": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  descriptions["rate_limit_qps"],
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  descriptions["rate_limit_burst"],
+						},
+						"service_qps": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: descriptions["rate_limit_service_qps"],
+						},
+					},
+				},
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KSYUN_PROFILE", ""),
+				Description: descriptions["profile"],
+			},
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KSYUN_SHARED_CREDENTIALS_FILE", ""),
+				Description: descriptions["shared_credentials_file"],
+			},
+			"assume_role": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["assume_role"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_krn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: descriptions["assume_role_role_krn"],
+						},
+						"role_session_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     defaultAssumeRoleSessionName,
+							Description: descriptions["assume_role_role_session_name"],
+						},
+						"duration_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultAssumeRoleDurationSeconds,
+							ValidateFunc: validation.IntBetween(900, 43200),
+							Description:  descriptions["assume_role_duration_seconds"],
+						},
+						"policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+							Description:  descriptions["assume_role_policy"],
+						},
+					},
+				},
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"ksyun_albs":                     dataSourceKsyunAlbs(),
@@ -520,6 +708,8 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_krds":                             dataSourceKsyunKrds(),
 			"ksyun_krds_security_groups":             dataSourceKsyunKrdsSecurityGroup(),
 			"ksyun_ks3_buckets":                      dataSourceKsyunKs3Buckets(),
+			"ksyun_ks3_object":                       dataSourceKsyunKs3Object(),
+			"ksyun_ks3_objects":                      dataSourceKsyunKs3Objects(),
 			"ksyun_certificates":                     dataSourceKsyunCertificates(),
 			"ksyun_ssh_keys":                         dataSourceKsyunSSHKeys(),
 			"ksyun_redis_instances":                  dataSourceRedisInstances(),
@@ -532,6 +722,7 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_lb_backend_server_groups":         dataSourceKsyunBackendServerGroups(),
 			"ksyun_lb_register_backend_servers":      dataSourceKsyunRegisterBackendServers(),
 			"ksyun_routes":                           dataSourceKsyunRoutes(),
+			"ksyun_vpc_peering_connections":          dataSourceKsyunVpcPeeringConnections(),
 			"ksyun_nats":                             dataSourceKsyunNats(),
 			"ksyun_scaling_configurations":           dataSourceKsyunScalingConfigurations(),
 			"ksyun_scaling_groups":                   dataSourceKsyunScalingGroups(),
@@ -550,10 +741,14 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_bare_metal_raid_attributes":       dataSourceKsyunBareMetalRaidAttributes(),
 			"ksyun_kce_clusters":                     dataSourceKsyunKceClusters(),
 			"ksyun_kce_instance_images":              dataSourceKsyunKceInstanceImages(),
+			"ksyun_kce_node_pools":                   dataSourceKsyunKceNodePools(),
 			"ksyun_tags":                             dataSourceKsyunTags(),
 			"ksyun_auto_snapshot_policy":             dataSourceKsyunAutoSnapshotPolicy(),
 			"ksyun_data_guard_group":                 dataSourceKsyunDataGuardGroup(),
 			"ksyun_krds_parameter_group":             dataSourceKsyunKrdsParameterGroup(),
+			"ksyun_krds_accounts":                    dataSourceKsyunKrdsAccounts(),
+			"ksyun_krds_databases":                   dataSourceKsyunKrdsDatabases(),
+			"ksyun_krds_backups":                     dataSourceKsyunKrdsBackups(),
 			"ksyun_auto_snapshot_volume_association": dataSourceKsyunAutoSnapshotVolumeAssociation(),
 			"ksyun_knads":                            dataSourceKsyunKnads(),
 			"ksyun_dnats":                            dataSourceKsyunDnats(),
@@ -578,23 +773,32 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_kfw_service_groups": dataSourceKsyunKfwServiceGroups(),
 
 			// iam
-			"ksyun_iam_users":  dataSourceKsyunIamUsers(),
-			"ksyun_iam_roles":  dataSourceKsyunIamRoles(),
-			"ksyun_iam_groups": dataSourceKsyunIamGroups(),
+			"ksyun_iam_users":           dataSourceKsyunIamUsers(),
+			"ksyun_iam_roles":           dataSourceKsyunIamRoles(),
+			"ksyun_iam_groups":          dataSourceKsyunIamGroups(),
+			"ksyun_iam_policy_document": dataSourceKsyunIamPolicyDocument(),
 
 			//kpfs
 			"ksyun_kpfs_file_systems":   dataSourceKsyunKpfsFileSystems(),
 			"ksyun_kpfs_clusters":       dataSourceKsyunKpfsClusters(),
 			"ksyun_kpfs_client_install": dataSourceKsyunKpfsClientInstall(),
 			// klog
-			"ksyun_klog_projects": dataSourceKsyunKlogProjects(),
+			"ksyun_klog_projects":  dataSourceKsyunKlogProjects(),
+			"ksyun_klog_log_pools": dataSourceKsyunKlogLogPools(),
 			// direct connect
 			"ksyun_direct_connects": dataSourceKsyunDirectConnects(),
 
 			// clickhouse
 			"ksyun_clickhouse": dataSourceKsyunClickhouse(),
 			// cen
-			"ksyun_cens": dataSourceKsyunCens(),
+			"ksyun_cens":          dataSourceKsyunCens(),
+			"ksyun_cen_instances": dataSourceKsyunCenInstances(),
+			"ksyun_cen_routes":    dataSourceKsyunCenRoutes(),
+			// monitor
+			"ksyun_monitor_alarm_policies": dataSourceKsyunMonitorAlarmPolicies(),
+			"ksyun_monitor_alarm_contacts": dataSourceKsyunMonitorAlarmContacts(),
+			"ksyun_monitor_metrics":        dataSourceKsyunMonitorMetrics(),
+			"ksyun_monitor_metric_data":    dataSourceKsyunMonitorMetricData(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"ksyun_alb":                              resourceKsyunAlb(),
@@ -613,6 +817,10 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_vpc":                              resourceKsyunVpc(),
 			"ksyun_subnet":                           resourceKsyunSubnet(),
 			"ksyun_instance":                         resourceKsyunInstance(),
+			"ksyun_image":                            resourceKsyunImage(),
+			"ksyun_image_copy":                       resourceKsyunImageCopy(),
+			"ksyun_image_import":                     resourceKsyunImageImport(),
+			"ksyun_image_share_permission":           resourceKsyunImageSharePermission(),
 			"ksyun_sqlserver":                        resourceKsyunSqlServer(),
 			"ksyun_kec_network_interface":            resourceKsyunKecNetworkInterface(),
 			"ksyun_kec_network_interface_attachment": resourceKsyunKecNetworkInterfaceAttachment(),
@@ -639,6 +847,8 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_lb_backend_server_group":          resourceKsyunBackendServerGroup(),
 			"ksyun_lb_register_backend_server":       resourceKsyunRegisterBackendServer(),
 			"ksyun_route":                            resourceKsyunRoute(),
+			"ksyun_vpc_peering_connection":           resourceKsyunVpcPeeringConnection(),
+			"ksyun_vpc_peering_connection_accepter":  resourceKsyunVpcPeeringConnectionAccepter(),
 			"ksyun_nat":                              resourceKsyunNat(),
 			"ksyun_nat_associate":                    resourceKsyunNatAssociation(),
 			"ksyun_scaling_configuration":            resourceKsyunScalingConfiguration(),
@@ -661,10 +871,15 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_tag":                              resourceKsyunTag(),
 
 			"ksyun_ks3_bucket":                       resourceKsyunKs3Bucket(),
+			"ksyun_ks3_object":                       resourceKsyunKs3Object(),
 			"ksyun_auto_snapshot_policy":             resourceKsyunAutoSnapshotPolicy(),
 			"ksyun_auto_snapshot_volume_association": resourceKsyunAutoSnapshotVolumeAssociation(),
 			"ksyun_data_guard_group":                 resourceKsyunDataGuardGroup(),
 			"ksyun_krds_parameter_group":             resourceKsyunKrdsParameterGroup(),
+			"ksyun_krds_account":                     resourceKsyunKrdsAccount(),
+			"ksyun_krds_database":                    resourceKsyunKrdsDatabase(),
+			"ksyun_krds_backup":                      resourceKsyunKrdsBackup(),
+			"ksyun_krds_backup_policy":               resourceKsyunKrdsBackupPolicy(),
 			"ksyun_knad":                             resourceKsyunKnad(),
 			"ksyun_knad_associate":                   resourceKsyunKnadAssociate(),
 			"ksyun_nat_instance_bandwidth_limit":     resourceKsyunNatInstanceBandwidthLimit(),
@@ -679,6 +894,7 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_kce_cluster_attachment":       resourceKsyunKceClusterAttachment(),
 			"ksyun_kce_cluster_attach_existence": resourceKsyunKceClusterAttachExistence(),
 			"ksyun_kce_auth_attachment":          resourceKsyunKceAuthAttachment(),
+			"ksyun_kce_node_pool":                resourceKsyunKceNodePool(),
 
 			// private dns
 			"ksyun_private_dns_zone":                resourceKsyunPrivateDnsZone(),
@@ -697,11 +913,14 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_tag_v2_attachment": resourceKsyunTagv2Attachment(),
 
 			// iam
-			"ksyun_iam_user":            resourceKsyunIamUser(),
-			"ksyun_iam_role":            resourceKsyunIamRole(),
-			"ksyun_iam_group":           resourceKsyunIamGroup(),
-			"ksyun_iam_policy":          resourceKsyunIamPolicy(),
-			"ksyun_iam_relation_policy": resourceKsyunIamRelationPolicy(),
+			"ksyun_iam_user":             resourceKsyunIamUser(),
+			"ksyun_iam_role":             resourceKsyunIamRole(),
+			"ksyun_iam_group":            resourceKsyunIamGroup(),
+			"ksyun_iam_policy":           resourceKsyunIamPolicy(),
+			"ksyun_iam_relation_policy":  resourceKsyunIamRelationPolicy(),
+			"ksyun_iam_group_membership": resourceKsyunIamGroupMembership(),
+			"ksyun_iam_login_profile":    resourceKsyunIamLoginProfile(),
+			"ksyun_iam_access_key":       resourceKsyunIamAccessKey(),
 
 			// security group
 			"ksyun_security_group":            resourceKsyunSecurityGroup(),
@@ -730,15 +949,42 @@ func Provider() terraform.ResourceProvider {
 			"ksyun_kfw_service_group": resourceKsyunKfwServiceGroup(),
 
 			// monitor
-			"ksyun_monitor_alarm_policy": resourceKsyunMonitorAlarmPolicy(),
+			"ksyun_monitor_alarm_policy":        resourceKsyunMonitorAlarmPolicy(),
+			"ksyun_monitor_alarm_contact":       resourceKsyunMonitorAlarmContact(),
+			"ksyun_monitor_alarm_contact_group": resourceKsyunMonitorAlarmContactGroup(),
 			// cen
-			"ksyun_cen": resourceKsyunCen(),
+			"ksyun_cen":                        resourceKsyunCen(),
+			"ksyun_cen_instance_attachment":    resourceKsyunCenInstanceAttachment(),
+			"ksyun_cen_bandwidth_package":      resourceKsyunCenBandwidthPackage(),
+			"ksyun_cen_region_bandwidth_limit": resourceKsyunCenRegionBandwidthLimit(),
+			"ksyun_cen_grant":                  resourceKsyunCenGrant(),
+			// klog
+			"ksyun_klog_project":  resourceKsyunKlogProject(),
+			"ksyun_klog_log_pool": resourceKsyunKlogLogPool(),
+			"ksyun_klog_index":    resourceKsyunKlogIndex(),
+			// kmr
+			"ksyun_kmr_cluster":        resourceKsyunKmrCluster(),
+			"ksyun_kmr_instance_group": resourceKsyunKmrInstanceGroup(),
+			// clickhouse
+			"ksyun_clickhouse_instance":      resourceKsyunClickhouseInstance(),
+			"ksyun_clickhouse_account":       resourceKsyunClickhouseAccount(),
+			"ksyun_clickhouse_security_rule": resourceKsyunClickhouseSecurityRule(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config, err := providerConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	client, err := config.Client()
+	return client, err
+}
+
+// providerConfig reads the provider configuration into Config
+func providerConfig(d *schema.ResourceData) (*Config, error) {
 	retryNum := 0
 	if mr, ok := d.GetOk("max_retries"); ok {
 		retryNum = mr.(int)
@@ -757,8 +1003,79 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		HttpProxy:     d.Get("http_proxy").(string),
 		UseSSL:        d.Get("force_https").(bool),
 	}
-	client, err := config.Client()
-	return client, err
+	if v, ok := d.GetOk("endpoints"); ok {
+		config.Endpoints = make(map[string]string)
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			for service, endpoint := range raw.(map[string]interface{}) {
+				if endpoint.(string) != "" {
+					config.Endpoints[service] = endpoint.(string)
+				}
+			}
+		}
+	}
+	if v, ok := d.GetOk("default_tags"); ok {
+		config.DefaultTags = make(map[string]string)
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			for k, v := range raw.(map[string]interface{})["tags"].(map[string]interface{}) {
+				config.DefaultTags[k] = v.(string)
+			}
+		}
+	}
+	if v, ok := d.GetOk("retry"); ok {
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			retry := raw.(map[string]interface{})
+			config.RetryPolicy = &network.RetryPolicy{
+				MaxRetries:     retry["max_retries"].(int),
+				BaseDelay:      time.Duration(retry["base_delay_ms"].(int)) * time.Millisecond,
+				MaxDelay:       time.Duration(retry["max_delay_ms"].(int)) * time.Millisecond,
+				Jitter:         retry["jitter"].(bool),
+				RetryableCodes: SchemaSetToStringSlice(retry["retryable_codes"]),
+			}
+		}
+	}
+	if v, ok := d.GetOk("rate_limit"); ok {
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			rateLimit := raw.(map[string]interface{})
+			config.RateLimit = &network.RateLimitConfig{
+				QPS:        rateLimit["qps"].(int),
+				Burst:      rateLimit["burst"].(int),
+				ServiceQPS: make(map[string]int),
+			}
+			for service, qps := range rateLimit["service_qps"].(map[string]interface{}) {
+				config.RateLimit.ServiceQPS[service] = qps.(int)
+			}
+		}
+	}
+	if err := config.applyProfileCredentials(d.Get("shared_credentials_file").(string), d.Get("profile").(string)); err != nil {
+		return nil, err
+	}
+	if v, ok := d.GetOk("assume_role"); ok {
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			assumeRole := raw.(map[string]interface{})
+			config.AssumeRole = &AssumeRoleConfig{
+				RoleKrn:         assumeRole["role_krn"].(string),
+				RoleSessionName: assumeRole["role_session_name"].(string),
+				DurationSeconds: assumeRole["duration_seconds"].(int),
+				Policy:          assumeRole["policy"].(string),
+			}
+		}
+	}
+	return &config, nil
 }
 
 var descriptions map[string]string
@@ -773,5 +1090,31 @@ func init() {
 		"endpoint":       "",
 		"dry_run":        "false",
 		"ignore_service": "false",
+
+		"endpoints": "The endpoints block to override the host of each service, e.g. for the private cloud, the finance cloud or a local stub server. It takes precedence over `domain` and `endpoint`.",
+
+		"default_tags":      "The default_tags block to apply the tags to all taggable resources, the tags of the resource win on conflict.",
+		"default_tags_tags": "The tags applied to all taggable resources.",
+
+		"retry":                 "The retry block for the requests that are throttled, such as `Throttling`, `TooManyRequests` and HTTP 429, or failed with server errors such as HTTP 5xx. The server errors are retried only for the read-only actions.",
+		"retry_max_retries":     "The max retry attempts for the throttled requests. It is independent of `max_retries`, which is for the network errors.",
+		"retry_base_delay_ms":   "The delay in milliseconds before the first retry, it doubles for each retry.",
+		"retry_max_delay_ms":    "The upper limit of the retry delay in milliseconds. The `Retry-After` header returned by the server is honored even if it is longer.",
+		"retry_jitter":          "Whether randomize the retry delay to avoid retrying at the same time.",
+		"retry_retryable_codes": "The extra error codes that should be retried, for all the actions.",
+
+		"rate_limit":             "The rate_limit block to limit the api requests per second, which is shared by all services.",
+		"rate_limit_qps":         "The requests per second of all services. Default is `0`, means unlimited.",
+		"rate_limit_burst":       "The max requests sent at once. Default is the same as `qps`.",
+		"rate_limit_service_qps": "The requests per second of each service, the key is the service name such as `vpc`, `kec`, `slb`.",
+
+		"profile":                 "The profile name in the shared credentials file. It can also be sourced from the `KSYUN_PROFILE` environment variable. Default is `default`.",
+		"shared_credentials_file": "The path to the shared credentials file. It can also be sourced from the `KSYUN_SHARED_CREDENTIALS_FILE` environment variable. Default is `~/.ksyun/credentials`.",
+
+		"assume_role":                   "The assume_role block. If provided, terraform will attempt to assume this role using the supplied credentials.",
+		"assume_role_role_krn":          "The KRN of the role to assume, such as `krn:ksc:iam::123456789:role/terraform`.",
+		"assume_role_role_session_name": "The session name to use when assuming the role.",
+		"assume_role_duration_seconds":  "The duration of the role session in seconds, valid from 900 to 43200. The temporary credentials are refreshed before expiration.",
+		"assume_role_policy":            "A more restrictive policy in JSON format to apply to the temporary credentials.",
 	}
 }
@@ -0,0 +1,200 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProtoV5ProviderServerFactory returns the factory of the provider server, it muxes the schema.Provider
+// returned by Provider with the FrameworkProvider serving the resources written on the plugin framework.
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	primary := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		primary.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(primary)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// FrameworkProvider serves the resources and data sources written on the terraform plugin framework.
+// It shares the provider block with the primary schema.Provider, which is configured before it by the mux server,
+// so the resources get the same *KsyunClient as the ones of the primary provider.
+type FrameworkProvider struct {
+	primary *schema.Provider
+}
+
+var _ provider.Provider = &FrameworkProvider{}
+
+// NewFrameworkProvider returns the framework provider sharing the configuration of the primary provider.
+func NewFrameworkProvider(primary *schema.Provider) provider.Provider {
+	return &FrameworkProvider{primary: primary}
+}
+
+// FrameworkDataSources returns the data sources written on the plugin framework, keyed by their type name.
+func FrameworkDataSources() map[string]func() datasource.DataSource {
+	return map[string]func() datasource.DataSource{}
+}
+
+// FrameworkResources returns the resources written on the plugin framework, keyed by their type name.
+func FrameworkResources() map[string]func() resource.Resource {
+	return map[string]func() resource.Resource{}
+}
+
+func (p *FrameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "ksyun"
+}
+
+// Schema converts the provider block of the primary provider, the mux server requires the same one on every server.
+func (p *FrameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	schemaResp, err := p.primary.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the provider schema", err.Error())
+		return
+	}
+	attributes, blocks := frameworkProviderSchema(schemaResp.Provider.Block)
+	resp.Schema = fwschema.Schema{
+		Attributes:  attributes,
+		Blocks:      blocks,
+		Description: schemaResp.Provider.Block.Description,
+	}
+}
+
+// Configure hands the client of the primary provider to the resources and data sources.
+func (p *FrameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.primary.Meta().(*KsyunClient)
+	if !ok {
+		resp.Diagnostics.AddError("Error configuring the provider", "the ksyun client is not configured by the primary provider")
+		return
+	}
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	var dataSources []func() datasource.DataSource
+	for _, f := range FrameworkDataSources() {
+		dataSources = append(dataSources, f)
+	}
+	return dataSources
+}
+
+func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	var resources []func() resource.Resource
+	for _, f := range FrameworkResources() {
+		resources = append(resources, f)
+	}
+	return resources
+}
+
+// frameworkProviderSchema converts the attributes and the nested blocks of the block in the protocol schema.
+func frameworkProviderSchema(block *tfprotov5.SchemaBlock) (map[string]fwschema.Attribute, map[string]fwschema.Block) {
+	attributes := make(map[string]fwschema.Attribute)
+	for _, a := range block.Attributes {
+		attributes[a.Name] = frameworkProviderAttribute(a)
+	}
+	blocks := make(map[string]fwschema.Block)
+	for _, b := range block.BlockTypes {
+		blocks[b.TypeName] = frameworkProviderBlock(b)
+	}
+	return attributes, blocks
+}
+
+func frameworkProviderAttribute(a *tfprotov5.SchemaAttribute) fwschema.Attribute {
+	var deprecationMessage string
+	if a.Deprecated {
+		deprecationMessage = "deprecated"
+	}
+	switch t := a.Type.(type) {
+	case tftypes.List:
+		return fwschema.ListAttribute{ElementType: frameworkAttrType(t.ElementType), Required: a.Required, Optional: a.Optional,
+			Sensitive: a.Sensitive, Description: a.Description, DeprecationMessage: deprecationMessage}
+	case tftypes.Set:
+		return fwschema.SetAttribute{ElementType: frameworkAttrType(t.ElementType), Required: a.Required, Optional: a.Optional,
+			Sensitive: a.Sensitive, Description: a.Description, DeprecationMessage: deprecationMessage}
+	case tftypes.Map:
+		return fwschema.MapAttribute{ElementType: frameworkAttrType(t.ElementType), Required: a.Required, Optional: a.Optional,
+			Sensitive: a.Sensitive, Description: a.Description, DeprecationMessage: deprecationMessage}
+	}
+	switch {
+	case a.Type.Is(tftypes.Bool):
+		return fwschema.BoolAttribute{Required: a.Required, Optional: a.Optional,
+			Sensitive: a.Sensitive, Description: a.Description, DeprecationMessage: deprecationMessage}
+	case a.Type.Is(tftypes.Number):
+		return fwschema.NumberAttribute{Required: a.Required, Optional: a.Optional,
+			Sensitive: a.Sensitive, Description: a.Description, DeprecationMessage: deprecationMessage}
+	default:
+		return fwschema.StringAttribute{Required: a.Required, Optional: a.Optional,
+			Sensitive: a.Sensitive, Description: a.Description, DeprecationMessage: deprecationMessage}
+	}
+}
+
+func frameworkProviderBlock(b *tfprotov5.SchemaNestedBlock) fwschema.Block {
+	var deprecationMessage string
+	if b.Block.Deprecated {
+		deprecationMessage = "deprecated"
+	}
+	attributes, blocks := frameworkProviderSchema(b.Block)
+	switch b.Nesting {
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return fwschema.SetNestedBlock{
+			NestedObject:       fwschema.NestedBlockObject{Attributes: attributes, Blocks: blocks},
+			Description:        b.Block.Description,
+			DeprecationMessage: deprecationMessage,
+		}
+	case tfprotov5.SchemaNestedBlockNestingModeSingle:
+		return fwschema.SingleNestedBlock{
+			Attributes:         attributes,
+			Blocks:             blocks,
+			Description:        b.Block.Description,
+			DeprecationMessage: deprecationMessage,
+		}
+	default:
+		return fwschema.ListNestedBlock{
+			NestedObject:       fwschema.NestedBlockObject{Attributes: attributes, Blocks: blocks},
+			Description:        b.Block.Description,
+			DeprecationMessage: deprecationMessage,
+		}
+	}
+}
+
+func frameworkAttrType(t tftypes.Type) attr.Type {
+	switch t := t.(type) {
+	case tftypes.List:
+		return types.ListType{ElemType: frameworkAttrType(t.ElementType)}
+	case tftypes.Set:
+		return types.SetType{ElemType: frameworkAttrType(t.ElementType)}
+	case tftypes.Map:
+		return types.MapType{ElemType: frameworkAttrType(t.ElementType)}
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type, len(t.AttributeTypes))
+		for k, v := range t.AttributeTypes {
+			attrTypes[k] = frameworkAttrType(v)
+		}
+		return types.ObjectType{AttrTypes: attrTypes}
+	}
+	switch {
+	case t.Is(tftypes.Bool):
+		return types.BoolType
+	case t.Is(tftypes.Number):
+		return types.NumberType
+	case t.Is(tftypes.String):
+		return types.StringType
+	default:
+		panic(fmt.Sprintf("unsupported type %s in the provider schema", t))
+	}
+}
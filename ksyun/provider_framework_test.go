@@ -0,0 +1,133 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+// testFrameworkProtoV5ProviderFactories serve the provider by the mux server like ProtoV5ProviderServerFactory,
+// the framework provider also serves the data sources only used by the tests.
+var testFrameworkProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"ksyun": func() (tfprotov5.ProviderServer, error) {
+		primary := Provider()
+		muxServer, err := tf5muxserver.NewMuxServer(context.Background(),
+			primary.GRPCProvider,
+			providerserver.NewProtocol5(&testFrameworkProvider{NewFrameworkProvider(primary)}),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return muxServer.ProviderServer(), nil
+	},
+}
+
+type testFrameworkProvider struct {
+	provider.Provider
+}
+
+func (p *testFrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return append(p.Provider.DataSources(ctx), newTestProjectsDataSource)
+}
+
+// testProjectsDataSource reads the projects by the IamProjectService shared with the sdk resources,
+// it checks that the framework data sources get the client configured by the sdk provider.
+type testProjectsDataSource struct {
+	client *KsyunClient
+}
+
+type testProjectsDataSourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	ProjectIds types.List   `tfsdk:"project_ids"`
+}
+
+func newTestProjectsDataSource() datasource.DataSource {
+	return &testProjectsDataSource{}
+}
+
+func (d *testProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_projects"
+}
+
+func (d *testProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"project_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *testProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*KsyunClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *KsyunClient, got: %T.", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *testProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	iamProjectService := IamProjectService{d.client}
+	results, err := iamProjectService.ReadProjects(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the projects", err.Error())
+		return
+	}
+	var ids []string
+	for _, item := range results {
+		ids = append(ids, fmt.Sprintf("%v", item.(map[string]interface{})["ProjectId"]))
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &testProjectsDataSourceModel{
+		Id:         types.StringValue(hashStringArray(ids)),
+		ProjectIds: list,
+	})...)
+}
+
+// TestUnitKsyunFrameworkDataSource_mux reads the projects by a data source written on the plugin framework,
+// the client is the one configured by the sdk provider behind the mux server.
+func TestUnitKsyunFrameworkDataSource_mux(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: testFrameworkProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + `
+data "ksyun_test_projects" "all" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ksyun_test_projects.all"),
+					resource.TestCheckResourceAttr("data.ksyun_test_projects.all", "project_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_test_projects.all", "project_ids.0", mockapi.DefaultProjectId),
+				),
+			},
+		},
+	})
+}
@@ -1,36 +1,81 @@
 package ksyun
 
 import (
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/cassette"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 	"log"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 )
 
-var testAccProviders map[string]terraform.ResourceProvider
+var testAccProviderFactories map[string]func() (*schema.Provider, error)
 var testAccProvider *schema.Provider
 
+// testAccProtoV5ProviderFactories serve the provider by the mux server, the tests of the resources
+// written on the plugin framework use them instead of testAccProviderFactories.
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"ksyun": func() (tfprotov5.ProviderServer, error) {
+		factory, err := ProtoV5ProviderServerFactory(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return factory(), nil
+	},
+}
+
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
-	testAccProviders = map[string]terraform.ResourceProvider{
-		"ksyun": testAccProvider,
+	testAccProvider = Provider()
+	// the factories return the same provider, the check functions read its client
+	testAccProviderFactories = map[string]func() (*schema.Provider, error){
+		"ksyun": func() (*schema.Provider, error) {
+			return testAccProvider, nil
+		},
 	}
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
-func TestProvider_impl(t *testing.T) {
-	var _ terraform.ResourceProvider = Provider()
+// TestProtoV5ProviderServerFactory checks the mux server, it refuses the provider schemas that are not the same.
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	factory, err := ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp, err := factory().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	for name := range FrameworkDataSources() {
+		if _, ok := resp.DataSourceSchemas[name]; !ok {
+			t.Errorf("data source %s is not served", name)
+		}
+	}
 }
 
 func testAccPreCheck(t *testing.T) {
+	// the replayed requests are not sent, any credentials are ok
+	if mode, _ := cassette.ModeFromEnv(); mode == cassette.ModeReplay {
+		for _, k := range []string{"KSYUN_ACCESS_KEY", "KSYUN_SECRET_KEY"} {
+			if os.Getenv(k) == "" {
+				os.Setenv(k, "replay")
+			}
+		}
+	}
 	if v := os.Getenv("KSYUN_ACCESS_KEY"); v == "" {
 		t.Fatal("KSYUN_ACCESS_KEY must be set for acceptance tests")
 	}
@@ -48,6 +93,100 @@ func testAccPreCheck(t *testing.T) {
 	//}
 }
 
+// testAccCassetteProviderFactories returns the providers whose api interactions are recorded into
+// or replayed from testdata/cassettes/<test name>.json, according to KSYUN_CASSETTE_MODE.
+// The testAccProvider is replaced during the test, so that the check functions use the same client.
+// The test is skipped in replay mode if the cassette has not been recorded.
+func testAccCassetteProviderFactories(t *testing.T) map[string]func() (*schema.Provider, error) {
+	mode, err := cassette.ModeFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode == cassette.ModeDisabled {
+		return testAccProviderFactories
+	}
+	filename := filepath.Join("testdata", "cassettes", t.Name()+".json")
+	if mode == cassette.ModeReplay {
+		if _, err = os.Stat(filename); os.IsNotExist(err) {
+			t.Skipf("cassette %s is not recorded, run the test with %s=%s first", filename, cassette.ModeEnv, cassette.ModeRecord)
+		}
+	}
+	recorder, err := cassette.New(filename, mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := Provider()
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		config, err := providerConfig(d)
+		if err != nil {
+			return nil, err
+		}
+		config.Transport = recorder.Transport
+		return config.Client()
+	}
+	origin := testAccProvider
+	testAccProvider = provider
+	t.Cleanup(func() {
+		testAccProvider = origin
+		if err := recorder.Stop(); err != nil {
+			t.Errorf("error saving cassette %s: %s", filename, err)
+		}
+	})
+	return map[string]func() (*schema.Provider, error){
+		"ksyun": func() (*schema.Provider, error) {
+			return provider, nil
+		},
+	}
+}
+
+// testAccCassetteTest runs the acceptance test with the providers of testAccCassetteProviderFactories.
+// The test runs in parallel unless the cassette mode is set, the recording and the replaying replace testAccProvider.
+func testAccCassetteTest(t *testing.T, c resource.TestCase) {
+	c.ProviderFactories = testAccCassetteProviderFactories(t)
+	if mode, _ := cassette.ModeFromEnv(); mode == cassette.ModeDisabled {
+		resource.ParallelTest(t, c)
+		return
+	}
+	resource.Test(t, c)
+}
+
+// testMockApiProviderConfig returns the provider block pointing to the mock api server,
+// the configuration is applied by unitTest without credentials and network access.
+// The clients of the kingsoftcloud sdk don't follow the domain, they are pointed to the server by the endpoints.
+func testMockApiProviderConfig(server *mockapi.Server) string {
+	return fmt.Sprintf(`
+provider "ksyun" {
+	access_key     = "mock-ak"
+	secret_key     = "mock-sk"
+	region         = "cn-beijing-6"
+	domain         = "%[1]s"
+	ignore_service = true
+
+	endpoints {
+		klog = "http://%[1]s"
+		kmr  = "http://%[1]s"
+	}
+}
+`, server.Domain())
+}
+
+// unitTest runs the test case by resource.UnitTest. The test fails if the terraform binary is not found,
+// otherwise resource.UnitTest tries to download it and exits the test process without network access.
+// It's skipped instead only if KSYUN_SKIP_UNIT_TESTS is set, so the tests are never reported as passed without running.
+func unitTest(t *testing.T, c resource.TestCase) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			if os.Getenv("KSYUN_SKIP_UNIT_TESTS") != "" {
+				t.Skip("terraform binary is not found and KSYUN_SKIP_UNIT_TESTS is set")
+			}
+			t.Fatal("terraform binary is not found, set TF_ACC_TERRAFORM_PATH or add terraform to the PATH to run the test, " +
+				"or set KSYUN_SKIP_UNIT_TESTS to skip it")
+		}
+	}
+	resource.UnitTest(t, c)
+}
+
 func testAccCheckIDExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
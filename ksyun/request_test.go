@@ -234,7 +234,7 @@ func TestConnectionReset(t *testing.T) {
 				t.Logf("Raw OpError %q, Handled OpError %q", osErr.Error(), aerr.OrigErr().Error())
 			}
 
-			t.Logf(err.Error())
+			t.Log(err.Error())
 			if e, a := c.ExpectAttempts, count; e != a {
 				t.Errorf("Expected %v, but received %v", e, a)
 			}
@@ -54,12 +54,12 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunAlb() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunAlbCreate,
 		Read:   resourceKsyunAlbRead,
 		Update: resourceKsyunAlbUpdate,
@@ -238,7 +238,7 @@ func resourceKsyunAlb() *schema.Resource {
 				Description: "The status of the ALB.",
 			},
 		},
-	}
+	})
 }
 
 func resourceKsyunAlbCreate(d *schema.ResourceData, meta interface{}) (err error) {
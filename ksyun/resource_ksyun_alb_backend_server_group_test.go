@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunAlbBackendServerGroup_basic(t *testing.T) {
@@ -14,9 +14,9 @@ func TestAccKsyunAlbBackendServerGroup_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_alb_backend_server_group.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckAlbBackendServerGroupDestroy,
+		IDRefreshName:     "ksyun_alb_backend_server_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAlbBackendServerGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAlbBackendServerGroupConfig,
@@ -35,9 +35,9 @@ func TestAccKsyunAlbBackendServerGroup_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_alb_backend_server_group.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckAlbBackendServerGroupDestroy,
+		IDRefreshName:     "ksyun_alb_backend_server_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAlbBackendServerGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAlbBackendServerGroupConfig,
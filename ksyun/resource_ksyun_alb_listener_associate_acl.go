@@ -24,7 +24,7 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunAlbListenerAssociateAcl() *schema.Resource {
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunAlbListener_basic(t *testing.T) {
@@ -13,8 +13,8 @@ func TestAccKsyunAlbListener_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_alb_listener.test",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_alb_listener.test",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckAlbListenerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -30,8 +30,8 @@ func TestAccKsyunAlbListener_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_alb_listener.test",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_alb_listener.test",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckAlbListenerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunAlbRegisterBackendServer_basic(t *testing.T) {
@@ -13,9 +13,9 @@ func TestAccKsyunAlbRegisterBackendServer_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_alb_register_backend_server.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckAlbRegisterBackendServerDestroy,
+		IDRefreshName:     "ksyun_alb_register_backend_server.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAlbRegisterBackendServerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAlbRegisterBackendServerConfig,
@@ -30,9 +30,9 @@ func TestAccKsyunAlbRegisterBackendServer_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_alb_register_backend_server.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckAlbRegisterBackendServerDestroy,
+		IDRefreshName:     "ksyun_alb_register_backend_server.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAlbRegisterBackendServerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAlbRegisterBackendServerConfig,
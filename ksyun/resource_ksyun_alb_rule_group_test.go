@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestResourceKsyunAlbRuleGroup_basic(t *testing.T) {
@@ -12,8 +12,8 @@ func TestResourceKsyunAlbRuleGroup_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_alb_rule_group.default",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_alb_rule_group.default",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckSnapshotDestroy,
 
 		Steps: []resource.TestStep{
@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
-	_ "github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	_ "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunAutoSnapshot_basic(t *testing.T) {
@@ -16,9 +16,9 @@ func TestAccKsyunAutoSnapshot_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_auto_snapshot_policy.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSnapshotDestroy,
+		IDRefreshName:     "ksyun_auto_snapshot_policy.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSnapshotDestroy,
 
 		Steps: []resource.TestStep{
 			{
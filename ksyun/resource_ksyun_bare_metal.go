@@ -38,12 +38,12 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunBareMetal() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunBareMetalCreate,
 		Read:   resourceKsyunBareMetalRead,
 		Update: resourceKsyunBareMetalUpdate,
@@ -563,7 +563,7 @@ func resourceKsyunBareMetal() *schema.Resource {
 				Description: "ID of the primary network interface.",
 			},
 		},
-	}
+	})
 }
 
 func resourceKsyunBareMetalCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -26,12 +26,12 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunBandWidthShare() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunBandWidthShareCreate,
 		Read:   resourceKsyunBandWidthShareRead,
 		Update: resourceKsyunBandWidthShareUpdate,
@@ -79,7 +79,7 @@ func resourceKsyunBandWidthShare() *schema.Resource {
 			},
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 
 func resourceKsyunBandWidthShareCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -0,0 +1,156 @@
+/*
+Provides a Cen bandwidth package resource, which provides the bandwidth between the geographic regions for the Cen.
+
+# Example Usage
+
+```hcl
+resource "ksyun_cen" "default" {
+  cen_name = "tf-cen"
+}
+
+resource "ksyun_cen_bandwidth_package" "default" {
+  cen_bandwidth_package_name = "tf-cen-bwp"
+  band_width                 = 10
+  geographic_region_a        = "China"
+  geographic_region_b        = "China"
+  charge_type                = "PrePaidByMonth"
+  purchase_time              = 1
+  cen_id                     = ksyun_cen.default.id
+}
+```
+
+# Import
+
+Cen bandwidth package can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_cen_bandwidth_package.default xxxxxxxx-abc123456
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunCenBandwidthPackage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunCenBandwidthPackageCreate,
+		Read:   resourceKsyunCenBandwidthPackageRead,
+		Update: resourceKsyunCenBandwidthPackageUpdate,
+		Delete: resourceKsyunCenBandwidthPackageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"cen_bandwidth_package_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the bandwidth package.",
+			},
+			"band_width": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The bandwidth of the package in Mbps.",
+			},
+			"geographic_region_a": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "One of the geographic regions connected by the package, such as China.",
+			},
+			"geographic_region_b": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The other geographic region connected by the package, such as China.",
+			},
+			"charge_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PrePaidByMonth",
+					"PostPaidByPeak",
+				}, false),
+				Description: "The charge type of the package. Valid Values: 'PrePaidByMonth', 'PostPaidByPeak'.",
+			},
+			"purchase_time": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: purchaseTimeDiffSuppressFunc,
+				ValidateFunc:     validation.IntBetween(0, 36),
+				Description:      "The purchase time in months. If charge_type is PrePaidByMonth, this is Required.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     0,
+				Description: "The ID of the project.",
+			},
+			"cen_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the cen which the package is associated with.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the package.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the package.",
+			},
+			"expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The expiration time of the prepaid package.",
+			},
+		},
+	}
+}
+
+func resourceKsyunCenBandwidthPackageCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.CreateCenBandwidthPackage(d, resourceKsyunCenBandwidthPackage())
+	if err != nil {
+		return fmt.Errorf("error on creating cen bandwidth package %q, %s", d.Id(), err)
+	}
+	return resourceKsyunCenBandwidthPackageRead(d, meta)
+}
+
+func resourceKsyunCenBandwidthPackageRead(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.ReadAndSetCenBandwidthPackage(d, resourceKsyunCenBandwidthPackage())
+	if err != nil {
+		return fmt.Errorf("error on reading cen bandwidth package %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunCenBandwidthPackageUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.ModifyCenBandwidthPackage(d, resourceKsyunCenBandwidthPackage())
+	if err != nil {
+		return fmt.Errorf("error on updating cen bandwidth package %q, %s", d.Id(), err)
+	}
+	return resourceKsyunCenBandwidthPackageRead(d, meta)
+}
+
+func resourceKsyunCenBandwidthPackageDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.RemoveCenBandwidthPackage(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting cen bandwidth package %q, %s", d.Id(), err)
+	}
+	return err
+}
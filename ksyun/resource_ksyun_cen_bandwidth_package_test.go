@@ -0,0 +1,199 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccCenBandwidthPackageConfig = `
+resource "ksyun_cen" "foo" {
+  cen_name = "tf-acc-cen-bwp"
+}
+
+resource "ksyun_cen_bandwidth_package" "foo" {
+  cen_bandwidth_package_name = "tf-acc-cen-bwp"
+  band_width                 = 10
+  geographic_region_a        = "China"
+  geographic_region_b        = "China"
+  charge_type                = "PostPaidByPeak"
+  cen_id                     = ksyun_cen.foo.id
+}
+`
+
+func TestAccKsyunCenBandwidthPackage_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_cen_bandwidth_package.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCenBandwidthPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCenBandwidthPackageConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCenBandwidthPackageExists("ksyun_cen_bandwidth_package.foo"),
+					resource.TestCheckResourceAttrPair("ksyun_cen_bandwidth_package.foo", "cen_id", "ksyun_cen.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunCenBandwidthPackage_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCenBandwidthPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitCenBandwidthPackageConfig("ksyun_cen.foo.id", 10, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCenBandwidthPackageExists("ksyun_cen_bandwidth_package.foo"),
+					resource.TestCheckResourceAttr("ksyun_cen_bandwidth_package.foo", "state", "InUse"),
+					resource.TestCheckResourceAttrPair("ksyun_cen_bandwidth_package.foo", "cen_id", "ksyun_cen.foo", "id"),
+					resource.TestCheckResourceAttrPair("ksyun_cen_region_bandwidth_limit.foo", "cen_bandwidth_package_id",
+						"ksyun_cen_bandwidth_package.foo", "id"),
+					resource.TestCheckResourceAttr("ksyun_cen_region_bandwidth_limit.foo", "band_width", "5"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitCenBandwidthPackageConfig("ksyun_cen.foo.id", 20, 15),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_cen_bandwidth_package.foo", "band_width", "20"),
+					resource.TestCheckResourceAttr("ksyun_cen_region_bandwidth_limit.foo", "band_width", "15"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testUnitCenBandwidthPackageConfig("ksyun_cen.foo.id", 20, 15),
+				ResourceName:      "ksyun_cen_region_bandwidth_limit.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testUnitCenBandwidthPackageConfig("ksyun_cen.foo.id", 20, 15),
+				ResourceName:            "ksyun_cen_bandwidth_package.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"purchase_time"},
+			},
+			{
+				Config:      testMockApiProviderConfig(server) + testUnitCenBandwidthPackageConfig("ksyun_cen.foo.id", 20, 30),
+				ExpectError: regexp.MustCompile("exceed the bandwidth package"),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunCenBandwidthPackage_reassociate(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := func(cenId string) string {
+		return testMockApiProviderConfig(server) + fmt.Sprintf(`
+resource "ksyun_cen" "foo" {
+  cen_name = "tf-unit-cen-foo"
+}
+
+resource "ksyun_cen" "bar" {
+  cen_name = "tf-unit-cen-bar"
+}
+
+resource "ksyun_cen_bandwidth_package" "foo" {
+  band_width          = 10
+  geographic_region_a = "China"
+  geographic_region_b = "China"
+  charge_type         = "PostPaidByPeak"
+  cen_id              = %s
+}
+`, cenId)
+	}
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCenBandwidthPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("ksyun_cen.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ksyun_cen_bandwidth_package.foo", "cen_id", "ksyun_cen.foo", "id"),
+				),
+			},
+			{
+				Config: config("ksyun_cen.bar.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ksyun_cen_bandwidth_package.foo", "cen_id", "ksyun_cen.bar", "id"),
+				),
+			},
+			{
+				Config: config(`""`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_cen_bandwidth_package.foo", "cen_id", ""),
+					resource.TestCheckResourceAttr("ksyun_cen_bandwidth_package.foo", "state", "Idle"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitCenBandwidthPackageConfig(cenId string, bandWidth, limit int) string {
+	return fmt.Sprintf(`
+resource "ksyun_cen" "foo" {
+  cen_name = "tf-unit-cen"
+}
+
+resource "ksyun_cen_bandwidth_package" "foo" {
+  cen_bandwidth_package_name = "tf-unit-cen-bwp"
+  band_width                 = %d
+  geographic_region_a        = "China"
+  geographic_region_b        = "China"
+  charge_type                = "PostPaidByPeak"
+  cen_id                     = %s
+}
+
+resource "ksyun_cen_region_bandwidth_limit" "foo" {
+  cen_id          = ksyun_cen_bandwidth_package.foo.cen_id
+  local_region    = "cn-beijing-6"
+  opposite_region = "cn-shanghai-2"
+  band_width      = %d
+}
+`, bandWidth, cenId, limit)
+}
+
+func testAccCheckCenBandwidthPackageExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("cen bandwidth package id is empty")
+		}
+		cenService := CenService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := cenService.ReadCenBandwidthPackage(nil, rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckCenBandwidthPackageDestroy(s *terraform.State) error {
+	cenService := CenService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_cen_bandwidth_package" {
+			continue
+		}
+		_, err := cenService.ReadCenBandwidthPackage(nil, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("cen bandwidth package still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Provides a resource to grant an instance of the account to the Cen of another account,
+the owner of the Cen can attach the instance by `ksyun_cen_instance_attachment` after it's granted.
+
+# Example Usage
+
+```hcl
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-cen-grant-vpc"
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "ksyun_cen_grant" "default" {
+  cen_id         = "xxxxxxxx-abc123456"
+  cen_account_id = "2000012345"
+  instance_type  = "Vpc"
+  instance_id    = ksyun_vpc.default.id
+}
+```
+
+# Import
+
+Cen grant can be imported using the `cen_id` and `instance_id`, e.g.
+
+```
+$ terraform import ksyun_cen_grant.default $cen_id:$instance_id
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunCenGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunCenGrantCreate,
+		Read:   resourceKsyunCenGrantRead,
+		Delete: resourceKsyunCenGrantDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "cen_id", "instance_id"),
+		},
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the cen of another account.",
+			},
+			"cen_account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The account ID of the cen.",
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Vpc",
+					"DirectConnectGateway",
+				}, false),
+				Description: "The type of the instance. Valid Values: 'Vpc', 'DirectConnectGateway'.",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the instance to grant.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the instance is granted.",
+			},
+		},
+	}
+}
+
+func resourceKsyunCenGrantCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.CreateCenGrant(d)
+	if err != nil {
+		return fmt.Errorf("error on granting instance %q to cen %q, %s", d.Get("instance_id"), d.Get("cen_id"), err)
+	}
+	return resourceKsyunCenGrantRead(d, meta)
+}
+
+func resourceKsyunCenGrantRead(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.ReadAndSetCenGrant(d, resourceKsyunCenGrant())
+	if err != nil {
+		return fmt.Errorf("error on reading cen grant %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunCenGrantDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.RemoveCenGrant(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting cen grant %q, %s", d.Id(), err)
+	}
+	return err
+}
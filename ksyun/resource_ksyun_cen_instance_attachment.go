@@ -0,0 +1,132 @@
+/*
+Provides a resource to attach a VPC or a direct connect gateway to the Cen.
+
+~> **NOTE:** The instance of another account must be granted to the Cen by `ksyun_cen_grant` of that account before it's attached.
+
+# Example Usage
+
+```hcl
+resource "ksyun_cen" "default" {
+  cen_name = "tf-cen"
+}
+
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-cen-vpc"
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "ksyun_cen_instance_attachment" "default" {
+  cen_id        = ksyun_cen.default.id
+  instance_type = "Vpc"
+  instance_id   = ksyun_vpc.default.id
+}
+```
+
+# Import
+
+Cen instance attachment can be imported using the `cen_id` and `instance_id`, e.g.
+
+```
+$ terraform import ksyun_cen_instance_attachment.default $cen_id:$instance_id
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunCenInstanceAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunCenInstanceAttachmentCreate,
+		Read:   resourceKsyunCenInstanceAttachmentRead,
+		Delete: resourceKsyunCenInstanceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "cen_id", "instance_id"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the cen.",
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Vpc",
+					"DirectConnectGateway",
+				}, false),
+				Description: "The type of the instance. Valid Values: 'Vpc', 'DirectConnectGateway'.",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the instance.",
+			},
+			"instance_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region of the instance, it's the region of the provider if not set.",
+			},
+			"instance_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The account ID of the instance, it's the account of the provider if not set.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the attachment.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the instance is attached.",
+			},
+		},
+	}
+}
+
+func resourceKsyunCenInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.AttachCenInstance(d)
+	if err != nil {
+		return fmt.Errorf("error on attaching instance %q to cen %q, %s", d.Get("instance_id"), d.Get("cen_id"), err)
+	}
+	return resourceKsyunCenInstanceAttachmentRead(d, meta)
+}
+
+func resourceKsyunCenInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.ReadAndSetCenInstanceAttachment(d, resourceKsyunCenInstanceAttachment())
+	if err != nil {
+		return fmt.Errorf("error on reading cen instance attachment %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunCenInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.DetachCenInstance(d)
+	if err != nil {
+		return fmt.Errorf("error on detaching cen instance %q, %s", d.Id(), err)
+	}
+	return err
+}
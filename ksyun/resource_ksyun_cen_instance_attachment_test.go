@@ -0,0 +1,216 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccCenInstanceAttachmentConfig = `
+resource "ksyun_cen" "foo" {
+  cen_name = "tf-acc-cen-attachment"
+}
+
+resource "ksyun_vpc" "foo" {
+  vpc_name   = "tf-acc-cen-attachment"
+  cidr_block = "10.21.0.0/16"
+}
+
+resource "ksyun_cen_instance_attachment" "foo" {
+  cen_id        = ksyun_cen.foo.id
+  instance_type = "Vpc"
+  instance_id   = ksyun_vpc.foo.id
+}
+
+data "ksyun_cen_instances" "foo" {
+  cen_id = ksyun_cen_instance_attachment.foo.cen_id
+}
+`
+
+func TestAccKsyunCenInstanceAttachment_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCenInstanceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCenInstanceAttachmentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCenInstanceAttachmentExists("ksyun_cen_instance_attachment.foo"),
+					resource.TestCheckResourceAttr("ksyun_cen_instance_attachment.foo", "status", "Attached"),
+					resource.TestCheckResourceAttr("data.ksyun_cen_instances.foo", "total_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunCenInstanceAttachment_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + `
+resource "ksyun_cen" "foo" {
+  cen_name = "tf-unit-cen"
+}
+
+resource "ksyun_vpc" "foo" {
+  vpc_name   = "tf-unit-cen-foo"
+  cidr_block = "10.21.0.0/16"
+}
+
+resource "ksyun_vpc" "bar" {
+  vpc_name   = "tf-unit-cen-bar"
+  cidr_block = "10.22.0.0/16"
+}
+
+resource "ksyun_cen_instance_attachment" "foo" {
+  cen_id        = ksyun_cen.foo.id
+  instance_type = "Vpc"
+  instance_id   = ksyun_vpc.foo.id
+}
+
+resource "ksyun_cen_instance_attachment" "bar" {
+  cen_id        = ksyun_cen.foo.id
+  instance_type = "Vpc"
+  instance_id   = ksyun_vpc.bar.id
+}
+
+data "ksyun_cen_instances" "foo" {
+  cen_id        = ksyun_cen_instance_attachment.bar.cen_id
+  instance_type = ksyun_cen_instance_attachment.foo.instance_type
+}
+
+data "ksyun_cen_routes" "foo" {
+  cen_id      = ksyun_cen.foo.id
+  instance_id = ksyun_cen_instance_attachment.bar.instance_id
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCenInstanceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCenInstanceAttachmentExists("ksyun_cen_instance_attachment.foo"),
+					resource.TestCheckResourceAttr("ksyun_cen_instance_attachment.foo", "status", "Attached"),
+					resource.TestCheckResourceAttr("ksyun_cen_instance_attachment.foo", "instance_region", mockapi.DefaultRegion),
+					resource.TestCheckResourceAttr("ksyun_cen_instance_attachment.foo", "instance_account_id", mockapi.DefaultAccountId),
+					resource.TestCheckResourceAttr("data.ksyun_cen_instances.foo", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ksyun_cen_routes.foo", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_cen_routes.foo", "routes.0.destination_cidr_block", "10.22.0.0/16"),
+					resource.TestCheckResourceAttrPair("data.ksyun_cen_routes.foo", "routes.0.instance_id",
+						"ksyun_vpc.bar", "id"),
+				),
+			},
+			{
+				Config:            config,
+				ResourceName:      "ksyun_cen_instance_attachment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitKsyunCenInstanceAttachment_notGranted(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + `
+resource "ksyun_cen" "foo" {
+  cen_name = "tf-unit-cen"
+}
+
+resource "ksyun_cen_instance_attachment" "foo" {
+  cen_id              = ksyun_cen.foo.id
+  instance_type       = "Vpc"
+  instance_id         = "5f3d4e1a-6c2b-4a7e-8f9d-000000000000"
+  instance_account_id = "2000000002"
+}
+`,
+				ExpectError: regexp.MustCompile("is not granted to the cen"),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunCenGrant_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + `
+resource "ksyun_vpc" "foo" {
+  vpc_name   = "tf-unit-cen-grant"
+  cidr_block = "10.23.0.0/16"
+}
+
+resource "ksyun_cen_grant" "foo" {
+  cen_id         = "7b1c7a4f-8e8a-4f1a-9b5e-000000000000"
+  cen_account_id = "2000000002"
+  instance_type  = "Vpc"
+  instance_id    = ksyun_vpc.foo.id
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_cen_grant.foo"),
+					resource.TestCheckResourceAttrSet("ksyun_cen_grant.foo", "create_time"),
+				),
+			},
+			{
+				Config:            config,
+				ResourceName:      "ksyun_cen_grant.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCenInstanceAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("cen instance attachment id is empty")
+		}
+		cenService := CenService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := cenService.ReadCenInstance(rs.Primary.Attributes["cen_id"], rs.Primary.Attributes["instance_id"])
+		return err
+	}
+}
+
+func testAccCheckCenInstanceAttachmentDestroy(s *terraform.State) error {
+	cenService := CenService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_cen_instance_attachment" {
+			continue
+		}
+		_, err := cenService.ReadCenInstance(rs.Primary.Attributes["cen_id"], rs.Primary.Attributes["instance_id"])
+		if err == nil {
+			return fmt.Errorf("cen instance attachment still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
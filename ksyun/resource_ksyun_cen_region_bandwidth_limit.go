@@ -0,0 +1,117 @@
+/*
+Provides a resource to limit the bandwidth between two regions of the Cen.
+
+~> **NOTE:** The Cen must be associated with a bandwidth package covering both regions, the sum of the limits can't exceed the bandwidth of the package.
+
+# Example Usage
+
+```hcl
+resource "ksyun_cen_region_bandwidth_limit" "default" {
+  cen_id          = ksyun_cen_bandwidth_package.default.cen_id
+  local_region    = "cn-beijing-6"
+  opposite_region = "cn-shanghai-2"
+  band_width      = 5
+}
+```
+
+# Import
+
+Cen region bandwidth limit can be imported using the `cen_id`, `local_region` and `opposite_region`, e.g.
+
+```
+$ terraform import ksyun_cen_region_bandwidth_limit.default $cen_id:cn-beijing-6:cn-shanghai-2
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunCenRegionBandwidthLimit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunCenRegionBandwidthLimitCreate,
+		Read:   resourceKsyunCenRegionBandwidthLimitRead,
+		Update: resourceKsyunCenRegionBandwidthLimitUpdate,
+		Delete: resourceKsyunCenRegionBandwidthLimitDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(3, "cen_id", "local_region", "opposite_region"),
+		},
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the cen.",
+			},
+			"local_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The local region of the limit.",
+			},
+			"opposite_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The opposite region of the limit.",
+			},
+			"band_width": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The bandwidth between the regions in Mbps.",
+			},
+			"cen_bandwidth_package_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the bandwidth package which provides the bandwidth.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the limit.",
+			},
+		},
+	}
+}
+
+func resourceKsyunCenRegionBandwidthLimitCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.CreateCenRegionBandwidthLimit(d)
+	if err != nil {
+		return fmt.Errorf("error on creating cen region bandwidth limit %q, %s", d.Id(), err)
+	}
+	return resourceKsyunCenRegionBandwidthLimitRead(d, meta)
+}
+
+func resourceKsyunCenRegionBandwidthLimitRead(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.ReadAndSetCenRegionBandwidthLimit(d, resourceKsyunCenRegionBandwidthLimit())
+	if err != nil {
+		return fmt.Errorf("error on reading cen region bandwidth limit %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunCenRegionBandwidthLimitUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.ModifyCenRegionBandwidthLimit(d)
+	if err != nil {
+		return fmt.Errorf("error on updating cen region bandwidth limit %q, %s", d.Id(), err)
+	}
+	return resourceKsyunCenRegionBandwidthLimitRead(d, meta)
+}
+
+func resourceKsyunCenRegionBandwidthLimitDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	cenService := CenService{meta.(*KsyunClient)}
+	err = cenService.RemoveCenRegionBandwidthLimit(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting cen region bandwidth limit %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunCen_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_cen.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckCenDestroy,
+		IDRefreshName:     "ksyun_cen.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCenDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCenConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunCen_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_cen.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckCenDestroy,
+		IDRefreshName:     "ksyun_cen.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCenDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCenConfig,
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunCertificate_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_certificate.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckCertificateDestroy,
+		IDRefreshName:     "ksyun_certificate.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCertificateDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCertificateConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunCertificate_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_certificate.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckCertificateDestroy,
+		IDRefreshName:     "ksyun_certificate.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCertificateDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCertificateConfig,
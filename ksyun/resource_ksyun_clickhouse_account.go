@@ -0,0 +1,119 @@
+/*
+Provides a ClickHouse account resource.
+
+~> **NOTE:** The password is not returned by the api, only the sha256 hash of it is kept in the state.
+After an account is imported, the next apply resets the password to the configured one.
+
+# Example Usage
+
+```hcl
+resource "ksyun_clickhouse_account" "foo" {
+  instance_id         = ksyun_clickhouse_instance.foo.id
+  account_name        = "app_user"
+  account_password    = "123qweASD123"
+  account_description = "account of the app"
+}
+```
+
+# Import
+
+ClickHouse account can be imported using the `instance_id:account_name`, e.g.
+
+```
+$ terraform import ksyun_clickhouse_account.foo f20bcde7-c428-43f1-9170-xxxxxxxxxxxx:app_user
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunClickhouseAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunClickhouseAccountCreate,
+		Read:   resourceKsyunClickhouseAccountRead,
+		Update: resourceKsyunClickhouseAccountUpdate,
+		Delete: resourceKsyunClickhouseAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "instance_id", "account_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the clickhouse instance.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the account.",
+			},
+			"account_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+				StateFunc:    accountPasswordStateFunc,
+				Description:  "The password of the account. It is kept as a sha256 hash in the state.",
+			},
+			"account_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the account.",
+			},
+			"account_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the account.",
+			},
+			"account_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the account.",
+			},
+		},
+	}
+}
+
+func resourceKsyunClickhouseAccountCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.CreateAccount(d)
+	if err != nil {
+		return fmt.Errorf("error on creating clickhouse account %q, %s", d.Get("account_name"), err)
+	}
+	return resourceKsyunClickhouseAccountRead(d, meta)
+}
+
+func resourceKsyunClickhouseAccountRead(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.ReadAndSetAccount(d)
+	if err != nil {
+		return fmt.Errorf("error on reading clickhouse account %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunClickhouseAccountUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.ModifyAccount(d)
+	if err != nil {
+		return fmt.Errorf("error on updating clickhouse account %q, %s", d.Id(), err)
+	}
+	return resourceKsyunClickhouseAccountRead(d, meta)
+}
+
+func resourceKsyunClickhouseAccountDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.DeleteAccount(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting clickhouse account %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,106 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunClickhouseAccount_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_clickhouse_account.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClickhouseAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testClickhouseInstanceConfig("tf-acc-clickhouse-account", 1, 2) + testClickhouseAccountConfig("123qweASD123", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickhouseAccountExists("ksyun_clickhouse_account.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunClickhouseAccount_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClickhouseAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-account", 1, 2) + testClickhouseAccountConfig("Passw0rd1", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickhouseAccountExists("ksyun_clickhouse_account.foo"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_account.foo", "account_password", accountPasswordStateFunc("Passw0rd1")),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_account.foo", "account_description", "v1"),
+					resource.TestCheckResourceAttrSet("ksyun_clickhouse_account.foo", "account_status"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-account", 1, 2) + testClickhouseAccountConfig("Passw0rd2", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickhouseAccountExists("ksyun_clickhouse_account.foo"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_account.foo", "account_password", accountPasswordStateFunc("Passw0rd2")),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_account.foo", "account_description", "v2"),
+					testUnitCheckKrdsAccountPasswordSent(server, "Passw0rd2"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-account", 1, 2) + testClickhouseAccountConfig("Passw0rd2", "v2"),
+				ResourceName:            "ksyun_clickhouse_account.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_password"},
+			},
+		},
+	})
+}
+
+func testClickhouseAccountConfig(password, description string) string {
+	return fmt.Sprintf(`
+resource "ksyun_clickhouse_account" "foo" {
+  instance_id         = ksyun_clickhouse_instance.foo.id
+  account_name        = "tf_unit_user"
+  account_password    = "%s"
+  account_description = "%s"
+}
+`, password, description)
+}
+
+func testAccCheckClickhouseAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		clickhouseService := ClickhouseService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := clickhouseService.ReadAccount(rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["account_name"])
+		return err
+	}
+}
+
+func testAccCheckClickhouseAccountDestroy(s *terraform.State) error {
+	clickhouseService := ClickhouseService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_clickhouse_account" {
+			continue
+		}
+		_, err := clickhouseService.ReadAccount(rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["account_name"])
+		if err == nil {
+			return fmt.Errorf("clickhouse account still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+/*
+Provides a ClickHouse instance resource.
+
+The `shard_num` and the `replicas` can be changed in place, the update waits until the instance is running again.
+
+# Example Usage
+
+```hcl
+resource "ksyun_clickhouse_instance" "foo" {
+  instance_name     = "tf-clickhouse"
+  product_type      = "ClickHouse"
+  engine_version    = "21.8"
+  instance_config   = "8C32G"
+  shard_num         = 2
+  replicas          = 2
+  ebs_type          = "ESSD_PL1"
+  ebs_size          = 200
+  vpc_id            = "your vpc id"
+  subnet_id         = "your subnet id"
+  availability_zone = "cn-beijing-6a"
+  admin_password    = "123qweASD123"
+}
+```
+
+# Import
+
+ClickHouse instance can be imported using the `id`, the `admin_password` is not read back, e.g.
+
+```
+$ terraform import ksyun_clickhouse_instance.foo f20bcde7-c428-43f1-9170-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunClickhouseInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunClickhouseInstanceCreate,
+		Read:   resourceKsyunClickhouseInstanceRead,
+		Update: resourceKsyunClickhouseInstanceUpdate,
+		Delete: resourceKsyunClickhouseInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instance.",
+			},
+			"product_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ClickHouse_Single", "ClickHouse"}, false),
+				Description:  "The product type of the instance. Valid values: 'ClickHouse_Single' (single replica) or 'ClickHouse' (high availability).",
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The version of the engine.",
+			},
+			"instance_config": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The configuration of each node, such as `8C32G`.",
+			},
+			"shard_num": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of the shards. It can be changed in place. Default is 1.",
+			},
+			"replicas": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of the replicas of each shard. It can be changed in place, the default value is determined by the api.",
+			},
+			"ebs_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The type of the disks.",
+			},
+			"ebs_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The size of the disk of each node in GB.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the VPC.",
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the subnet.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The availability zone of the instance.",
+			},
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the clickhouse security group bound to the instance, the default one is created by the api if it's not set.",
+			},
+			"admin_user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the admin user, the default value is determined by the api.",
+			},
+			"admin_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+				Description:  "The password of the admin user.",
+			},
+			"bill_type": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     1,
+				Description: "The billing type of the instance. Default is 1.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the project.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the instance.",
+			},
+			"vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual IP address of the instance.",
+			},
+			"tcp_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The TCP port.",
+			},
+			"http_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The HTTP port.",
+			},
+			"node_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the nodes.",
+			},
+			"engine": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The engine of the instance.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the instance.",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the instance.",
+			},
+		},
+	}
+}
+
+func resourceKsyunClickhouseInstanceCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.CreateInstance(d)
+	if err != nil {
+		return fmt.Errorf("error on creating clickhouse instance %q, %s", d.Get("instance_name"), err)
+	}
+	return resourceKsyunClickhouseInstanceRead(d, meta)
+}
+
+func resourceKsyunClickhouseInstanceRead(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.ReadAndSetInstance(d, resourceKsyunClickhouseInstance())
+	if err != nil {
+		return fmt.Errorf("error on reading clickhouse instance %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunClickhouseInstanceUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.ModifyInstance(d)
+	if err != nil {
+		return fmt.Errorf("error on updating clickhouse instance %q, %s", d.Id(), err)
+	}
+	return resourceKsyunClickhouseInstanceRead(d, meta)
+}
+
+func resourceKsyunClickhouseInstanceDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.DeleteInstance(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting clickhouse instance %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,176 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunClickhouseInstance_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_clickhouse_instance.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClickhouseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testClickhouseInstanceConfig("tf-acc-clickhouse", 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickhouseInstanceExists("ksyun_clickhouse_instance.foo"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunClickhouseInstance_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClickhouseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse", 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickhouseInstanceExists("ksyun_clickhouse_instance.foo"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "status", "running"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "instance_config", "8C32G"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "availability_zone", "cn-beijing-6a"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "admin_user", "admin"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "node_num", "2"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "tcp_port", "9000"),
+					resource.TestCheckResourceAttrSet("ksyun_clickhouse_instance.foo", "security_group_id"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-renamed", 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "instance_name", "tf-unit-clickhouse-renamed"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "shard_num", "2"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "node_num", "4"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-renamed", 2, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "replicas", "3"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "node_num", "6"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-renamed", 2, 3),
+				ResourceName:            "ksyun_clickhouse_instance.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_password"},
+			},
+		},
+	})
+}
+
+func TestUnitKsyunClickhouseInstance_single(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClickhouseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + `
+resource "ksyun_clickhouse_instance" "foo" {
+  instance_name     = "tf-unit-clickhouse-single"
+  product_type      = "ClickHouse_Single"
+  engine_version    = "21.8"
+  instance_config   = "4C16G"
+  ebs_type          = "ESSD_PL1"
+  ebs_size          = 100
+  vpc_id            = "00000000-0000-0000-0000-00000000aaaa"
+  subnet_id         = "00000000-0000-0000-0000-00000000bbbb"
+  availability_zone = "cn-beijing-6a"
+  admin_password    = "123qweASD123"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "replicas", "1"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_instance.foo", "shard_num", "1"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + `
+resource "ksyun_clickhouse_instance" "foo" {
+  instance_name     = "tf-unit-clickhouse-single"
+  product_type      = "ClickHouse_Single"
+  engine_version    = "21.8"
+  instance_config   = "4C16G"
+  replicas          = 2
+  ebs_type          = "ESSD_PL1"
+  ebs_size          = 100
+  vpc_id            = "00000000-0000-0000-0000-00000000aaaa"
+  subnet_id         = "00000000-0000-0000-0000-00000000bbbb"
+  availability_zone = "cn-beijing-6a"
+  admin_password    = "123qweASD123"
+}
+`,
+				ExpectError: regexp.MustCompile("the ClickHouse_Single instance has only one replica"),
+			},
+		},
+	})
+}
+
+func testClickhouseInstanceConfig(name string, shardNum, replicas int) string {
+	return fmt.Sprintf(`
+resource "ksyun_clickhouse_instance" "foo" {
+  instance_name     = "%s"
+  product_type      = "ClickHouse"
+  engine_version    = "21.8"
+  instance_config   = "8C32G"
+  shard_num         = %d
+  replicas          = %d
+  ebs_type          = "ESSD_PL1"
+  ebs_size          = 200
+  vpc_id            = "00000000-0000-0000-0000-00000000aaaa"
+  subnet_id         = "00000000-0000-0000-0000-00000000bbbb"
+  availability_zone = "cn-beijing-6a"
+  admin_password    = "123qweASD123"
+}
+`, name, shardNum, replicas)
+}
+
+func testAccCheckClickhouseInstanceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		clickhouseService := ClickhouseService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := clickhouseService.ReadInstance(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckClickhouseInstanceDestroy(s *terraform.State) error {
+	clickhouseService := ClickhouseService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_clickhouse_instance" {
+			continue
+		}
+		_, err := clickhouseService.ReadInstance(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("clickhouse instance still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Provides a ClickHouse security rule resource, which adds a CIDR to the whitelist of a clickhouse security group.
+
+# Example Usage
+
+```hcl
+resource "ksyun_clickhouse_security_rule" "foo" {
+  security_group_id = ksyun_clickhouse_instance.foo.security_group_id
+  cidr              = "10.0.0.0/16"
+  description       = "the app servers"
+}
+```
+
+# Import
+
+ClickHouse security rule can be imported using the `security_group_id:cidr`, e.g.
+
+```
+$ terraform import ksyun_clickhouse_security_rule.foo 62540:10.0.0.0/16
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunClickhouseSecurityRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunClickhouseSecurityRuleCreate,
+		Read:   resourceKsyunClickhouseSecurityRuleRead,
+		Delete: resourceKsyunClickhouseSecurityRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "security_group_id", "cidr"),
+		},
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the clickhouse security group.",
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDRNetwork(0, 32),
+				Description:  "The CIDR allowed to access the instances.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the rule.",
+			},
+			"security_group_rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the rule.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the rule.",
+			},
+		},
+	}
+}
+
+func resourceKsyunClickhouseSecurityRuleCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.CreateSecurityRule(d)
+	if err != nil {
+		return fmt.Errorf("error on creating clickhouse security rule %q, %s", d.Get("cidr"), err)
+	}
+	return resourceKsyunClickhouseSecurityRuleRead(d, meta)
+}
+
+func resourceKsyunClickhouseSecurityRuleRead(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.ReadAndSetSecurityRule(d, resourceKsyunClickhouseSecurityRule())
+	if err != nil {
+		return fmt.Errorf("error on reading clickhouse security rule %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunClickhouseSecurityRuleDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	clickhouseService := ClickhouseService{meta.(*KsyunClient)}
+	err = clickhouseService.DeleteSecurityRule(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting clickhouse security rule %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testClickhouseSecurityRuleConfig = `
+resource "ksyun_clickhouse_security_rule" "foo" {
+  security_group_id = ksyun_clickhouse_instance.foo.security_group_id
+  cidr              = "10.0.0.0/16"
+  description       = "the app servers"
+}
+
+resource "ksyun_clickhouse_security_rule" "bar" {
+  security_group_id = ksyun_clickhouse_instance.foo.security_group_id
+  cidr              = "192.168.1.0/24"
+}
+`
+
+func TestAccKsyunClickhouseSecurityRule_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_clickhouse_security_rule.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClickhouseSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testClickhouseInstanceConfig("tf-acc-clickhouse-rule", 1, 2) + testClickhouseSecurityRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickhouseSecurityRuleExists("ksyun_clickhouse_security_rule.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunClickhouseSecurityRule_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckClickhouseSecurityRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-rule", 1, 2) + testClickhouseSecurityRuleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClickhouseSecurityRuleExists("ksyun_clickhouse_security_rule.foo"),
+					testAccCheckClickhouseSecurityRuleExists("ksyun_clickhouse_security_rule.bar"),
+					resource.TestCheckResourceAttr("ksyun_clickhouse_security_rule.foo", "description", "the app servers"),
+					resource.TestCheckResourceAttrSet("ksyun_clickhouse_security_rule.foo", "security_group_rule_id"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testClickhouseInstanceConfig("tf-unit-clickhouse-rule", 1, 2) + testClickhouseSecurityRuleConfig,
+				ResourceName:      "ksyun_clickhouse_security_rule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckClickhouseSecurityRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		clickhouseService := ClickhouseService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := clickhouseService.ReadSecurityRule(rs.Primary.Attributes["security_group_id"], rs.Primary.Attributes["cidr"])
+		return err
+	}
+}
+
+func testAccCheckClickhouseSecurityRuleDestroy(s *terraform.State) error {
+	clickhouseService := ClickhouseService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_clickhouse_security_rule" {
+			continue
+		}
+		_, err := clickhouseService.ReadSecurityRule(rs.Primary.Attributes["security_group_id"], rs.Primary.Attributes["cidr"])
+		if err == nil {
+			return fmt.Errorf("clickhouse security rule still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
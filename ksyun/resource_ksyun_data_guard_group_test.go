@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestResourceKsyunDataGuardGroup_basic(t *testing.T) {
@@ -12,8 +12,8 @@ func TestResourceKsyunDataGuardGroup_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_data_guard_group.foo",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_data_guard_group.foo",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckSnapshotDestroy,
 
 		Steps: []resource.TestStep{
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunDnat_basic(t *testing.T) {
@@ -14,9 +14,9 @@ func TestAccKsyunDnat_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_dnat.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckDnatDestroy,
+		IDRefreshName:     "ksyun_dnat.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDnatDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDnatConfig,
@@ -31,12 +31,12 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunEip() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunEipCreate,
 		Read:   resourceKsyunEipRead,
 		Update: resourceKsyunEipUpdate,
@@ -161,7 +161,7 @@ func resourceKsyunEip() *schema.Resource {
 				Description: "BWS EIP.",
 			},
 		},
-	}
+	})
 }
 
 func resourceKsyunEipCreate(d *schema.ResourceData, meta interface{}) (err error) {
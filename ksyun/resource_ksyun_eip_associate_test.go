@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"strings"
 	"testing"
 )
@@ -14,9 +14,9 @@ func TestAccKsyunEipAssociationAssociation_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_eip_associate.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckEipAssociationAssociationDestroy,
+		IDRefreshName:     "ksyun_eip_associate.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEipAssociationAssociationDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEipAssociationAssociationConfig,
@@ -2,19 +2,19 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 	"testing"
 )
 
 func TestAccKsyunEip_basic(t *testing.T) {
 	var val map[string]interface{}
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
 		IDRefreshName: "ksyun_eip.foo",
-		Providers:     testAccProviders,
 		CheckDestroy:  testAccCheckEipDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -34,9 +34,9 @@ func TestAccKsyunEip_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_eip.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckEipDestroy,
+		IDRefreshName:     "ksyun_eip.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEipDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccEipConfig,
@@ -56,6 +56,69 @@ func TestAccKsyunEip_update(t *testing.T) {
 	})
 }
 
+func TestUnitKsyunEip_basic(t *testing.T) {
+	var val map[string]interface{}
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitEipConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEipExists("ksyun_eip.foo", &val),
+					testAccCheckEipAttributes(&val),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "band_width", "1"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "state", "disassociate"),
+					resource.TestCheckResourceAttrSet("ksyun_eip.foo", "public_ip"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitEipUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEipExists("ksyun_eip.foo", &val),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "band_width", "10"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags.env", "unit"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunEip_defaultTags(t *testing.T) {
+	var val map[string]interface{}
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitEipDefaultTagsConfig(server, "c1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEipExists("ksyun_eip.foo", &val),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags.env", "unit"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags_all.env", "unit"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags_all.cost_center", "c1"),
+				),
+			},
+			{
+				Config: testUnitEipDefaultTagsConfig(server, "c2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags.%", "1"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("ksyun_eip.foo", "tags_all.cost_center", "c2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEipExists(n string, val *map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -150,3 +213,56 @@ resource "ksyun_eip" "foo" {
   project_id=0
 }
 `
+
+const testUnitEipConfig = `
+data "ksyun_lines" "default" {
+  line_name = "BGP"
+}
+resource "ksyun_eip" "foo" {
+  line_id     = data.ksyun_lines.default.lines.0.line_id
+  band_width  = 1
+  charge_type = "PostPaidByDay"
+}
+`
+
+const testUnitEipUpdateConfig = `
+data "ksyun_lines" "default" {
+  line_name = "BGP"
+}
+resource "ksyun_eip" "foo" {
+  line_id     = data.ksyun_lines.default.lines.0.line_id
+  band_width  = 10
+  charge_type = "PostPaidByDay"
+  tags = {
+    env = "unit"
+  }
+}
+`
+
+// testUnitEipDefaultTagsConfig returns the config whose default env tag is overridden by the resource
+func testUnitEipDefaultTagsConfig(server *mockapi.Server, costCenter string) string {
+	return fmt.Sprintf(`
+provider "ksyun" {
+  access_key     = "mock-ak"
+  secret_key     = "mock-sk"
+  region         = "cn-beijing-6"
+  domain         = "%s"
+  ignore_service = true
+  default_tags {
+    tags = {
+      cost_center = "%s"
+      env         = "default"
+    }
+  }
+}
+
+resource "ksyun_eip" "foo" {
+  line_id     = "%s"
+  band_width  = 1
+  charge_type = "PostPaidByDay"
+  tags = {
+    env = "unit"
+  }
+}
+`, server.Domain(), costCenter, mockapi.DefaultLineId)
+}
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunHealthCheck_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_healthcheck.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckHealthCheckDestroy,
+		IDRefreshName:     "ksyun_healthcheck.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckHealthCheckDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunHealthCheck_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_healthcheck.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckHealthCheckDestroy,
+		IDRefreshName:     "ksyun_healthcheck.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckHealthCheckDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig,
@@ -0,0 +1,132 @@
+/*
+Provides a resource to manage the access keys of an IAM user.
+
+~> **NOTE:** The secret is only returned on creating, it's kept in the state. Set `pgp_key` to keep it encrypted.
+
+# Example Usage
+
+```hcl
+resource "ksyun_iam_user" "user" {
+  user_name = "iam_user_name"
+}
+
+resource "ksyun_iam_access_key" "foo" {
+  user_name = ksyun_iam_user.user.user_name
+  pgp_key   = "keybase:some_person_that_exists"
+}
+
+output "encrypted_secret" {
+  value = ksyun_iam_access_key.foo.encrypted_secret
+}
+```
+
+# Import
+
+IAM access key can be imported using the `user_name:access_key_id`, the secret is not read back, e.g.
+
+```
+$ terraform import ksyun_iam_access_key.foo iam_user_name:AKLTxxxxxxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunIamAccessKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamAccessKeyCreate,
+		Read:   resourceKsyunIamAccessKeyRead,
+		Update: resourceKsyunIamAccessKeyUpdate,
+		Delete: resourceKsyunIamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importIamAccessKey,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM user.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Active",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Active",
+					"Inactive",
+				}, false),
+				Description: "The status of the access key. Valid Values: 'Active', 'Inactive'. Default is 'Active'.",
+			},
+			"pgp_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Either a base-64 encoded PGP public key, or a keybase username in the form `keybase:some_person_that_exists`, which is used to encrypt the secret.",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret of the access key, it's empty if `pgp_key` is set.",
+			},
+			"encrypted_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret encrypted by the `pgp_key`, base-64 encoded.",
+			},
+			"key_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fingerprint of the PGP key used to encrypt the secret.",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the access key.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamAccessKeyService := IamAccessKeyService{meta.(*KsyunClient)}
+	err = iamAccessKeyService.CreateIamAccessKey(d)
+	if err != nil {
+		return fmt.Errorf("error on creating IAM access key of user %q, %s", d.Get("user_name"), err)
+	}
+	return resourceKsyunIamAccessKeyRead(d, meta)
+}
+
+func resourceKsyunIamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamAccessKeyService := IamAccessKeyService{meta.(*KsyunClient)}
+	err = iamAccessKeyService.ModifyIamAccessKey(d)
+	if err != nil {
+		return fmt.Errorf("error on updating IAM access key %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamAccessKeyRead(d, meta)
+}
+
+func resourceKsyunIamAccessKeyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamAccessKeyService := IamAccessKeyService{meta.(*KsyunClient)}
+	err = iamAccessKeyService.ReadAndSetIamAccessKey(d, resourceKsyunIamAccessKey())
+	if err != nil {
+		return fmt.Errorf("error on reading IAM access key %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamAccessKeyService := IamAccessKeyService{meta.(*KsyunClient)}
+	err = iamAccessKeyService.DeleteIamAccessKey(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting IAM access key %q, %s", d.Id(), err)
+	}
+	return
+}
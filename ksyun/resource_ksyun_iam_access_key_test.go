@@ -0,0 +1,113 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+// testIamAccessKeyPgpKey is a base-64 encoded PGP public key generated for the tests only
+const testIamAccessKeyPgpKey = "mI0EatLKKwEEAKxHphyD8niqQ0w780S29I/qH9o8+VZvObSQIL0WmU67PYquij6W9KSzIMxri/lzWx6qGkrllnPGlLPhsyPT2wTVMs3hRTBn+M6QCy9JNhrBPwjw5KPbsBd9TrKVlhI0zpQ9Py4QLqyBe3fcWdmopHzqJ6dRmeGO20cKdlK3ktIdABEBAAG0IXRlcnJhZm9ybS10ZXN0IDx0ZXN0QGV4YW1wbGUuY29tPojOBBMBCgA4FiEEfzDmLLd0UDebdhuOGWqQfnQXm9EFAmrSyisCGwMFCwkIBwIGFQoJCAsCBBYCAwECHgECF4AACgkQGWqQfnQXm9FLyAP/dSzRkFdU3qoMwqmQB/YzD6M8ncEJYHvACoA2oUN1P5RwhgzoZtMJQBBBVbM8F1uAGG6dYgOWOAlPndlIUmXF21UeBLGUavbXnn05oK6IgXSIDKEjTx0HTPe9rWcFm+aoTSFE744kgRGq0quhjUgY/iUe+rJWeXQPtlX1sB2L0Xi4jQRq0sorAQQAsDcorqkfc9PcLUHZ8I5glX0GrzRzgRsqoQe+q3gMa9uPqAATWNocZPcWwvvvl6SlGoMEjrges8y5Lkz15kdjN2LeODsVm86hm+dSH4vYWfI2WX7ez2V9ueRGtXMKPzwMqX3QEhgel1hkYP9n/PIypNEpXi5hnLNcMMLYJW4kBu0AEQEAAYi2BBgBCgAgFiEEfzDmLLd0UDebdhuOGWqQfnQXm9EFAmrSyisCGwwACgkQGWqQfnQXm9GJwwP/a6Y+4wsJ7WHdHhlgTZebmXGelwDHGJ81zhP8WzP5uDf8t2N74CEzW2nJuofy9m1V7iLoCLu9G8pHHWvpY/Vtjx+Nn399rN88bzs/NXg60ab12v+k6tsA8H4Dx3anIhxdkQqQGq+aUSNxxYVz6JW0Qo64dTMqwob1iCWtSku0pQM="
+
+func TestUnitKsyunIamAccessKey_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIamAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitIamAccessKeyConfig("Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("ksyun_iam_access_key.foo", "id", regexp.MustCompile("^AKLT")),
+					resource.TestCheckResourceAttr("ksyun_iam_access_key.foo", "status", "Inactive"),
+					resource.TestMatchResourceAttr("ksyun_iam_access_key.foo", "secret", regexp.MustCompile("^secret-AKLT")),
+					resource.TestCheckNoResourceAttr("ksyun_iam_access_key.foo", "encrypted_secret"),
+					resource.TestCheckResourceAttrSet("ksyun_iam_access_key.foo", "create_date"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitIamAccessKeyConfig("Active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_access_key.foo", "status", "Active"),
+					resource.TestMatchResourceAttr("ksyun_iam_access_key.foo", "secret", regexp.MustCompile("^secret-AKLT")),
+				),
+			},
+			{
+				Config:       testMockApiProviderConfig(server) + testUnitIamAccessKeyConfig("Active"),
+				ResourceName: "ksyun_iam_access_key.foo",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["ksyun_iam_access_key.foo"]
+					return rs.Primary.Attributes["user_name"] + ":" + rs.Primary.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestUnitKsyunIamAccessKey_pgpKey(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIamAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + fmt.Sprintf(`
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-unit-user"
+}
+
+resource "ksyun_iam_access_key" "foo" {
+  user_name = ksyun_iam_user.user.user_name
+  pgp_key   = "%s"
+}
+`, testIamAccessKeyPgpKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("ksyun_iam_access_key.foo", "secret"),
+					resource.TestMatchResourceAttr("ksyun_iam_access_key.foo", "encrypted_secret", regexp.MustCompile("^[A-Za-z0-9+/]+=*$")),
+					resource.TestCheckResourceAttr("ksyun_iam_access_key.foo", "key_fingerprint", "7f30e62cb77450379b761b8e196a907e74179bd1"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitIamAccessKeyConfig(status string) string {
+	return fmt.Sprintf(`
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-unit-user"
+}
+
+resource "ksyun_iam_access_key" "foo" {
+  user_name = ksyun_iam_user.user.user_name
+  status    = "%s"
+}
+`, status)
+}
+
+func testAccCheckIamAccessKeyDestroy(s *terraform.State) error {
+	accessKeyService := IamAccessKeyService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_iam_access_key" {
+			continue
+		}
+		_, err := accessKeyService.ReadAccessKey(rs.Primary.Attributes["user_name"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("iam access key still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
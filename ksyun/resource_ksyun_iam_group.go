@@ -26,7 +26,7 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunIamGroup() *schema.Resource {
@@ -34,6 +34,9 @@ func resourceKsyunIamGroup() *schema.Resource {
 		Create: resourceKsyunIamGroupCreate,
 		Read:   resourceKsyunIamGroupRead,
 		Delete: resourceKsyunIamGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "group_name"),
+		},
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:        schema.TypeString,
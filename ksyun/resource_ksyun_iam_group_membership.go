@@ -0,0 +1,103 @@
+/*
+Provides a resource to manage the users of an IAM group.
+
+~> **NOTE:** Only the users in `user_names` are managed, the users added to the group by others are kept.
+
+# Example Usage
+
+```hcl
+resource "ksyun_iam_user" "user" {
+  user_name = "iam_user_name"
+}
+
+resource "ksyun_iam_group" "group" {
+  group_name = "iam_group_name"
+}
+
+resource "ksyun_iam_group_membership" "foo" {
+  group_name = ksyun_iam_group.group.group_name
+  user_names = [ksyun_iam_user.user.user_name]
+}
+```
+
+# Import
+
+IAM group membership can be imported using the `group_name`, e.g.
+
+```
+$ terraform import ksyun_iam_group_membership.foo iam_group_name
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunIamGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamGroupMembershipCreate,
+		Read:   resourceKsyunIamGroupMembershipRead,
+		Update: resourceKsyunIamGroupMembershipUpdate,
+		Delete: resourceKsyunIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "group_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM group.",
+			},
+			"user_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "The names of the IAM users in the group.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupMembershipService := IamGroupMembershipService{meta.(*KsyunClient)}
+	err = iamGroupMembershipService.CreateIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on creating IAM group membership %q, %s", d.Get("group_name"), err)
+	}
+	return resourceKsyunIamGroupMembershipRead(d, meta)
+}
+
+func resourceKsyunIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupMembershipService := IamGroupMembershipService{meta.(*KsyunClient)}
+	err = iamGroupMembershipService.ModifyIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on updating IAM group membership %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamGroupMembershipRead(d, meta)
+}
+
+func resourceKsyunIamGroupMembershipRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupMembershipService := IamGroupMembershipService{meta.(*KsyunClient)}
+	err = iamGroupMembershipService.ReadAndSetIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on reading IAM group membership %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamGroupMembershipService := IamGroupMembershipService{meta.(*KsyunClient)}
+	err = iamGroupMembershipService.DeleteIamGroupMembership(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting IAM group membership %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,141 @@
+package ksyun
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunIamGroupMembership_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIamGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitIamGroupMembershipConfig(`[ksyun_iam_user.a.user_name, ksyun_iam_user.b.user_name]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_iam_group_membership.foo"),
+					resource.TestCheckResourceAttr("ksyun_iam_group_membership.foo", "user_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunIamGroupMembership_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIamGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) +
+					testUnitIamGroupMembershipConfig(`[ksyun_iam_user.a.user_name, ksyun_iam_user.b.user_name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_group_membership.foo", "id", "tf-unit-group"),
+					resource.TestCheckResourceAttr("ksyun_iam_group_membership.foo", "user_names.#", "2"),
+					testAccCheckIamGroupMembers("tf-unit-group", "tf-unit-user-a", "tf-unit-user-b"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) +
+					testUnitIamGroupMembershipConfig(`[ksyun_iam_user.b.user_name, ksyun_iam_user.c.user_name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_group_membership.foo", "user_names.#", "2"),
+					testAccCheckIamGroupMembers("tf-unit-group", "tf-unit-user-b", "tf-unit-user-c"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) +
+					testUnitIamGroupMembershipConfig(`[ksyun_iam_user.b.user_name, ksyun_iam_user.c.user_name]`),
+				ResourceName:      "ksyun_iam_group_membership.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testMockApiProviderConfig(server) +
+					testUnitIamGroupMembershipConfig(`[ksyun_iam_user.b.user_name, ksyun_iam_user.c.user_name]`),
+				ResourceName:      "ksyun_iam_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testMockApiProviderConfig(server) +
+					testUnitIamGroupMembershipConfig(`[ksyun_iam_user.b.user_name, ksyun_iam_user.c.user_name]`),
+				ResourceName:      "ksyun_iam_user.a",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testUnitIamGroupMembershipConfig(userNames string) string {
+	return fmt.Sprintf(`
+resource "ksyun_iam_user" "a" {
+  user_name = "tf-unit-user-a"
+}
+
+resource "ksyun_iam_user" "b" {
+  user_name = "tf-unit-user-b"
+}
+
+resource "ksyun_iam_user" "c" {
+  user_name = "tf-unit-user-c"
+}
+
+resource "ksyun_iam_group" "group" {
+  group_name  = "tf-unit-group"
+  description = "tf unit group"
+}
+
+resource "ksyun_iam_group_membership" "foo" {
+  group_name = ksyun_iam_group.group.group_name
+  user_names = %s
+}
+`, userNames)
+}
+
+func testAccCheckIamGroupMembers(groupName string, userNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		membershipService := IamGroupMembershipService{testAccProvider.Meta().(*KsyunClient)}
+		members, err := membershipService.ReadGroupMembers(groupName, nil)
+		if err != nil {
+			return err
+		}
+		sort.Strings(members)
+		if fmt.Sprint(members) != fmt.Sprint(userNames) {
+			return fmt.Errorf("the members of group %s are %v, expected %v", groupName, members, userNames)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIamGroupMembershipDestroy(s *terraform.State) error {
+	membershipService := IamGroupMembershipService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_iam_user" {
+			continue
+		}
+		groups, err := membershipService.ReadGroupsForUser(rs.Primary.ID)
+		if err != nil {
+			if notFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if len(groups) > 0 {
+			return fmt.Errorf("the user %s is still in groups", rs.Primary.ID)
+		}
+	}
+	return nil
+}
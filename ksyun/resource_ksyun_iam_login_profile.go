@@ -0,0 +1,106 @@
+/*
+Provides a resource to manage the console login password of an IAM user.
+
+# Example Usage
+
+```hcl
+resource "ksyun_iam_user" "user" {
+  user_name = "iam_user_name"
+}
+
+resource "ksyun_iam_login_profile" "foo" {
+  user_name               = ksyun_iam_user.user.user_name
+  password                = "Password@123"
+  password_reset_required = true
+}
+```
+
+# Import
+
+IAM login profile can be imported using the `user_name`, the `password` is not read back, e.g.
+
+```
+$ terraform import ksyun_iam_login_profile.foo iam_user_name
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunIamLoginProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunIamLoginProfileCreate,
+		Read:   resourceKsyunIamLoginProfileRead,
+		Update: resourceKsyunIamLoginProfileUpdate,
+		Delete: resourceKsyunIamLoginProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "user_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM user.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The console login password of the user.",
+			},
+			"password_reset_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the user must reset the password at the next login.",
+			},
+			"create_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the login profile.",
+			},
+		},
+	}
+}
+
+func resourceKsyunIamLoginProfileCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamLoginProfileService := IamLoginProfileService{meta.(*KsyunClient)}
+	err = iamLoginProfileService.CreateIamLoginProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on creating IAM login profile %q, %s", d.Get("user_name"), err)
+	}
+	return resourceKsyunIamLoginProfileRead(d, meta)
+}
+
+func resourceKsyunIamLoginProfileUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	iamLoginProfileService := IamLoginProfileService{meta.(*KsyunClient)}
+	err = iamLoginProfileService.ModifyIamLoginProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on updating IAM login profile %q, %s", d.Id(), err)
+	}
+	return resourceKsyunIamLoginProfileRead(d, meta)
+}
+
+func resourceKsyunIamLoginProfileRead(d *schema.ResourceData, meta interface{}) (err error) {
+	iamLoginProfileService := IamLoginProfileService{meta.(*KsyunClient)}
+	err = iamLoginProfileService.ReadAndSetIamLoginProfile(d, resourceKsyunIamLoginProfile())
+	if err != nil {
+		return fmt.Errorf("error on reading IAM login profile %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunIamLoginProfileDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	iamLoginProfileService := IamLoginProfileService{meta.(*KsyunClient)}
+	err = iamLoginProfileService.DeleteIamLoginProfile(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting IAM login profile %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,74 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestUnitKsyunIamLoginProfile_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIamLoginProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitIamLoginProfileConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_login_profile.foo", "id", "tf-unit-user"),
+					resource.TestCheckResourceAttr("ksyun_iam_login_profile.foo", "password_reset_required", "false"),
+					resource.TestCheckResourceAttrSet("ksyun_iam_login_profile.foo", "create_date"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitIamLoginProfileConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_login_profile.foo", "password_reset_required", "true"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testUnitIamLoginProfileConfig(true),
+				ResourceName:            "ksyun_iam_login_profile.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testUnitIamLoginProfileConfig(resetRequired bool) string {
+	return fmt.Sprintf(`
+resource "ksyun_iam_user" "user" {
+  user_name = "tf-unit-user"
+}
+
+resource "ksyun_iam_login_profile" "foo" {
+  user_name               = ksyun_iam_user.user.user_name
+  password                = "Password@123"
+  password_reset_required = %t
+}
+`, resetRequired)
+}
+
+func testAccCheckIamLoginProfileDestroy(s *terraform.State) error {
+	loginProfileService := IamLoginProfileService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_iam_login_profile" {
+			continue
+		}
+		_, err := loginProfileService.ReadLoginProfile(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("iam login profile still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -10,14 +10,26 @@ resource "ksyun_iam_policy" "policy" {
   policy_document = "{\"Version\": \"2015-11-01\",\"Statement\": [{\"Effect\": \"Allow\",\"Action\": [\"iam:List*\"],\"Resource\": [\"*\"]}]}"
 }`
 
+data "ksyun_iam_policy_document" "list" {
+  statement {
+    actions   = ["iam:List*"]
+    resources = ["*"]
+  }
+}
+
+resource "ksyun_iam_policy" "document" {
+  policy_name     = "TestPolicy2"
+  policy_document = data.ksyun_iam_policy_document.list.json
+}
+
 ```
 
 # Import
 
-IAM Policy can be imported using the `policy_name`, e.g.
+IAM Policy can be imported using the `policy_krn`, e.g.
 
 ```
-$ terraform import ksyun_iam_policy.policy policy_name
+$ terraform import ksyun_iam_policy.policy krn:ksc:iam::2000000001:policy/policy_name
 ```
 */
 
@@ -26,7 +38,7 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunIamPolicy() *schema.Resource {
@@ -35,6 +47,9 @@ func resourceKsyunIamPolicy() *schema.Resource {
 		Read:   resourceKsyunIamPolicyRead,
 		Update: resourceKsyunIamPolicyUpdate,
 		Delete: resourceKsyunIamPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importIamPolicy,
+		},
 		Schema: map[string]*schema.Schema{
 			"policy_name": {
 				Type:        schema.TypeString,
@@ -43,9 +58,10 @@ func resourceKsyunIamPolicy() *schema.Resource {
 				Description: "IAM PolicyName.",
 			},
 			"policy_document": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "IAM PolicyDocument.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: policyDocumentDiffSuppressFunc,
+				Description:      "IAM PolicyDocument, it can be rendered by the `ksyun_iam_policy_document` data source.",
 			},
 			"policy_krn": {
 				Type:        schema.TypeString,
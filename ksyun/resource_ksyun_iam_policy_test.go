@@ -1,8 +1,10 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunIamPolicy_basic(t *testing.T) {
@@ -10,7 +12,7 @@ func TestAccKsyunIamPolicy_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIAMPolicyConfig,
@@ -24,6 +26,16 @@ func TestAccKsyunIamPolicy_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_policy.policy"),
 				),
 			},
+			{
+				Config:       testAccIAMPolicyConfigUpdate,
+				ResourceName: "ksyun_iam_policy.policy",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["ksyun_iam_policy.policy"].Primary.Attributes["policy_krn"], nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_document"},
+			},
 		},
 	})
 }
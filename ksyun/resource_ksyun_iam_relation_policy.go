@@ -11,21 +11,28 @@ resource "ksyun_iam_relation_policy" "user" {
   policy_type = "system"
 }`
 
-resource "ksyun_iam_relation_policy" "user" {
+resource "ksyun_iam_relation_policy" "role" {
   name = "iam_role_name"
   policy_name = "IAMReadOnlyAccess"
   relation_type = 2
   policy_type = "system"
 }`
 
+resource "ksyun_iam_relation_policy" "group" {
+  name = "iam_group_name"
+  policy_name = "IAMReadOnlyAccess"
+  relation_type = 3
+  policy_type = "system"
+}`
+
 ```
 
 # Import
 
-IAM Policy can be imported using the `policy_name`, e.g.
+IAM relation policy can be imported using the `name:policy_name:relation_type:policy_type`, e.g.
 
 ```
-$ terraform import ksyun_iam_relation_policy.user
+$ terraform import ksyun_iam_relation_policy.user iam_user_name:IAMReadOnlyAccess:1:system
 ```
 */
 
@@ -33,7 +40,9 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunIamRelationPolicy() *schema.Resource {
@@ -41,12 +50,15 @@ func resourceKsyunIamRelationPolicy() *schema.Resource {
 		Create: resourceKsyunIamRelationPolicyCreate,
 		Read:   resourceKsyunIamRelationPolicyRead,
 		Delete: resourceKsyunIamRelationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importIamRelationPolicy,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "IAM UserName or RoleName according to relation type.",
+				Description: "IAM UserName, RoleName or GroupName according to relation type.",
 			},
 			"policy_name": {
 				Type:        schema.TypeString,
@@ -55,10 +67,11 @@ func resourceKsyunIamRelationPolicy() *schema.Resource {
 				Description: "IAM PolicyName.",
 			},
 			"relation_type": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				ForceNew:    true,
-				Description: "relation type 1 is the user,relation type 2 is the role.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 3}),
+				Description:  "relation type 1 is the user,relation type 2 is the role,relation type 3 is the group.",
 			},
 			"policy_type": {
 				Type:        schema.TypeString,
@@ -1,8 +1,12 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"fmt"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunIamRelationPolicy_basic(t *testing.T) {
@@ -10,7 +14,7 @@ func TestAccKsyunIamRelationPolicy_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKsyunIamRelationPolicyConfig,
@@ -29,3 +33,89 @@ resource "ksyun_iam_relation_policy" "user" {
   relation_type = 1
   policy_type = "system"
 }`
+
+func TestUnitKsyunIamRelationPolicy_group(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + `
+resource "ksyun_iam_group" "group" {
+  group_name = "tf-unit-group"
+}
+
+resource "ksyun_iam_relation_policy" "custom" {
+  name          = ksyun_iam_group.group.group_name
+  policy_name   = "tf-unit-policy"
+  relation_type = 3
+  policy_type   = "custom"
+}
+
+resource "ksyun_iam_relation_policy" "system" {
+  name          = ksyun_iam_group.group.group_name
+  policy_name   = "IAMReadOnlyAccess"
+  relation_type = 3
+  policy_type   = "system"
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIamGroupRelationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_relation_policy.system", "id", "tf-unit-group:IAMReadOnlyAccess:3:system"),
+					resource.TestCheckResourceAttr("ksyun_iam_relation_policy.custom", "id", "tf-unit-group:tf-unit-policy:3:custom"),
+					testAccCheckIamGroupRelationPolicyExists("ksyun_iam_relation_policy.system", "krn:ksc:iam::ksc:policy/IAMReadOnlyAccess"),
+					testAccCheckIamGroupRelationPolicyExists("ksyun_iam_relation_policy.custom",
+						fmt.Sprintf("krn:ksc:iam::%s:policy/tf-unit-policy", mockapi.DefaultAccountId)),
+				),
+			},
+			{
+				Config:            config,
+				ResourceName:      "ksyun_iam_relation_policy.custom",
+				ImportState:       true,
+				ImportStateId:     "tf-unit-group:tf-unit-policy:3:custom",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIamGroupRelationPolicyExists(n, policyKrn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		relationPolicyService := IamRelationPolicyService{testAccProvider.Meta().(*KsyunClient)}
+		data, err := relationPolicyService.ReadGroupRelationPolicy(rs.Primary.Attributes["name"], rs.Primary.Attributes["policy_name"])
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 || data[0].(map[string]interface{})["PolicyKrn"] != policyKrn {
+			return fmt.Errorf("the policy %s is not attached to group %s", policyKrn, rs.Primary.Attributes["name"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckIamGroupRelationPolicyDestroy(s *terraform.State) error {
+	relationPolicyService := IamRelationPolicyService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_iam_relation_policy" {
+			continue
+		}
+		data, err := relationPolicyService.ReadGroupRelationPolicy(rs.Primary.Attributes["name"], rs.Primary.Attributes["policy_name"])
+		if err != nil {
+			if notFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if len(data) > 0 {
+			return fmt.Errorf("iam relation policy still exists: %s", rs.Primary.Attributes["policy_name"])
+		}
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 /*
 Provides a Iam Role resource.
 
+~> **NOTE:** The role trusts the accounts in `trust_accounts`, the api doesn't take a trust policy document,
+so there is no JSON document to be compared like the `policy_document` of `ksyun_iam_policy`.
+
 # Example Usage
 
 ```hcl
@@ -27,7 +30,7 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunIamRole() *schema.Resource {
@@ -35,6 +38,9 @@ func resourceKsyunIamRole() *schema.Resource {
 		Create: resourceKsyunIamRoleCreate,
 		Read:   resourceKsyunIamRoleRead,
 		Delete: resourceKsyunIamRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "role_name"),
+		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
@@ -1,8 +1,10 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunIamRole_basic(t *testing.T) {
@@ -10,7 +12,7 @@ func TestAccKsyunIamRole_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIAMRoleConfig,
@@ -18,6 +20,34 @@ func TestAccKsyunIamRole_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_role.role"),
 				),
 			},
+			{
+				ResourceName:      "ksyun_iam_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitKsyunIamRole_import(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testAccIAMRoleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_role.role", "trust_accounts", "2000096256"),
+					resource.TestCheckResourceAttr("ksyun_iam_role.role", "description", "desc"),
+				),
+			},
+			{
+				ResourceName:      "ksyun_iam_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -22,7 +22,7 @@ resource "ksyun_iam_user" "user" {
 
 # Import
 
-IAM User can be imported using the `user_name`, e.g.
+IAM User can be imported using the `user_name`, the `password` is not read back, e.g.
 
 ```
 $ terraform import ksyun_iam_user.user user_name
@@ -34,7 +34,7 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunIamUser() *schema.Resource {
@@ -42,6 +42,9 @@ func resourceKsyunIamUser() *schema.Resource {
 		Create: resourceKsyunIamUserCreate,
 		Read:   resourceKsyunIamUserRead,
 		Delete: resourceKsyunIamUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "user_name"),
+		},
 		Schema: map[string]*schema.Schema{
 			"user_name": {
 				Type:        schema.TypeString,
@@ -82,24 +85,28 @@ func resourceKsyunIamUser() *schema.Resource {
 			"password_reset_required": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "Does IAM user login reset password.",
 			},
 			"open_login_protection": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "Does IAM user enable login protection.",
 			},
 			"open_security_protection": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "Does IAM user enable operation protection.",
 			},
 			"view_all_project": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "Can IAM users view all projects.",
 			},
@@ -1,8 +1,10 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunIamUser_basic(t *testing.T) {
@@ -10,7 +12,7 @@ func TestAccKsyunIamUser_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccIAMUserConfig,
@@ -18,6 +20,47 @@ func TestAccKsyunIamUser_basic(t *testing.T) {
 					testAccCheckIDExists("ksyun_iam_user.user"),
 				),
 			},
+			{
+				ResourceName:            "ksyun_iam_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+// TestUnitKsyunIamUser_import imports the users with and without the password,
+// the arguments are read back from the user and its login profile.
+func TestUnitKsyunIamUser_import(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testAccIAMUserConfig + `
+resource "ksyun_iam_user" "no_password" {
+  user_name = "username02"
+  remark    = "remark"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_iam_user.user", "open_login_protection", "1"),
+					resource.TestCheckNoResourceAttr("ksyun_iam_user.no_password", "open_login_protection"),
+				),
+			},
+			{
+				ResourceName:            "ksyun_iam_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				ResourceName:      "ksyun_iam_user.no_password",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -0,0 +1,153 @@
+/*
+Provides a custom image resource, which is created from an instance or a system disk snapshot.
+
+# Example Usage
+
+```hcl
+resource "ksyun_image" "foo" {
+  image_name  = "tf-golden-image"
+  instance_id = "4e3b9a8b-5f3c-4d1e-9f8a-xxxxxxxxxxxx"
+}
+```
+
+# Import
+
+Image can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_image.foo IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kecImageComputedSchema returns the attributes of the image that are shared by the image resources
+func kecImageComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"image_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the image.",
+		},
+		"image_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The state of the image.",
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The creation time of the image.",
+		},
+		"sys_disk": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The size of the system disk of the image, in GB.",
+		},
+		"image_source": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The source of the image.",
+		},
+	}
+}
+
+func resourceKsyunImage() *schema.Resource {
+	s := kecImageComputedSchema()
+	s["image_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the image.",
+	}
+	s["instance_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"instance_id", "snapshot_id"},
+		Description:  "The ID of the instance to create the image from.",
+	}
+	s["snapshot_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"instance_id", "snapshot_id"},
+		Description:  "The ID of the system disk snapshot to create the image from.",
+	}
+	s["data_disk_ids"] = &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		ForceNew:      true,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Set:           schema.HashString,
+		ConflictsWith: []string{"snapshot_id"},
+		Description:   "The IDs of the data disks of the instance to include in the image.",
+	}
+	s["platform"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The platform of the image.",
+	}
+	return &schema.Resource{
+		Create: resourceKsyunImageCreate,
+		Read:   resourceKsyunImageRead,
+		Update: resourceKsyunImageUpdate,
+		Delete: resourceKsyunImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: importImage,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: s,
+	}
+}
+
+func importImage(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("image_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceKsyunImageCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.CreateImage(d, resourceKsyunImage())
+	if err != nil {
+		return fmt.Errorf("error on creating image %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageRead(d, meta)
+}
+
+func resourceKsyunImageRead(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ReadAndSetImage(d, resourceKsyunImage())
+	if err != nil {
+		return fmt.Errorf("error on reading image %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunImageUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ModifyImage(d)
+	if err != nil {
+		return fmt.Errorf("error on updating image %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageRead(d, meta)
+}
+
+func resourceKsyunImageDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.RemoveImage(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting image %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,121 @@
+/*
+Provides an image copy resource, which copies a custom image to another region.
+
+~> **NOTE:** The copied image is managed in the destination region, destroying the resource removes the copied image only.
+
+# Example Usage
+
+```hcl
+resource "ksyun_image" "foo" {
+  image_name  = "tf-golden-image"
+  instance_id = "4e3b9a8b-5f3c-4d1e-9f8a-xxxxxxxxxxxx"
+}
+
+resource "ksyun_image_copy" "foo" {
+  source_image_id    = ksyun_image.foo.id
+  destination_region = "cn-shanghai-2"
+  image_name         = "tf-golden-image-copy"
+}
+```
+
+# Import
+
+Copied image can be imported using the destination region, the id of the copied image and the id of the source image, e.g.
+
+```
+$ terraform import ksyun_image_copy.foo cn-shanghai-2:IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx:IMG-0b2f6f3c-8e0d-4c55-9a1e-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunImageCopy() *schema.Resource {
+	s := kecImageComputedSchema()
+	s["source_image_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The ID of the image to copy.",
+	}
+	s["destination_region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The region to copy the image to.",
+	}
+	s["image_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the copied image, the name of the source image is used if it's not set.",
+	}
+	return &schema.Resource{
+		Create: resourceKsyunImageCopyCreate,
+		Read:   resourceKsyunImageCopyRead,
+		Update: resourceKsyunImageCopyUpdate,
+		Delete: resourceKsyunImageCopyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importImageCopy,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: s,
+	}
+}
+
+func resourceKsyunImageCopyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.CopyImage(d)
+	if err != nil {
+		return fmt.Errorf("error on copying image %q, %s", d.Get("source_image_id"), err)
+	}
+	return resourceKsyunImageCopyRead(d, meta)
+}
+
+func resourceKsyunImageCopyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	destination, err := imageService.destination(d.Get("destination_region").(string))
+	if err != nil {
+		return err
+	}
+	err = destination.ReadAndSetImage(d, resourceKsyunImageCopy())
+	if err != nil {
+		return fmt.Errorf("error on reading image %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunImageCopyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	destination, err := imageService.destination(d.Get("destination_region").(string))
+	if err != nil {
+		return err
+	}
+	err = destination.ModifyImage(d)
+	if err != nil {
+		return fmt.Errorf("error on updating image %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageCopyRead(d, meta)
+}
+
+func resourceKsyunImageCopyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	destination, err := imageService.destination(d.Get("destination_region").(string))
+	if err != nil {
+		return err
+	}
+	err = destination.RemoveImage(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting image %q, %s", d.Id(), err)
+	}
+	return err
+}
@@ -0,0 +1,127 @@
+/*
+Provides an image import resource, which imports an image file in KS3 as a custom image.
+
+# Example Usage
+
+```hcl
+resource "ksyun_image_import" "foo" {
+  image_name   = "tf-imported-image"
+  image_url    = "https://tf-images.ks3-cn-beijing.ksyuncs.com/centos-7.9.qcow2"
+  image_format = "qcow2"
+  architecture = "x86_64"
+  platform     = "centos-7.9"
+}
+```
+
+# Import
+
+Imported image can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_image_import.foo IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunImageImport() *schema.Resource {
+	s := kecImageComputedSchema()
+	s["image_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the image.",
+	}
+	s["image_url"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The url of the image file in KS3.",
+	}
+	s["image_format"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"qcow2",
+			"vmdk",
+			"vhd",
+			"raw",
+		}, false),
+		Description: "The format of the image file. Valid values: qcow2, vmdk, vhd, raw.",
+	}
+	s["architecture"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Default:  "x86_64",
+		ValidateFunc: validation.StringInSlice([]string{
+			"x86_64",
+			"i386",
+		}, false),
+		Description: "The architecture of the image. Valid values: x86_64, i386.",
+	}
+	s["platform"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The platform of the image, such as centos-7.9.",
+	}
+	return &schema.Resource{
+		Create: resourceKsyunImageImportCreate,
+		Read:   resourceKsyunImageImportRead,
+		Update: resourceKsyunImageImportUpdate,
+		Delete: resourceKsyunImageImportDelete,
+		Importer: &schema.ResourceImporter{
+			State: importImage,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: s,
+	}
+}
+
+func resourceKsyunImageImportCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ImportImage(d, resourceKsyunImageImport())
+	if err != nil {
+		return fmt.Errorf("error on importing image %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageImportRead(d, meta)
+}
+
+func resourceKsyunImageImportRead(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ReadAndSetImage(d, resourceKsyunImageImport())
+	if err != nil {
+		return fmt.Errorf("error on reading image %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunImageImportUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ModifyImage(d)
+	if err != nil {
+		return fmt.Errorf("error on updating image %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageImportRead(d, meta)
+}
+
+func resourceKsyunImageImportDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.RemoveImage(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting image %q, %s", d.Id(), err)
+	}
+	return err
+}
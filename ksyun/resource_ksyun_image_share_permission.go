@@ -0,0 +1,93 @@
+/*
+Provides an image share permission resource, which shares a custom image with other accounts.
+
+# Example Usage
+
+```hcl
+resource "ksyun_image_share_permission" "foo" {
+  image_id    = "IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx"
+  account_ids = ["2000000001", "2000000002"]
+}
+```
+
+# Import
+
+Image share permission can be imported using the id of the image, e.g.
+
+```
+$ terraform import ksyun_image_share_permission.foo IMG-5465174a-6d71-4770-b8e1-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunImageSharePermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunImageSharePermissionCreate,
+		Read:   resourceKsyunImageSharePermissionRead,
+		Update: resourceKsyunImageSharePermissionUpdate,
+		Delete: resourceKsyunImageSharePermissionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the image to share.",
+			},
+			"account_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IDs of the accounts to share the image with.",
+			},
+		},
+	}
+}
+
+func resourceKsyunImageSharePermissionCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ModifyImageSharePermission(d)
+	if err != nil {
+		return fmt.Errorf("error on sharing image %q, %s", d.Get("image_id"), err)
+	}
+	d.SetId(d.Get("image_id").(string))
+	return resourceKsyunImageSharePermissionRead(d, meta)
+}
+
+func resourceKsyunImageSharePermissionRead(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ReadAndSetImageSharePermission(d)
+	if err != nil {
+		return fmt.Errorf("error on reading image share permission %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunImageSharePermissionUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.ModifyImageSharePermission(d)
+	if err != nil {
+		return fmt.Errorf("error on updating image share permission %q, %s", d.Id(), err)
+	}
+	return resourceKsyunImageSharePermissionRead(d, meta)
+}
+
+func resourceKsyunImageSharePermissionDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	imageService := ImageService{meta.(*KsyunClient)}
+	err = imageService.RemoveImageSharePermission(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting image share permission %q, %s", d.Id(), err)
+	}
+	return err
+}
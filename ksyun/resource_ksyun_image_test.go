@@ -0,0 +1,286 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccImageConfig = `
+resource "ksyun_image" "foo" {
+  image_name  = "tf-acc-image"
+  snapshot_id = "S-0b1c6e5a-2d4f-4a8e-9f3b-xxxxxxxxxxxx"
+}
+
+resource "ksyun_image_share_permission" "foo" {
+  image_id    = ksyun_image.foo.id
+  account_ids = ["2000000001"]
+}
+`
+
+func TestAccKsyunImage_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_image.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists("ksyun_image.foo"),
+					resource.TestCheckResourceAttr("ksyun_image.foo", "image_state", "active"),
+					resource.TestCheckResourceAttr("ksyun_image_share_permission.foo", "account_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunImage_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitImageConfig("tf-unit-image"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists("ksyun_image.foo"),
+					resource.TestCheckResourceAttr("ksyun_image.foo", "image_state", "active"),
+					resource.TestCheckResourceAttr("ksyun_image.foo", "image_source", "snapshot"),
+					resource.TestCheckResourceAttrPair("ksyun_image.foo", "image_id", "ksyun_image.foo", "id"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitImageConfig("tf-unit-image-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_image.foo", "image_name", "tf-unit-image-renamed"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testUnitImageConfig("tf-unit-image-renamed"),
+				ResourceName:            "ksyun_image.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"snapshot_id"},
+			},
+		},
+	})
+}
+
+func testUnitImageConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ksyun_image" "foo" {
+  image_name  = "%s"
+  snapshot_id = "S-00000000-0000-0000-0000-000000000001"
+}
+`, name)
+}
+
+func TestUnitKsyunImageCopy_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + testUnitImageConfig("tf-unit-image") + `
+resource "ksyun_image_copy" "foo" {
+  source_image_id    = ksyun_image.foo.id
+  destination_region = "cn-shanghai-2"
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists("ksyun_image_copy.foo"),
+					resource.TestCheckResourceAttr("ksyun_image_copy.foo", "image_state", "active"),
+					resource.TestCheckResourceAttr("ksyun_image_copy.foo", "image_name", "tf-unit-image"),
+					resource.TestCheckResourceAttr("ksyun_image_copy.foo", "image_source", "copy"),
+				),
+			},
+			{
+				Config:            config,
+				ResourceName:      "ksyun_image_copy.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImageCopyImportStateId("ksyun_image_copy.foo"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitKsyunImageImport_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + `
+resource "ksyun_image_import" "foo" {
+  image_name   = "tf-unit-imported"
+  image_url    = "https://tf-unit.ks3-cn-beijing.ksyuncs.com/centos-7.9.qcow2"
+  image_format = "qcow2"
+  platform     = "centos-7.9"
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists("ksyun_image_import.foo"),
+					resource.TestCheckResourceAttr("ksyun_image_import.foo", "image_state", "active"),
+					resource.TestCheckResourceAttr("ksyun_image_import.foo", "image_source", "import"),
+				),
+			},
+			{
+				Config:                  config,
+				ResourceName:            "ksyun_image_import.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_url", "image_format"},
+			},
+		},
+	})
+}
+
+func TestUnitKsyunImageSharePermission_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitImageSharePermissionConfig(`"2000000001", "2000000002"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ksyun_image_share_permission.foo", "id", "ksyun_image.foo", "id"),
+					resource.TestCheckResourceAttr("ksyun_image_share_permission.foo", "account_ids.#", "2"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitImageSharePermissionConfig(`"2000000002", "2000000003"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_image_share_permission.foo", "account_ids.#", "2"),
+					testAccCheckImageSharedAccounts("ksyun_image.foo", "2000000002", "2000000003"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testUnitImageSharePermissionConfig(`"2000000002", "2000000003"`),
+				ResourceName:      "ksyun_image_share_permission.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testUnitImageSharePermissionConfig(accounts string) string {
+	return testUnitImageConfig("tf-unit-image") + fmt.Sprintf(`
+resource "ksyun_image_share_permission" "foo" {
+  image_id    = ksyun_image.foo.id
+  account_ids = [%s]
+}
+`, accounts)
+}
+
+func TestImportImageCopy(t *testing.T) {
+	d := resourceKsyunImageCopy().TestResourceData()
+	d.SetId("cn-shanghai-2:IMG-copied:IMG-source")
+	result, err := importImageCopy(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d = result[0]
+	if d.Id() != "cn-shanghai-2:IMG-copied" {
+		t.Errorf("expected id cn-shanghai-2:IMG-copied, got %s", d.Id())
+	}
+	for k, v := range map[string]string{
+		"destination_region": "cn-shanghai-2",
+		"image_id":           "IMG-copied",
+		"source_image_id":    "IMG-source",
+	} {
+		if d.Get(k) != v {
+			t.Errorf("expected %s to be %s, got %v", k, v, d.Get(k))
+		}
+	}
+
+	d.SetId("cn-shanghai-2:IMG-copied")
+	if _, err = importImageCopy(d, nil); err == nil {
+		t.Error("expected an error for the id without the source image")
+	}
+}
+
+// testAccImageCopyImportStateId appends the source image to the id of the copied image
+func testAccImageCopyImportStateId(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return rs.Primary.ID + ":" + rs.Primary.Attributes["source_image_id"], nil
+	}
+}
+
+func testAccCheckImageExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("image id is empty")
+		}
+		imageService := ImageService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := imageService.readKecImage(rs.Primary.Attributes["image_id"])
+		return err
+	}
+}
+
+func testAccCheckImageSharedAccounts(n string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		imageService := ImageService{testAccProvider.Meta().(*KsyunClient)}
+		accounts, err := imageService.readImageSharedAccounts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if fmt.Sprint(accounts) != fmt.Sprint(expected) {
+			return fmt.Errorf("the image is shared with %v, expected %v", accounts, expected)
+		}
+		return nil
+	}
+}
+
+func testAccCheckImageDestroy(s *terraform.State) error {
+	imageService := ImageService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "ksyun_image", "ksyun_image_copy", "ksyun_image_import":
+		default:
+			continue
+		}
+		_, err := imageService.readKecImage(rs.Primary.Attributes["image_id"])
+		if err == nil {
+			return fmt.Errorf("image %s still exists", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -70,8 +70,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func instanceConfig() map[string]*schema.Schema {
@@ -419,7 +419,7 @@ func instanceConfig() map[string]*schema.Schema {
 }
 
 func resourceKsyunInstance() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunInstanceCreate,
 		Update: resourceKsyunInstanceUpdate,
 		Read:   resourceKsyunInstanceRead,
@@ -433,7 +433,7 @@ func resourceKsyunInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: instanceConfig(),
-	}
+	})
 }
 
 func resourceKsyunInstanceCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -4,8 +4,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunInstance_basic(t *testing.T) {
@@ -14,9 +15,9 @@ func TestAccKsyunInstance_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_instance.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckInstanceDestroy,
+		IDRefreshName:     "ksyun_instance.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccInstanceConfig,
@@ -35,9 +36,9 @@ func TestAccKsyunInstance_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_instance.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckInstanceDestroy,
+		IDRefreshName:     "ksyun_instance.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -74,6 +75,36 @@ func TestAccKsyunInstance_update(t *testing.T) {
 	})
 }
 
+func TestUnitKsyunInstance_basic(t *testing.T) {
+	var val map[string]interface{}
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitInstanceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ksyun_instance.foo", &val),
+					testAccCheckInstanceAttributes(&val),
+					resource.TestCheckResourceAttr("ksyun_instance.foo", "instance_status", "active"),
+					resource.TestCheckResourceAttr("ksyun_instance.foo", "private_ip_address", "10.7.0.2"),
+					resource.TestCheckResourceAttr("ksyun_instance.foo", "tags.env", "unit"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitInstanceUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ksyun_instance.foo", &val),
+					resource.TestCheckResourceAttr("ksyun_instance.foo", "instance_name", "tf-unit-instance-update"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceExists(n string, val *map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -141,6 +172,64 @@ func testAccCheckInstanceDestroy(s *terraform.State) error {
 	return nil
 }
 
+const testUnitInstanceConfig = `
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-unit-vpc"
+  cidr_block = "10.7.0.0/21"
+}
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-unit-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Normal"
+  vpc_id            = "${ksyun_vpc.default.id}"
+  gateway_ip        = "10.7.0.1"
+  availability_zone = "cn-beijing-6a"
+}
+resource "ksyun_instance" "foo" {
+  image_id          = "IMG-5465174a-6d71-4770-b8e1-917a0dd92466"
+  instance_type     = "N3.2B"
+  subnet_id         = "${ksyun_subnet.default.id}"
+  instance_password = "Xuan663222"
+  keep_image_login  = false
+  charge_type       = "Daily"
+  security_group_id = ["00000000-0000-0000-0000-00000000ffff"]
+  instance_name     = "tf-unit-instance"
+  sriov_net_support = "false"
+  tags = {
+    env = "unit"
+  }
+}
+`
+
+const testUnitInstanceUpdateConfig = `
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-unit-vpc"
+  cidr_block = "10.7.0.0/21"
+}
+resource "ksyun_subnet" "default" {
+  subnet_name       = "tf-unit-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Normal"
+  vpc_id            = "${ksyun_vpc.default.id}"
+  gateway_ip        = "10.7.0.1"
+  availability_zone = "cn-beijing-6a"
+}
+resource "ksyun_instance" "foo" {
+  image_id          = "IMG-5465174a-6d71-4770-b8e1-917a0dd92466"
+  instance_type     = "N3.2B"
+  subnet_id         = "${ksyun_subnet.default.id}"
+  instance_password = "Xuan663222"
+  keep_image_login  = false
+  charge_type       = "Daily"
+  security_group_id = ["00000000-0000-0000-0000-00000000ffff"]
+  instance_name     = "tf-unit-instance-update"
+  sriov_net_support = "false"
+  tags = {
+    env = "unit"
+  }
+}
+`
+
 const testAccInstanceConfig = `
 provider "ksyun" {
 	region =  "cn-beijing-6"
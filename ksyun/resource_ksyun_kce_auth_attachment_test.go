@@ -3,8 +3,8 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const testAccKceAuthAttachment = `
@@ -25,9 +25,9 @@ func TestAccKsyunKceAuthAttachment_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kce_auth_attachment.auth",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKceAuthAttachmentDestroy,
+		IDRefreshName:     "ksyun_kce_auth_attachment.auth",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKceAuthAttachmentDestroy,
 		Steps: []resource.TestStep{
 			{
 				// PlanOnly: true,
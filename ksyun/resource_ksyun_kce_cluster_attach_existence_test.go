@@ -3,8 +3,8 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const testAccKceWorkerConfig = `
@@ -45,9 +45,9 @@ func TestAccKsyunKceWorker_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kce_cluster_attach_existence.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKceWorkerDestroy,
+		IDRefreshName:     "ksyun_kce_cluster_attach_existence.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKceWorkerDestroy,
 		Steps: []resource.TestStep{
 			{
 				// PlanOnly: true,
@@ -3,8 +3,8 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const testAccKceClusterAttachmentConfigWithNewInstance = `
@@ -47,9 +47,9 @@ func TestAccKsyunKceClusterAttachment_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kce_cluster_attachment.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKceClusterAttachmentDestroy,
+		IDRefreshName:     "ksyun_kce_cluster_attachment.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKceClusterAttachmentDestroy,
 		Steps: []resource.TestStep{
 			{
 				// PlanOnly: true,
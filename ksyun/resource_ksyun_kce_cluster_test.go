@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/structor/v1/kce"
 )
 
@@ -111,8 +111,8 @@ func TestAccKsyunKceCluster_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kce_cluster.default",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_kce_cluster.default",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckKceWorkerDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -140,8 +140,8 @@ func TestAccKsyunKceVKKci_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kce_cluster.default",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_kce_cluster.default",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckKceWorkerDestroy,
 		Steps: []resource.TestStep{
 			{
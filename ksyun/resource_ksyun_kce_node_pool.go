@@ -0,0 +1,361 @@
+/*
+Provides a KCE node pool resource, which creates the worker nodes of a cluster from a node template and scales them between min_size and max_size.
+
+~> **NOTE:** The changes of `node_template` and `advanced_setting` are applied to the node template in place,
+they take effect on the nodes scaled out afterwards. The existing nodes are kept as they are unless `rolling_update` is set,
+then they are drained and replaced by the nodes of the new template, `max_unavailable` nodes at a time.
+
+# Example Usage
+
+```hcl
+data "ksyun_kce_instance_images" "test" {
+}
+
+resource "ksyun_kce_node_pool" "foo" {
+  cluster_id        = "67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx"
+  node_pool_name    = "tf-node-pool"
+  enable_auto_scale = true
+  min_size          = 1
+  max_size          = 10
+  desired_capacity  = 2
+
+  node_template {
+    image_id          = data.ksyun_kce_instance_images.test.image_set.0.image_id
+    instance_type     = "S6.2A"
+    subnet_id         = ["subnet-xxxxxx"]
+    security_group_id = "sg-xxxxxx"
+    charge_type       = "HourlyInstantSettlement"
+    system_disk {
+      disk_size = 20
+      disk_type = "SSD3.0"
+    }
+    data_disk {
+      disk_size = 100
+      disk_type = "SSD3.0"
+    }
+  }
+
+  advanced_setting {
+    container_runtime = "containerd"
+    label {
+      key   = "pool"
+      value = "tf-node-pool"
+    }
+    taints {
+      key    = "dedicated"
+      value  = "tf"
+      effect = "NoSchedule"
+    }
+  }
+
+  rolling_update {
+    max_unavailable = 1
+  }
+}
+```
+
+# Import
+
+KCE node pool can be imported using the cluster id and the node pool id, e.g.
+
+```
+$ terraform import ksyun_kce_node_pool.foo 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx:2a7e4b1c-2f5d-4b8e-9bcb-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func kceNodeTemplate() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"instance_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The type of the instances.",
+		},
+		"image_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The ID of the image.",
+		},
+		"subnet_id": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The IDs of the subnets that the instances are created in.",
+		},
+		"security_group_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The ID of the security group.",
+		},
+		"charge_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "HourlyInstantSettlement",
+			ValidateFunc: validation.StringInSlice([]string{
+				"HourlyInstantSettlement",
+				"Daily",
+			}, false),
+			Description: "The charge type of the instances. Valid values: HourlyInstantSettlement, Daily.",
+		},
+		"system_disk": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "The system disk of the instances.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disk_type": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "The type of the system disk.",
+					},
+					"disk_size": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+						Description: "The size of the system disk, in GB.",
+					},
+				},
+			},
+		},
+		"data_disk": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The data disks of the instances.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disk_type": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The type of the data disk.",
+					},
+					"disk_size": {
+						Type:        schema.TypeInt,
+						Required:    true,
+						Description: "The size of the data disk, in GB.",
+					},
+				},
+			},
+		},
+		"key_id": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Description: "The IDs of the certificates to log in the instances.",
+		},
+		"instance_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The password of the instances.",
+		},
+	}
+}
+
+// nodePoolAdvancedSetting is the advanced setting of the node template, the fields are
+// updatable because a template change only applies to the nodes scaled out afterwards.
+func nodePoolAdvancedSetting() map[string]*schema.Schema {
+	m := nodeAdvancedSetting()
+	unsetForceNew(m)
+	return m
+}
+
+func unsetForceNew(m map[string]*schema.Schema) {
+	for _, v := range m {
+		v.ForceNew = false
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			unsetForceNew(elem.Schema)
+		case *schema.Schema:
+			elem.ForceNew = false
+		}
+	}
+}
+
+func resourceKsyunKceNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKceNodePoolCreate,
+		Read:   resourceKsyunKceNodePoolRead,
+		Update: resourceKsyunKceNodePoolUpdate,
+		Delete: resourceKsyunKceNodePoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: kceNodePoolSizeCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the kce cluster.",
+			},
+			"node_pool_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the node pool.",
+			},
+			"enable_auto_scale": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to scale the node pool automatically between min_size and max_size.",
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The minimum number of nodes in the node pool.",
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of nodes in the node pool.",
+			},
+			"desired_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// the autoscaler owns the capacity once the pool is created
+					return d.Id() != "" && d.Get("enable_auto_scale").(bool)
+				},
+				Description: "The desired number of nodes in the node pool. " +
+					"It is ignored after the node pool is created if enable_auto_scale is true, since the capacity is managed by the autoscaler.",
+			},
+			"node_template": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The template of the nodes in the node pool.",
+				Elem: &schema.Resource{
+					Schema: kceNodeTemplate(),
+				},
+			},
+			"advanced_setting": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The advanced settings of the nodes in the node pool, including the labels and taints.",
+				Elem: &schema.Resource{
+					Schema: nodePoolAdvancedSetting(),
+				},
+			},
+			"rolling_update": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replaces the existing nodes by the nodes of the new template when node_template or advanced_setting changes. Without it the changes only apply to the nodes scaled out afterwards.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of nodes that are drained and replaced at a time. The next batch starts after the new nodes of the previous one are running.",
+						},
+					},
+				},
+			},
+			"instance_delete_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Terminate",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Terminate",
+					"Remove",
+				}, false),
+				Description: "The delete mode of the nodes when the node pool is deleted. The value can be 'Terminate' or 'Remove'.",
+			},
+			"node_pool_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the node pool.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the node pool.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the node pool.",
+			},
+		},
+	}
+}
+
+func kceNodePoolSizeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	minSize := d.Get("min_size").(int)
+	maxSize := d.Get("max_size").(int)
+	if maxSize < minSize {
+		return fmt.Errorf("max_size %d must not be less than min_size %d", maxSize, minSize)
+	}
+	if desired, ok := d.GetOk("desired_capacity"); ok && d.Get("enable_auto_scale").(bool) {
+		if desired.(int) < minSize || desired.(int) > maxSize {
+			return fmt.Errorf("desired_capacity %d must be between min_size %d and max_size %d", desired, minSize, maxSize)
+		}
+	}
+	return nil
+}
+
+func resourceKsyunKceNodePoolCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	srv := KceService{meta.(*KsyunClient)}
+	err = srv.CreateNodePool(d, resourceKsyunKceNodePool())
+	if err != nil {
+		return fmt.Errorf("error on creating kce node pool: %s", err)
+	}
+	return resourceKsyunKceNodePoolRead(d, meta)
+}
+
+func resourceKsyunKceNodePoolRead(d *schema.ResourceData, meta interface{}) (err error) {
+	srv := KceService{meta.(*KsyunClient)}
+	err = srv.ReadAndSetNodePool(d, resourceKsyunKceNodePool())
+	if err != nil {
+		return fmt.Errorf("error on reading kce node pool %q: %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunKceNodePoolUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	srv := KceService{meta.(*KsyunClient)}
+	err = srv.ModifyNodePool(d, resourceKsyunKceNodePool())
+	if err != nil {
+		return fmt.Errorf("error on updating kce node pool %q: %s", d.Id(), err)
+	}
+	return resourceKsyunKceNodePoolRead(d, meta)
+}
+
+func resourceKsyunKceNodePoolDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	srv := KceService{meta.(*KsyunClient)}
+	err = srv.DeleteNodePool(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting kce node pool %q: %s", d.Id(), err)
+	}
+	return
+}
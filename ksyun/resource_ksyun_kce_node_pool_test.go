@@ -0,0 +1,362 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccKceNodePoolConfig = `
+resource "ksyun_kce_node_pool" "foo" {
+  cluster_id        = "dec547af-a10d-4f21-82b4-89ff5642c55a"
+  node_pool_name    = "tf-acc-node-pool"
+  enable_auto_scale = true
+  min_size          = 0
+  max_size          = 3
+  desired_capacity  = 1
+
+  node_template {
+    image_id          = "fbafd8cd-b570-47c4-a3db-ff9702108f17"
+    instance_type     = "S6.4B"
+    subnet_id         = ["c771027a-fafd-4b3b-a6b9-daeab9d0c13a"]
+    security_group_id = "59a87036-dc27-41cf-98ab-24a387501195"
+    system_disk {
+      disk_size = 20
+      disk_type = "SSD3.0"
+    }
+  }
+
+  advanced_setting {
+    container_runtime = "containerd"
+    label {
+      key   = "tf_assembly_kce"
+      value = "node_pool"
+    }
+    taints {
+      key    = "key1"
+      value  = "value1"
+      effect = "NoSchedule"
+    }
+  }
+}
+`
+
+func TestAccKsyunKceNodePool_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_kce_node_pool.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKceNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKceNodePoolConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKceNodePoolExists("ksyun_kce_node_pool.foo"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "max_size", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKceNodePool_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKceNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKceNodePoolConfig("S6.2A", 3, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKceNodePoolExists("ksyun_kce_node_pool.foo"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "status", "Active"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "desired_capacity", "1"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "node_template.0.instance_type", "S6.2A"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "advanced_setting.0.label.0.value", "v1"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "advanced_setting.0.taints.0.effect", "NoSchedule"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "advanced_setting.0.container_log_max_files", "5"),
+					resource.TestCheckResourceAttr("data.ksyun_kce_node_pools.foo", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_kce_node_pools.foo", "node_pools.0.node_pool_name", "tf-unit-node-pool"),
+					resource.TestCheckResourceAttr("data.ksyun_kce_node_pools.foo", "node_pools.0.node_template.0.data_disk.0.disk_size", "50"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKceNodePoolConfig("S6.4B", 5, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKceNodePoolExists("ksyun_kce_node_pool.foo"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "max_size", "5"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "node_template.0.instance_type", "S6.4B"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "advanced_setting.0.label.0.value", "v2"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testUnitKceNodePoolConfig("S6.4B", 5, "v2"),
+				ResourceName:            "ksyun_kce_node_pool.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_delete_mode"},
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKceNodePool_rollingUpdate(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	rollingUpdate := `
+  rolling_update {
+    max_unavailable = 2
+  }
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKceNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKceNodePoolRollingUpdateConfig("S6.2A", rollingUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKceNodePoolExists("ksyun_kce_node_pool.foo"),
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "rolling_update.0.max_unavailable", "2"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKceNodePoolRollingUpdateConfig("S6.4B", rollingUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "node_template.0.instance_type", "S6.4B"),
+					testAccCheckKceNodePoolInstanceType("ksyun_kce_node_pool.foo", "S6.4B", 3),
+				),
+			},
+			{
+				// without rolling_update the existing nodes are kept
+				Config: testMockApiProviderConfig(server) + testUnitKceNodePoolRollingUpdateConfig("S6.8C", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kce_node_pool.foo", "node_template.0.instance_type", "S6.8C"),
+					testAccCheckKceNodePoolInstanceType("ksyun_kce_node_pool.foo", "S6.4B", 3),
+				),
+			},
+		},
+	})
+}
+
+// TestKceServiceRollingUpdateNodePool replaces the nodes against the mock api without the terraform binary
+func TestKceServiceRollingUpdateNodePool(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	client, err := (&Config{
+		AccessKey:     "mock-ak",
+		SecretKey:     "mock-sk",
+		Region:        "cn-beijing-6",
+		Domain:        server.Domain(),
+		IgnoreService: true,
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := KceService{client}
+
+	r := resourceKsyunKceNodePool()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cluster_id":       "00000000-0000-0000-0000-0000000000ce",
+		"node_pool_name":   "tf-unit-node-pool",
+		"min_size":         1,
+		"max_size":         3,
+		"desired_capacity": 3,
+		"node_template": []interface{}{
+			map[string]interface{}{
+				"image_id":          "IMG-5465174a-6d71-4770-b8e1-917a0dd92466",
+				"instance_type":     "S6.2A",
+				"subnet_id":         []interface{}{"00000000-0000-0000-0000-00000000aaaa"},
+				"security_group_id": "00000000-0000-0000-0000-00000000ffff",
+			},
+		},
+	})
+	if err = srv.CreateNodePool(d, r); err != nil {
+		t.Fatal(err)
+	}
+	if err = srv.ReadAndSetNodePool(d, r); err != nil {
+		t.Fatal(err)
+	}
+	clusterId, nodePoolId := d.Get("cluster_id").(string), d.Get("node_pool_id").(string)
+	oldIds, err := srv.readKceNodePoolInstanceIds(clusterId, nodePoolId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(oldIds) != 3 {
+		t.Fatalf("expected 3 nodes, got %v", oldIds)
+	}
+
+	if err = srv.rollingUpdateNodePool(d, oldIds, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	nodes, err := srv.readKceNodePoolInstances(clusterId, nodePoolId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 3 {
+		t.Fatalf("expected 3 nodes after the rolling update, got %d", len(nodes))
+	}
+	for _, node := range nodes {
+		if stringSliceContains(oldIds, node["InstanceId"].(string)) {
+			t.Errorf("node %s is not replaced", node["InstanceId"])
+		}
+		if node["InstanceStatus"] != "normal" {
+			t.Errorf("node %s is %s", node["InstanceId"], node["InstanceStatus"])
+		}
+	}
+	var batches [][]string
+	for _, req := range server.Requests() {
+		if req.Action == "DeleteClusterInstancesFromNodePool" {
+			batches = append(batches, req.Params.List("InstanceId"))
+		}
+	}
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Errorf("expected the nodes to be removed in batches of 2, got %v", batches)
+	}
+}
+
+func TestUnitKsyunKceNodePool_invalidSize(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testMockApiProviderConfig(server) + testUnitKceNodePoolConfig("S6.2A", 0, "v1"),
+				ExpectError: regexp.MustCompile("max_size 0 must not be less than min_size 1"),
+			},
+		},
+	})
+}
+
+func testUnitKceNodePoolConfig(instanceType string, maxSize int, label string) string {
+	return fmt.Sprintf(`
+resource "ksyun_kce_node_pool" "foo" {
+  cluster_id        = "00000000-0000-0000-0000-0000000000ce"
+  node_pool_name    = "tf-unit-node-pool"
+  enable_auto_scale = true
+  min_size          = 1
+  max_size          = %d
+  desired_capacity  = 1
+
+  node_template {
+    image_id          = "IMG-5465174a-6d71-4770-b8e1-917a0dd92466"
+    instance_type     = "%s"
+    subnet_id         = ["00000000-0000-0000-0000-00000000aaaa"]
+    security_group_id = "00000000-0000-0000-0000-00000000ffff"
+    data_disk {
+      disk_type = "SSD3.0"
+      disk_size = 50
+    }
+  }
+
+  advanced_setting {
+    container_runtime       = "containerd"
+    container_log_max_files = 5
+    label {
+      key   = "pool"
+      value = "%s"
+    }
+    taints {
+      key    = "dedicated"
+      value  = "unit"
+      effect = "NoSchedule"
+    }
+  }
+}
+
+data "ksyun_kce_node_pools" "foo" {
+  cluster_id = ksyun_kce_node_pool.foo.cluster_id
+  ids        = [ksyun_kce_node_pool.foo.node_pool_id]
+}
+`, maxSize, instanceType, label)
+}
+
+func testUnitKceNodePoolRollingUpdateConfig(instanceType, rollingUpdate string) string {
+	return fmt.Sprintf(`
+resource "ksyun_kce_node_pool" "foo" {
+  cluster_id       = "00000000-0000-0000-0000-0000000000ce"
+  node_pool_name   = "tf-unit-node-pool"
+  min_size         = 1
+  max_size         = 3
+  desired_capacity = 3
+
+  node_template {
+    image_id          = "IMG-5465174a-6d71-4770-b8e1-917a0dd92466"
+    instance_type     = "%s"
+    subnet_id         = ["00000000-0000-0000-0000-00000000aaaa"]
+    security_group_id = "00000000-0000-0000-0000-00000000ffff"
+  }
+%s
+}
+`, instanceType, rollingUpdate)
+}
+
+// testAccCheckKceNodePoolInstanceType checks the instance type of the running nodes of the node pool
+func testAccCheckKceNodePoolInstanceType(n, instanceType string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		srv := KceService{testAccProvider.Meta().(*KsyunClient)}
+		nodes, err := srv.readKceNodePoolInstances(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["node_pool_id"])
+		if err != nil {
+			return err
+		}
+		if len(nodes) != count {
+			return fmt.Errorf("expected %d nodes, got %d", count, len(nodes))
+		}
+		for _, node := range nodes {
+			if v, _ := getSdkValue("KecInstancePara.InstanceType", node); v != instanceType {
+				return fmt.Errorf("the instance type of node %s is %v, expected %s", node["InstanceId"], v, instanceType)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckKceNodePoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("node pool id is empty")
+		}
+		client := testAccProvider.Meta().(*KsyunClient)
+		srv := KceService{client}
+		_, err := srv.readKceNodePool(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["node_pool_id"])
+		return err
+	}
+}
+
+func testAccCheckKceNodePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*KsyunClient)
+	srv := KceService{client}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_kce_node_pool" {
+			continue
+		}
+		_, err := srv.readKceNodePool(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["node_pool_id"])
+		if err == nil {
+			return fmt.Errorf("node pool still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccKsyunKcrsNamespace_basic(t *testing.T) {
@@ -14,7 +14,7 @@ func TestAccKsyunKcrsNamespace_basic(t *testing.T) {
 
 		IDRefreshName: "ksyun_kcrs_namespace.foo",
 
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKsyunKcrsNamespaceConfig,
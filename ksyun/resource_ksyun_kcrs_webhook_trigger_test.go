@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccKsyunKcrsWebhookTrigger_basic(t *testing.T) {
@@ -14,7 +14,7 @@ func TestAccKsyunKcrsWebhookTrigger_basic(t *testing.T) {
 
 		IDRefreshName: "ksyun_kcrs_webhook_trigger.foo",
 
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKsyunKcrsWebhookTriggerConfig,
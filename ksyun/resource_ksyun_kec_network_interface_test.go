@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
-	_ "github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	_ "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunNetworkInterface_basic(t *testing.T) {
@@ -16,9 +16,9 @@ func TestAccKsyunNetworkInterface_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_kec_network_interface.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckNetworkInterfaceDestroy,
+		IDRefreshName:     "ksyun_kec_network_interface.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkInterfaceDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunCfwAcl_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_cfw_acl.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckCfwAclDestroy,
+		IDRefreshName:     "ksyun_cfw_acl.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCfwAclDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCfwAclConfig,
@@ -42,9 +42,9 @@ func TestAccKsyunCfwAcl_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_cfw_acl.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckCfwAclDestroy,
+		IDRefreshName:     "ksyun_cfw_acl.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCfwAclDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCfwAclConfig,
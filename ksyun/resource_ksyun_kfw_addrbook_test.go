@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunKfwAddrbook_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kfw_addrbook.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKfwAddrbookDestroy,
+		IDRefreshName:     "ksyun_kfw_addrbook.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKfwAddrbookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKfwAddrbookConfig,
@@ -37,9 +37,9 @@ func TestAccKsyunCfwAddrbook_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kfw_addrbook.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKfwAddrbookDestroy,
+		IDRefreshName:     "ksyun_kfw_addrbook.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKfwAddrbookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKfwAddrbookConfig,
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunKfwInstance_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_cfw_instance.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKfwInstanceDestroy,
+		IDRefreshName:     "ksyun_cfw_instance.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKfwInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKfwInstanceConfig,
@@ -39,9 +39,9 @@ func TestAccKsyunKfwInstance_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_cfw_instance.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKfwInstanceDestroy,
+		IDRefreshName:     "ksyun_cfw_instance.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKfwInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKfwInstanceConfig,
@@ -24,7 +24,7 @@ $ terraform import ksyun_kfw_service_group.default service_group_id
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunKfwServiceGroup() *schema.Resource {
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunKfwServiceGroup_basic(t *testing.T) {
@@ -14,9 +14,9 @@ func TestAccKsyunKfwServiceGroup_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kfw_service_group.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKfwServiceGroupDestroy,
+		IDRefreshName:     "ksyun_kfw_service_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKfwServiceGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKfwServiceGroupConfig,
@@ -37,9 +37,9 @@ func TestAccKsyunKfwServiceGroup_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_kfw_service_group.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKfwServiceGroupDestroy,
+		IDRefreshName:     "ksyun_kfw_service_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKfwServiceGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKfwServiceGroupConfig,
@@ -0,0 +1,182 @@
+/*
+Provides a KLog index resource, which manages the full-text index and the field indexes of a log pool.
+
+# Example Usage
+
+```hcl
+resource "ksyun_klog_project" "foo" {
+  project_name = "tf-klog-project"
+}
+
+resource "ksyun_klog_log_pool" "foo" {
+  project_name  = ksyun_klog_project.foo.project_name
+  log_pool_name = "tf-klog-log-pool"
+}
+
+resource "ksyun_klog_index" "foo" {
+  project_name  = ksyun_klog_log_pool.foo.project_name
+  log_pool_name = ksyun_klog_log_pool.foo.log_pool_name
+
+  full_text {
+    case_sensitive = false
+    delimiters     = ",;"
+  }
+
+  fields {
+    field_name = "level"
+    field_type = "text"
+  }
+
+  fields {
+    field_name = "latency"
+    field_type = "long"
+  }
+}
+```
+
+# Import
+
+KLog index can be imported using the `project_name:log_pool_name`, e.g.
+
+```
+$ terraform import ksyun_klog_index.foo tf-klog-project:tf-klog-log-pool
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunKlogIndex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKlogIndexCreate,
+		Read:   resourceKsyunKlogIndexRead,
+		Update: resourceKsyunKlogIndexUpdate,
+		Delete: resourceKsyunKlogIndexDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "project_name", "log_pool_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the project.",
+			},
+			"log_pool_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the log pool.",
+			},
+			"full_text": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"full_text", "fields"},
+				Description:  "The full-text index of the log pool.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"case_sensitive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the index is case sensitive.",
+						},
+						"include_chinese": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the Chinese words are tokenized.",
+						},
+						"delimiters": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The delimiters to tokenize the logs, each character is a delimiter.",
+						},
+					},
+				},
+			},
+			"fields": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"full_text", "fields"},
+				Description:  "The field indexes of the log pool.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the field.",
+						},
+						"field_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "text",
+							ValidateFunc: validation.StringInSlice([]string{"text", "long", "double", "json"}, false),
+							Description:  "The type of the field. Valid Values: 'text', 'long', 'double', 'json'. Default is 'text'.",
+						},
+						"case_sensitive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the index is case sensitive, it's only used by the `text` fields.",
+						},
+						"include_chinese": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the Chinese words are tokenized, it's only used by the `text` fields.",
+						},
+						"delimiters": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The delimiters to tokenize the field, it's only used by the `text` fields.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKsyunKlogIndexCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	klogIndexService := KlogIndexService{meta.(*KsyunClient)}
+	err = klogIndexService.CreateIndex(d)
+	if err != nil {
+		return fmt.Errorf("error on creating klog index of log pool %q, %s", d.Get("log_pool_name"), err)
+	}
+	return resourceKsyunKlogIndexRead(d, meta)
+}
+
+func resourceKsyunKlogIndexRead(d *schema.ResourceData, meta interface{}) (err error) {
+	klogIndexService := KlogIndexService{meta.(*KsyunClient)}
+	err = klogIndexService.ReadAndSetIndex(d)
+	if err != nil {
+		return fmt.Errorf("error on reading klog index %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunKlogIndexUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	klogIndexService := KlogIndexService{meta.(*KsyunClient)}
+	err = klogIndexService.ModifyIndex(d)
+	if err != nil {
+		return fmt.Errorf("error on updating klog index %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKlogIndexRead(d, meta)
+}
+
+func resourceKsyunKlogIndexDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	klogIndexService := KlogIndexService{meta.(*KsyunClient)}
+	err = klogIndexService.DeleteIndex(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting klog index %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,180 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testKlogIndexConfig = `
+resource "ksyun_klog_project" "foo" {
+  project_name = "tf-klog-project"
+}
+
+resource "ksyun_klog_log_pool" "foo" {
+  project_name  = ksyun_klog_project.foo.project_name
+  log_pool_name = "tf-klog-log-pool"
+}
+
+resource "ksyun_klog_index" "foo" {
+  project_name  = ksyun_klog_log_pool.foo.project_name
+  log_pool_name = ksyun_klog_log_pool.foo.log_pool_name
+
+  full_text {
+    delimiters = ",;"
+  }
+
+  fields {
+    field_name = "level"
+  }
+
+  fields {
+    field_name     = "message"
+    case_sensitive = true
+  }
+}
+`
+
+const testKlogIndexConfigUpdate = `
+resource "ksyun_klog_project" "foo" {
+  project_name = "tf-klog-project"
+}
+
+resource "ksyun_klog_log_pool" "foo" {
+  project_name  = ksyun_klog_project.foo.project_name
+  log_pool_name = "tf-klog-log-pool"
+}
+
+resource "ksyun_klog_index" "foo" {
+  project_name  = ksyun_klog_log_pool.foo.project_name
+  log_pool_name = ksyun_klog_log_pool.foo.log_pool_name
+
+  fields {
+    field_name = "level"
+  }
+
+  fields {
+    field_name = "latency"
+    field_type = "long"
+  }
+}
+`
+
+func TestAccKsyunKlogIndex_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_klog_index.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKlogIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKlogIndexConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKlogIndexExists("ksyun_klog_index.foo"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKlogIndex_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKlogIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testKlogIndexConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKlogIndexExists("ksyun_klog_index.foo"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "id", "tf-klog-project:tf-klog-log-pool"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "full_text.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "full_text.0.delimiters", ",;"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "full_text.0.case_sensitive", "false"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.0.field_name", "level"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.0.field_type", "text"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.1.case_sensitive", "true"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testKlogIndexConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "full_text.#", "0"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.1.field_name", "latency"),
+					resource.TestCheckResourceAttr("ksyun_klog_index.foo", "fields.1.field_type", "long"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testKlogIndexConfigUpdate,
+				ResourceName:      "ksyun_klog_index.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKlogIndex_empty(t *testing.T) {
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "ksyun_klog_index" "foo" {
+  project_name  = "tf-klog-project"
+  log_pool_name = "tf-klog-log-pool"
+}
+`,
+				ExpectError: regexp.MustCompile("one of `fields,full_text` must be specified"),
+			},
+		},
+	})
+}
+
+func testAccCheckKlogIndexExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		projectName, logPoolName, err := parseKlogLogPoolId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		klogIndexService := KlogIndexService{testAccProvider.Meta().(*KsyunClient)}
+		_, err = klogIndexService.ReadIndex(projectName, logPoolName)
+		return err
+	}
+}
+
+func testAccCheckKlogIndexDestroy(s *terraform.State) error {
+	klogIndexService := KlogIndexService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_klog_index" {
+			continue
+		}
+		projectName, logPoolName, err := parseKlogLogPoolId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = klogIndexService.ReadIndex(projectName, logPoolName)
+		if err == nil {
+			return fmt.Errorf("klog index still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Provides a KLog log pool resource.
+
+# Example Usage
+
+```hcl
+resource "ksyun_klog_project" "foo" {
+  project_name = "tf-klog-project"
+}
+
+resource "ksyun_klog_log_pool" "foo" {
+  project_name   = ksyun_klog_project.foo.project_name
+  log_pool_name  = "tf-klog-log-pool"
+  retention_days = 7
+  partitions     = 1
+  description    = "created by terraform"
+}
+```
+
+# Import
+
+KLog log pool can be imported using the `project_name:log_pool_name`, the `description` is not read back, e.g.
+
+```
+$ terraform import ksyun_klog_log_pool.foo tf-klog-project:tf-klog-log-pool
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunKlogLogPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKlogLogPoolCreate,
+		Read:   resourceKsyunKlogLogPoolRead,
+		Update: resourceKsyunKlogLogPoolUpdate,
+		Delete: resourceKsyunKlogLogPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "project_name", "log_pool_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the project which the log pool belongs to.",
+			},
+			"log_pool_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the log pool.",
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The days to keep the logs, the default value is determined by the api.",
+			},
+			"partitions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of the partitions of the log pool, the default value is determined by the api.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the log pool.",
+			},
+			"log_pool_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the log pool.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the log pool.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the log pool.",
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last update of the log pool.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKlogLogPoolCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	klogLogPoolService := KlogLogPoolService{meta.(*KsyunClient)}
+	err = klogLogPoolService.CreateLogPool(d)
+	if err != nil {
+		return fmt.Errorf("error on creating klog log pool %q, %s", d.Get("log_pool_name"), err)
+	}
+	return resourceKsyunKlogLogPoolRead(d, meta)
+}
+
+func resourceKsyunKlogLogPoolRead(d *schema.ResourceData, meta interface{}) (err error) {
+	klogLogPoolService := KlogLogPoolService{meta.(*KsyunClient)}
+	err = klogLogPoolService.ReadAndSetLogPool(d, resourceKsyunKlogLogPool())
+	if err != nil {
+		return fmt.Errorf("error on reading klog log pool %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunKlogLogPoolUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	klogLogPoolService := KlogLogPoolService{meta.(*KsyunClient)}
+	err = klogLogPoolService.ModifyLogPool(d)
+	if err != nil {
+		return fmt.Errorf("error on updating klog log pool %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKlogLogPoolRead(d, meta)
+}
+
+func resourceKsyunKlogLogPoolDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	klogLogPoolService := KlogLogPoolService{meta.(*KsyunClient)}
+	err = klogLogPoolService.DeleteLogPool(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting klog log pool %q, %s", d.Id(), err)
+	}
+	return
+}
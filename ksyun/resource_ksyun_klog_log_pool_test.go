@@ -0,0 +1,145 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunKlogLogPool_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_klog_log_pool.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKlogLogPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKlogLogPoolConfig("tf-acc-klog-project", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKlogLogPoolExists("ksyun_klog_log_pool.foo"),
+					resource.TestCheckResourceAttr("ksyun_klog_log_pool.foo", "retention_days", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKlogLogPool_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKlogLogPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testKlogLogPoolConfig("tf-unit-klog-project", 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKlogLogPoolExists("ksyun_klog_log_pool.foo"),
+					resource.TestCheckResourceAttr("ksyun_klog_log_pool.foo", "id", "tf-unit-klog-project:tf-klog-log-pool"),
+					resource.TestCheckResourceAttr("ksyun_klog_log_pool.foo", "retention_days", "7"),
+					resource.TestCheckResourceAttr("ksyun_klog_log_pool.foo", "partitions", "2"),
+					resource.TestCheckResourceAttrSet("ksyun_klog_log_pool.foo", "log_pool_id"),
+					resource.TestCheckResourceAttr("ksyun_klog_log_pool.bar", "retention_days", "7"),
+					resource.TestCheckResourceAttr("ksyun_klog_log_pool.bar", "partitions", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_log_pools.foo", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_log_pools.foo", "log_pools.0.log_pool_name", "tf-klog-log-pool"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_log_pools.foo", "log_pools.0.project_name", "tf-unit-klog-project"),
+					resource.TestCheckResourceAttrPair("data.ksyun_klog_log_pools.foo", "log_pools.0.id",
+						"ksyun_klog_log_pool.foo", "log_pool_id"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_log_pools.regex", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_log_pools.regex", "log_pools.0.log_pool_name", "tf-klog-log-pool-bar"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testKlogLogPoolConfig("tf-unit-klog-project", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_klog_log_pool.foo", "retention_days", "30"),
+					resource.TestCheckResourceAttr("data.ksyun_klog_log_pools.foo", "log_pools.0.retention_days", "30"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testKlogLogPoolConfig("tf-unit-klog-project", 30),
+				ResourceName:            "ksyun_klog_log_pool.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description"},
+			},
+		},
+	})
+}
+
+func testKlogLogPoolConfig(projectName string, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "ksyun_klog_project" "foo" {
+  project_name = "%s"
+}
+
+resource "ksyun_klog_log_pool" "foo" {
+  project_name   = ksyun_klog_project.foo.project_name
+  log_pool_name  = "tf-klog-log-pool"
+  retention_days = %d
+  partitions     = 2
+  description    = "created by terraform"
+}
+
+resource "ksyun_klog_log_pool" "bar" {
+  project_name  = ksyun_klog_project.foo.project_name
+  log_pool_name = "tf-klog-log-pool-bar"
+  depends_on    = [ksyun_klog_log_pool.foo]
+}
+
+# the log pool bar is created after foo
+data "ksyun_klog_log_pools" "foo" {
+  project_name  = ksyun_klog_log_pool.bar.project_name
+  log_pool_name = ksyun_klog_log_pool.foo.log_pool_name
+}
+
+data "ksyun_klog_log_pools" "regex" {
+  project_name = ksyun_klog_log_pool.bar.project_name
+  name_regex   = "-bar$"
+}
+`, projectName, retentionDays)
+}
+
+func testAccCheckKlogLogPoolExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		projectName, logPoolName, err := parseKlogLogPoolId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		klogLogPoolService := KlogLogPoolService{testAccProvider.Meta().(*KsyunClient)}
+		_, err = klogLogPoolService.ReadLogPool(projectName, logPoolName)
+		return err
+	}
+}
+
+func testAccCheckKlogLogPoolDestroy(s *terraform.State) error {
+	klogLogPoolService := KlogLogPoolService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_klog_log_pool" {
+			continue
+		}
+		projectName, logPoolName, err := parseKlogLogPoolId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = klogLogPoolService.ReadLogPool(projectName, logPoolName)
+		if err == nil {
+			return fmt.Errorf("klog log pool still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
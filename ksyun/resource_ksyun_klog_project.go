@@ -0,0 +1,120 @@
+/*
+Provides a KLog project resource.
+
+# Example Usage
+
+```hcl
+resource "ksyun_klog_project" "foo" {
+  project_name = "tf-klog-project"
+  description  = "created by terraform"
+}
+```
+
+# Import
+
+KLog project can be imported using the `project_name`, the `description` is not read back, e.g.
+
+```
+$ terraform import ksyun_klog_project.foo tf-klog-project
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunKlogProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKlogProjectCreate,
+		Read:   resourceKsyunKlogProjectRead,
+		Update: resourceKsyunKlogProjectUpdate,
+		Delete: resourceKsyunKlogProjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(1, "project_name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the project.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the project.",
+			},
+			"iam_project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the IAM project which the project belongs to.",
+			},
+			"iam_project_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the IAM project which the project belongs to.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the project.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the project.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the project.",
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last update of the project.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKlogProjectCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	klogProjectService := KlogProjectService{meta.(*KsyunClient)}
+	err = klogProjectService.CreateProject(d)
+	if err != nil {
+		return fmt.Errorf("error on creating klog project %q, %s", d.Get("project_name"), err)
+	}
+	return resourceKsyunKlogProjectRead(d, meta)
+}
+
+func resourceKsyunKlogProjectRead(d *schema.ResourceData, meta interface{}) (err error) {
+	klogProjectService := KlogProjectService{meta.(*KsyunClient)}
+	err = klogProjectService.ReadAndSetProject(d, resourceKsyunKlogProject())
+	if err != nil {
+		return fmt.Errorf("error on reading klog project %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunKlogProjectUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	klogProjectService := KlogProjectService{meta.(*KsyunClient)}
+	err = klogProjectService.ModifyProject(d)
+	if err != nil {
+		return fmt.Errorf("error on updating klog project %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKlogProjectRead(d, meta)
+}
+
+func resourceKsyunKlogProjectDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	klogProjectService := KlogProjectService{meta.(*KsyunClient)}
+	err = klogProjectService.DeleteProject(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting klog project %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,106 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunKlogProject_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_klog_project.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKlogProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKlogProjectConfig("tf-acc-klog-project", "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKlogProjectExists("ksyun_klog_project.foo"),
+					resource.TestCheckResourceAttr("ksyun_klog_project.foo", "project_name", "tf-acc-klog-project"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKlogProject_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKlogProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testKlogProjectConfig("tf-unit-klog-project", "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKlogProjectExists("ksyun_klog_project.foo"),
+					resource.TestCheckResourceAttr("ksyun_klog_project.foo", "id", "tf-unit-klog-project"),
+					resource.TestCheckResourceAttr("ksyun_klog_project.foo", "description", "created by terraform"),
+					resource.TestCheckResourceAttr("ksyun_klog_project.foo", "region", mockapi.DefaultRegion),
+					resource.TestCheckResourceAttr("ksyun_klog_project.foo", "status", "Normal"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testKlogProjectConfig("tf-unit-klog-project", "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_klog_project.foo", "description", "updated by terraform"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testKlogProjectConfig("tf-unit-klog-project", "updated by terraform"),
+				ResourceName:            "ksyun_klog_project.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description"},
+			},
+		},
+	})
+}
+
+func testKlogProjectConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "ksyun_klog_project" "foo" {
+  project_name = "%s"
+  description  = "%s"
+}
+`, name, description)
+}
+
+func testAccCheckKlogProjectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("klog project id is empty")
+		}
+		klogProjectService := KlogProjectService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := klogProjectService.ReadProject(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckKlogProjectDestroy(s *terraform.State) error {
+	klogProjectService := KlogProjectService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_klog_project" {
+			continue
+		}
+		_, err := klogProjectService.ReadProject(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("klog project still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
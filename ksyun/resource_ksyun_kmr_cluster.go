@@ -0,0 +1,337 @@
+/*
+Provides a KMR cluster resource.
+
+The `instance_count` of the CORE and TASK instance groups can be changed in place, the cluster is scaled out or in
+and the update waits until the cluster is running again.
+
+# Example Usage
+
+```hcl
+resource "ksyun_kmr_cluster" "foo" {
+  cluster_name      = "tf-kmr-cluster"
+  main_version      = "KMR 3.0.0"
+  services          = ["HDFS", "YARN", "HIVE", "SPARK"]
+  vpc_domain_id     = "your vpc id"
+  vpc_subnet_id     = "your subnet id"
+  security_group_id = "your security group id"
+  availability_zone = "cn-beijing-6a"
+
+  instance_groups {
+    instance_group_type = "MASTER"
+    instance_type       = "KMR.S2.2XLARGE"
+    instance_count      = 2
+    volume_type         = "EHDD"
+    volume_size         = 500
+  }
+
+  instance_groups {
+    instance_group_type = "CORE"
+    instance_type       = "KMR.S2.2XLARGE"
+    instance_count      = 3
+    volume_type         = "EHDD"
+    volume_size         = 500
+  }
+
+  bootstrap_actions {
+    name        = "init"
+    script_path = "ks3://tf-kmr/init.sh"
+    args        = "-v"
+    target      = "CORE"
+  }
+}
+```
+
+# Import
+
+KMR cluster can be imported using the `id`, the `services` and the `bootstrap_actions` are not read back, e.g.
+
+```
+$ terraform import ksyun_kmr_cluster.foo 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunKmrCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKmrClusterCreate,
+		Read:   resourceKsyunKmrClusterRead,
+		Update: resourceKsyunKmrClusterUpdate,
+		Delete: resourceKsyunKmrClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: kmrClusterInstanceGroupsCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the cluster.",
+			},
+			"main_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The version of the cluster, such as `KMR 3.0.0`.",
+			},
+			"services": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The services of the cluster, such as `HDFS`, `YARN`, `HIVE` and `SPARK`.",
+			},
+			"charge_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The charge type of the cluster, the default value is determined by the api.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the project.",
+			},
+			"vpc_domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the VPC.",
+			},
+			"vpc_subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the subnet which the instances are launched in.",
+			},
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the security group.",
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The availability zone which the instances are launched in.",
+			},
+			"instance_groups": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The instance groups of the cluster. Adding or removing an instance group will create a new cluster, use `ksyun_kmr_instance_group` to manage the elastic TASK groups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_group_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"MASTER", "CORE", "TASK"}, false),
+							Description:  "The type of the instance group. Valid Values: 'MASTER', 'CORE', 'TASK'.",
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The instance type of the instances.",
+						},
+						"instance_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of the instances. The CORE and TASK groups are scaled in place when it's changed.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The resource type of the instances, the default value is determined by the api.",
+						},
+						"volume_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The type of the data disks, the default value is determined by the api.",
+						},
+						"volume_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "The size of each data disk in GB, the default value is determined by the api.",
+						},
+						"volume_count": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The number of the data disks of each instance.",
+						},
+						"system_disk_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The type of the system disk.",
+						},
+						"system_disk_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The size of the system disk in GB.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance group.",
+						},
+						"instance_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the instances.",
+						},
+					},
+				},
+			},
+			"bootstrap_actions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The scripts which are run on the instances when the cluster is launched.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the bootstrap action.",
+						},
+						"script_path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The path of the script, such as `ks3://bucket/init.sh`.",
+						},
+						"args": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The arguments of the script.",
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "ALL",
+							ValidateFunc: validation.StringInSlice([]string{"ALL", "MASTER", "CORE", "TASK"}, false),
+							Description:  "The instance groups which the script is run on. Valid Values: 'ALL', 'MASTER', 'CORE', 'TASK'. Default is 'ALL'.",
+						},
+					},
+				},
+			},
+			"cluster_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the cluster.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the cluster.",
+			},
+			"enable_eip": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the EIP is enabled.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the cluster.",
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last update of the cluster.",
+			},
+		},
+	}
+}
+
+// kmrClusterInstanceGroupsCustomizeDiff creates a new cluster when the instance groups are added or removed,
+// only the instance_count of the CORE and TASK groups can be changed in place.
+func kmrClusterInstanceGroupsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("instance_groups") {
+		return nil
+	}
+	o, n := d.GetChange("instance_groups")
+	oldGroups, newGroups := o.([]interface{}), n.([]interface{})
+	if len(oldGroups) != len(newGroups) {
+		return d.ForceNew("instance_groups")
+	}
+	for i, v := range newGroups {
+		group := v.(map[string]interface{})
+		old := oldGroups[i].(map[string]interface{})
+		if group["instance_group_type"] == "MASTER" && old["instance_group_type"] == "MASTER" &&
+			group["instance_count"] != old["instance_count"] {
+			return fmt.Errorf("the instance_count of the MASTER group can't be changed")
+		}
+	}
+	return nil
+}
+
+func resourceKsyunKmrClusterCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.CreateKmrCluster(d)
+	if err != nil {
+		return fmt.Errorf("error on creating kmr cluster %q, %s", d.Get("cluster_name"), err)
+	}
+	return resourceKsyunKmrClusterRead(d, meta)
+}
+
+func resourceKsyunKmrClusterRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.ReadAndSetKmrCluster(d, resourceKsyunKmrCluster())
+	if err != nil {
+		return fmt.Errorf("error on reading kmr cluster %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunKmrClusterUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.ModifyKmrCluster(d)
+	if err != nil {
+		return fmt.Errorf("error on updating kmr cluster %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKmrClusterRead(d, meta)
+}
+
+func resourceKsyunKmrClusterDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.DeleteKmrCluster(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting kmr cluster %q, %s", d.Id(), err)
+	}
+	return
+}
@@ -0,0 +1,190 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunKmrCluster_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_kmr_cluster.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKmrClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKmrClusterConfig(1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmrClusterExists("ksyun_kmr_cluster.foo"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "cluster_status", "Running"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKmrCluster_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	var coreGroupId string
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKmrClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testKmrClusterConfig(1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmrClusterExists("ksyun_kmr_cluster.foo"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "cluster_status", "Running"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "vpc_domain_id", "00000000-0000-0000-0000-00000000aaaa"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "charge_type", "PostPaidByHour"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.0.instance_ids.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.1.instance_ids.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.1.volume_type", "EHDD"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.1.resource_type", "KEC"),
+					testUnitCheckKmrBootstrapActionSent(server, "init", "CORE"),
+					func(s *terraform.State) error {
+						coreGroupId = s.RootModule().Resources["ksyun_kmr_cluster.foo"].Primary.Attributes["instance_groups.1.id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testKmrClusterConfig(1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.1.instance_count", "3"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.1.instance_ids.#", "3"),
+					testUnitCheckKmrInstanceGroupId("ksyun_kmr_cluster.foo", "instance_groups.1.id", &coreGroupId),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testKmrClusterConfig(1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.1.instance_count", "1"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.1.instance_ids.#", "1"),
+					testUnitCheckKmrInstanceGroupId("ksyun_kmr_cluster.foo", "instance_groups.1.id", &coreGroupId),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testKmrClusterConfig(1, 1),
+				ResourceName:      "ksyun_kmr_cluster.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"services", "bootstrap_actions", "vpc_subnet_id", "security_group_id",
+					"availability_zone"},
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKmrCluster_master(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKmrClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testKmrClusterConfig(1, 2),
+			},
+			{
+				Config:      testMockApiProviderConfig(server) + testKmrClusterConfig(2, 2),
+				ExpectError: regexp.MustCompile("the instance_count of the MASTER group can't be changed"),
+			},
+		},
+	})
+}
+
+func testKmrClusterConfig(masterCount, coreCount int) string {
+	return fmt.Sprintf(`
+resource "ksyun_kmr_cluster" "foo" {
+  cluster_name      = "tf-kmr-cluster"
+  main_version      = "KMR 3.0.0"
+  services          = ["HDFS", "YARN", "HIVE"]
+  vpc_domain_id     = "00000000-0000-0000-0000-00000000aaaa"
+  vpc_subnet_id     = "00000000-0000-0000-0000-00000000bbbb"
+  security_group_id = "00000000-0000-0000-0000-00000000ffff"
+  availability_zone = "cn-beijing-6a"
+
+  instance_groups {
+    instance_group_type = "MASTER"
+    instance_type       = "KMR.S2.2XLARGE"
+    instance_count      = %d
+    volume_size         = 200
+  }
+
+  instance_groups {
+    instance_group_type = "CORE"
+    instance_type       = "KMR.S2.2XLARGE"
+    instance_count      = %d
+    volume_type         = "EHDD"
+    volume_size         = 500
+  }
+
+  bootstrap_actions {
+    name        = "init"
+    script_path = "ks3://tf-kmr/init.sh"
+    target      = "CORE"
+  }
+}
+`, masterCount, coreCount)
+}
+
+// testUnitCheckKmrBootstrapActionSent checks the bootstrap action is sent on launching the cluster
+func testUnitCheckKmrBootstrapActionSent(server *mockapi.Server, name, target string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, req := range server.Requests() {
+			if req.Action == "LaunchCluster" && req.Params["BootstrapActions.1.Name"] == name &&
+				req.Params["BootstrapActions.1.Target"] == target {
+				return nil
+			}
+		}
+		return fmt.Errorf("the bootstrap action %s is not sent", name)
+	}
+}
+
+// testUnitCheckKmrInstanceGroupId checks the instance group is scaled in place
+func testUnitCheckKmrInstanceGroupId(n, key string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(n, key, *id)(s)
+	}
+}
+
+func testAccCheckKmrClusterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		kmrService := KmrService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := kmrService.ReadCluster(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckKmrClusterDestroy(s *terraform.State) error {
+	kmrService := KmrService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_kmr_cluster" {
+			continue
+		}
+		_, err := kmrService.ReadCluster(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("kmr cluster still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Provides a KMR instance group resource, which manages an elastic TASK group of a KMR cluster.
+
+The `instance_count` can be changed in place, the group is scaled out or in and the update waits until the cluster
+is running again. All instances of the group are removed on destroying.
+
+# Example Usage
+
+```hcl
+resource "ksyun_kmr_instance_group" "foo" {
+  cluster_id     = ksyun_kmr_cluster.foo.id
+  instance_type  = "KMR.S2.2XLARGE"
+  instance_count = 2
+  volume_type    = "EHDD"
+  volume_size    = 500
+}
+```
+
+# Import
+
+KMR instance group can be imported using the `cluster_id:instance_group_id`, e.g.
+
+```
+$ terraform import ksyun_kmr_instance_group.foo 67b91d3c-c363-4f57-b0cd-xxxxxxxxxxxx:3f2c0a4e-xxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunKmrInstanceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKmrInstanceGroupCreate,
+		Read:   resourceKsyunKmrInstanceGroupRead,
+		Update: resourceKsyunKmrInstanceGroupUpdate,
+		Delete: resourceKsyunKmrInstanceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "cluster_id", "instance_group_id"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KMR cluster.",
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The instance type of the instances.",
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of the instances, the group is scaled in place when it's changed.",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The resource type of the instances, the default value is determined by the api.",
+			},
+			"volume_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The type of the data disks, the default value is determined by the api.",
+			},
+			"volume_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The size of each data disk in GB, the default value is determined by the api.",
+			},
+			"volume_count": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The number of the data disks of each instance.",
+			},
+			"system_disk_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The type of the system disk.",
+			},
+			"system_disk_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The size of the system disk in GB.",
+			},
+			"instance_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the instance group.",
+			},
+			"instance_group_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the instance group, it's always `TASK`.",
+			},
+			"instance_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKmrInstanceGroupCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.CreateKmrInstanceGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on creating kmr instance group of cluster %q, %s", d.Get("cluster_id"), err)
+	}
+	return resourceKsyunKmrInstanceGroupRead(d, meta)
+}
+
+func resourceKsyunKmrInstanceGroupRead(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.ReadAndSetKmrInstanceGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on reading kmr instance group %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunKmrInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.ModifyKmrInstanceGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on updating kmr instance group %q, %s", d.Id(), err)
+	}
+	return resourceKsyunKmrInstanceGroupRead(d, meta)
+}
+
+func resourceKsyunKmrInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	kmrService := KmrService{meta.(*KsyunClient)}
+	err = kmrService.DeleteKmrInstanceGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting kmr instance group %q, %s", d.Id(), err)
+	}
+	return
+}
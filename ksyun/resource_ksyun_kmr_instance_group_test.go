@@ -0,0 +1,126 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunKmrInstanceGroup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_kmr_instance_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKmrInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKmrInstanceGroupConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmrInstanceGroupExists("ksyun_kmr_instance_group.foo"),
+					resource.TestCheckResourceAttr("ksyun_kmr_instance_group.foo", "instance_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKmrInstanceGroup_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKmrInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testKmrInstanceGroupConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmrInstanceGroupExists("ksyun_kmr_instance_group.foo"),
+					resource.TestCheckResourceAttr("ksyun_kmr_instance_group.foo", "instance_group_type", "TASK"),
+					resource.TestCheckResourceAttr("ksyun_kmr_instance_group.foo", "instance_ids.#", "2"),
+					resource.TestCheckResourceAttr("ksyun_kmr_instance_group.foo", "volume_size", "300"),
+					resource.TestCheckResourceAttrSet("ksyun_kmr_instance_group.foo", "instance_group_id"),
+					resource.TestCheckResourceAttrPair("ksyun_kmr_instance_group.foo", "cluster_id", "ksyun_kmr_cluster.foo", "id"),
+					// the group isn't managed by the cluster resource
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.#", "2"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testKmrInstanceGroupConfig(3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_kmr_instance_group.foo", "instance_count", "3"),
+					resource.TestCheckResourceAttr("ksyun_kmr_instance_group.foo", "instance_ids.#", "3"),
+					resource.TestCheckResourceAttr("ksyun_kmr_cluster.foo", "instance_groups.#", "2"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testKmrInstanceGroupConfig(3),
+				ResourceName:      "ksyun_kmr_instance_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testKmrInstanceGroupConfig(count int) string {
+	return testKmrClusterConfig(1, 2) + fmt.Sprintf(`
+resource "ksyun_kmr_instance_group" "foo" {
+  cluster_id     = ksyun_kmr_cluster.foo.id
+  instance_type  = "KMR.S2.2XLARGE"
+  instance_count = %d
+  volume_size    = 300
+}
+`, count)
+}
+
+func testAccCheckKmrInstanceGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		clusterId, groupId, err := parseKmrInstanceGroupId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		kmrService := KmrService{testAccProvider.Meta().(*KsyunClient)}
+		resp, err := kmrService.ReadCluster(clusterId)
+		if err != nil {
+			return err
+		}
+		if findKmrInstanceGroup(resp.InstanceGroups, groupId) == nil {
+			return fmt.Errorf("kmr instance group not found: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKmrInstanceGroupDestroy(s *terraform.State) error {
+	kmrService := KmrService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_kmr_instance_group" {
+			continue
+		}
+		clusterId, groupId, err := parseKmrInstanceGroupId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := kmrService.ReadCluster(clusterId)
+		if err != nil {
+			if notFoundError(err) {
+				continue
+			}
+			return err
+		}
+		if findKmrInstanceGroup(resp.InstanceGroups, groupId) != nil {
+			return fmt.Errorf("kmr instance group still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
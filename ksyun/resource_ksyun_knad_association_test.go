@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunKnadAssociationAssociation_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_knad_associate.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKnadAssociationAssociationDestroy,
+		IDRefreshName:     "ksyun_knad_associate.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKnadAssociationAssociationDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKnadAssociationAssociationConfig,
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunKnad_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_knad.foo1",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKnadDestroy,
+		IDRefreshName:     "ksyun_knad.foo1",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKnadDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKnadConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunKnad_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_knad.foo1",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKnadDestroy,
+		IDRefreshName:     "ksyun_knad.foo1",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKnadDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKnadConfig,
@@ -147,6 +147,46 @@ Provides an RDS instance resource. A DB instance is an isolated database environ
 	  instance_has_eip = true
 	}
 
+# Create a RDS MySQL instance from a backup
+
+	resource "ksyun_krds_backup" "foo" {
+	  db_instance_identifier = "${ksyun_krds.my_rds_xx.id}"
+	  backup_name            = "tf-backup"
+	}
+
+	resource "ksyun_krds" "restored" {
+	  db_instance_class = "db.ram.2|db.disk.21"
+	  db_instance_name = "houbin_terraform_restored"
+	  db_instance_type = "HRDS"
+	  engine = "mysql"
+	  engine_version = "5.7"
+	  master_user_name = "admin"
+	  master_user_password = "123qweASD123"
+	  vpc_id = "${ksyun_vpc.default.id}"
+	  subnet_id = "${ksyun_subnet.foo.id}"
+	  restore_from {
+	    backup_id = "${ksyun_krds_backup.foo.db_backup_identifier}"
+	  }
+	}
+
+# Create a RDS MySQL instance by restoring another instance to a point in time
+
+	resource "ksyun_krds" "point_in_time" {
+	  db_instance_class = "db.ram.2|db.disk.21"
+	  db_instance_name = "houbin_terraform_point_in_time"
+	  db_instance_type = "HRDS"
+	  engine = "mysql"
+	  engine_version = "5.7"
+	  master_user_name = "admin"
+	  master_user_password = "123qweASD123"
+	  vpc_id = "${ksyun_vpc.default.id}"
+	  subnet_id = "${ksyun_subnet.foo.id}"
+	  restore_from {
+	    source_db_instance_identifier = "${ksyun_krds.my_rds_xx.id}"
+	    point_in_time = "2021-01-01T08:00:00+08:00"
+	  }
+	}
+
 ```
 
 # Import
@@ -163,14 +203,13 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunKrds() *schema.Resource {
 
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunKrdsCreate,
 		Update: resourceKsyunKrdsUpdate,
 		Read:   resourceKsyunKrdsRead,
@@ -377,17 +416,53 @@ func resourceKsyunKrds() *schema.Resource {
 				Description: "Set it to true to make some parameter efficient when modifying them. Default to false.",
 			},
 
+			"restore_from": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Description: "Create the instance by restoring a backup or restoring another instance to a point in time. " +
+					"The restored instance takes the class, the engine, the network and the master user of the source, they must match the arguments of the instance. " +
+					"The name and the master password are set on the instance after it's restored.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"restore_from.0.backup_id", "restore_from.0.point_in_time"},
+							Description:  "The ID of the backup to restore from.",
+						},
+						"point_in_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"restore_from.0.backup_id", "restore_from.0.point_in_time"},
+							ValidateFunc: validation.IsRFC3339Time,
+							Description: "The point in time to restore the source instance to, in RFC3339 format, such as 2021-01-01T08:00:00+08:00. " +
+								"It's converted to the time zone of Beijing in seconds, the precision of the restore api.",
+						},
+						"source_db_instance_identifier": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The ID of the instance to restore, it's required when point_in_time is set.",
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 
 func parameterToHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
-	return hashcode.String(m["name"].(string) + "|" + m["value"].(string))
+	return schema.HashString(m["name"].(string) + "|" + m["value"].(string))
 }
 
 func resourceKsyunKrdsCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -396,7 +471,7 @@ func resourceKsyunKrdsCreate(d *schema.ResourceData, meta interface{}) (err erro
 		return fmt.Errorf("error on creating instance , error is %e", err)
 	}
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "krds", false, true)
 		if err != nil {
@@ -431,7 +506,7 @@ func resourceKsyunKrdsUpdate(d *schema.ResourceData, meta interface{}) (err erro
 		return fmt.Errorf("error on updating instance , error is %e", err)
 	}
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "krds", false, true)
 		if err != nil {
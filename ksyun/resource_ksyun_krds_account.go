@@ -0,0 +1,150 @@
+/*
+Provides a KRDS account resource, which manages a database account of the krds instance and its privileges.
+
+~> **NOTE:** The password is not returned by the api, only the sha256 hash of it is kept in the state.
+After an account is imported, the next apply resets the password to the configured one.
+
+# Example Usage
+
+```hcl
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  database_name          = "app"
+}
+
+resource "ksyun_krds_account" "foo" {
+  db_instance_identifier = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  account_name           = "app_user"
+  account_password       = "123qweASD123"
+  account_description    = "account of the app"
+
+  account_privileges {
+    database_name = ksyun_krds_database.foo.database_name
+    privilege     = "ReadWrite"
+  }
+}
+```
+
+# Import
+
+KRDS account can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_krds_account.foo ${db_instance_identifier}:${account_name}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunKrdsAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsAccountCreate,
+		Read:   resourceKsyunKrdsAccountRead,
+		Update: resourceKsyunKrdsAccountUpdate,
+		Delete: resourceKsyunKrdsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "db_instance_identifier", "account_name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the krds instance.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the account.",
+			},
+			"account_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+				StateFunc:    accountPasswordStateFunc,
+				Description:  "The password of the account. It is kept as a sha256 hash in the state.",
+			},
+			"account_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the account.",
+			},
+			"account_privileges": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The privileges of the account on the databases.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the database.",
+						},
+						"privilege": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ReadWrite",
+								"ReadOnly",
+								"DDLOnly",
+								"DMLOnly",
+							}, false),
+							Description: "The privilege on the database. Valid values: ReadWrite, ReadOnly, DDLOnly, DMLOnly.",
+						},
+					},
+				},
+			},
+			"account_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the account.",
+			},
+			"account_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the account.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsAccountCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	err = createKrdsAccount(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating krds account, error is %s", err)
+	}
+	return resourceKsyunKrdsAccountRead(d, meta)
+}
+
+func resourceKsyunKrdsAccountRead(d *schema.ResourceData, meta interface{}) (err error) {
+	err = readAndSetKrdsAccount(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading krds account %q, error is %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsAccountUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	err = modifyKrdsAccount(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on updating krds account %q, error is %s", d.Id(), err)
+	}
+	return resourceKsyunKrdsAccountRead(d, meta)
+}
+
+func resourceKsyunKrdsAccountDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	return removeKrdsAccount(d, meta)
+}
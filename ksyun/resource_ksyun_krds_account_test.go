@@ -0,0 +1,156 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccKrdsAccountConfig = `
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = "a2a7a9ab-3c1d-4f7e-9ee0-3a3d6ef7f2b1"
+  database_name          = "tf_acc_account_db"
+}
+
+resource "ksyun_krds_account" "foo" {
+  db_instance_identifier = ksyun_krds_database.foo.db_instance_identifier
+  account_name           = "tf_acc_user"
+  account_password       = "123qweASD123"
+
+  account_privileges {
+    database_name = ksyun_krds_database.foo.database_name
+    privilege     = "ReadOnly"
+  }
+}
+`
+
+func TestAccKsyunKrdsAccount_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_krds_account.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKrdsAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKrdsAccountExists("ksyun_krds_account.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "account_privileges.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKrdsAccount_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKrdsAccountConfig("Passw0rd1", "v1", "ReadWrite"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKrdsAccountExists("ksyun_krds_account.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "account_password", accountPasswordStateFunc("Passw0rd1")),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "account_status", "ACTIVE"),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "account_privileges.#", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_krds_accounts.foo", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_krds_accounts.foo", "accounts.0.account_description", "v1"),
+					resource.TestCheckResourceAttr("data.ksyun_krds_accounts.foo", "accounts.0.account_privileges.#", "1"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKrdsAccountConfig("Passw0rd2", "v2", "ReadOnly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKrdsAccountExists("ksyun_krds_account.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "account_password", accountPasswordStateFunc("Passw0rd2")),
+					resource.TestCheckResourceAttr("ksyun_krds_account.foo", "account_description", "v2"),
+					testUnitCheckKrdsAccountPasswordSent(server, "Passw0rd2"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testUnitKrdsAccountConfig("Passw0rd2", "v2", "ReadOnly"),
+				ResourceName:            "ksyun_krds_account.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_password"},
+			},
+		},
+	})
+}
+
+func testUnitKrdsAccountConfig(password, description, privilege string) string {
+	return fmt.Sprintf(`
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = "00000000-0000-0000-0000-0000000000db"
+  database_name          = "tf_unit_db"
+}
+
+resource "ksyun_krds_account" "foo" {
+  db_instance_identifier = ksyun_krds_database.foo.db_instance_identifier
+  account_name           = "tf_unit_user"
+  account_password       = "%s"
+  account_description    = "%s"
+
+  account_privileges {
+    database_name = ksyun_krds_database.foo.database_name
+    privilege     = "%s"
+  }
+}
+
+data "ksyun_krds_accounts" "foo" {
+  db_instance_identifier = ksyun_krds_account.foo.db_instance_identifier
+  name_regex             = ksyun_krds_account.foo.account_name
+}
+`, password, description, privilege)
+}
+
+// testUnitCheckKrdsAccountPasswordSent checks the plain password, not the hash in the state, is sent to the api
+func testUnitCheckKrdsAccountPasswordSent(server *mockapi.Server, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, req := range server.Requests() {
+			if req.Action == "ModifyInstanceAccountInfo" && req.Params["InstanceAccountPassword"] == password {
+				return nil
+			}
+		}
+		return fmt.Errorf("the password is not modified to %s", password)
+	}
+}
+
+func testAccCheckKrdsAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("krds account id is empty")
+		}
+		_, err := readKrdsAccount(testAccProvider.Meta(), rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["account_name"])
+		return err
+	}
+}
+
+func testAccCheckKrdsAccountDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_krds_account" {
+			continue
+		}
+		_, err := readKrdsAccount(testAccProvider.Meta(), rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["account_name"])
+		if err == nil {
+			return fmt.Errorf("krds account still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+Provides a KRDS backup resource, which takes a manual backup of the krds instance.
+
+# Example Usage
+
+```hcl
+resource "ksyun_krds_backup" "foo" {
+  db_instance_identifier = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  backup_name            = "tf-backup"
+}
+```
+
+# Import
+
+KRDS backup can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_krds_backup.foo ${db_instance_identifier}:${db_backup_identifier}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunKrdsBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsBackupCreate,
+		Read:   resourceKsyunKrdsBackupRead,
+		Delete: resourceKsyunKrdsBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "db_instance_identifier", "db_backup_identifier"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the krds instance.",
+			},
+			"backup_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the backup.",
+			},
+			"db_backup_identifier": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the backup.",
+			},
+			"backup_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mode of the backup, the value is Manual or Auto.",
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backup.",
+			},
+			"backup_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the backup, in bytes.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup.",
+			},
+			"backup_create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the backup started.",
+			},
+			"backup_updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time when the backup finished.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsBackupCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	err = createKrdsBackup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating krds backup, error is %s", err)
+	}
+	return resourceKsyunKrdsBackupRead(d, meta)
+}
+
+func resourceKsyunKrdsBackupRead(d *schema.ResourceData, meta interface{}) (err error) {
+	err = readAndSetKrdsBackup(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading krds backup %q, error is %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsBackupDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	return removeKrdsBackup(d, meta)
+}
@@ -0,0 +1,100 @@
+/*
+Provides a KRDS backup policy resource, which manages the automatic backup of the krds instance.
+
+~> **NOTE:** The backup policy always exists along with the instance, so destroying the resource only removes it from the state.
+Don't set `preferred_backup_time` of `ksyun_krds` at the same time, or the two resources overwrite each other.
+
+# Example Usage
+
+```hcl
+resource "ksyun_krds_backup_policy" "foo" {
+  db_instance_identifier  = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  preferred_backup_time   = "01:00-02:00"
+  backup_retention_period = 14
+}
+```
+
+# Import
+
+KRDS backup policy can be imported using the id of the instance, e.g.
+
+```
+$ terraform import ksyun_krds_backup_policy.foo b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunKrdsBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsBackupPolicyCreate,
+		Read:   resourceKsyunKrdsBackupPolicyRead,
+		Update: resourceKsyunKrdsBackupPolicyUpdate,
+		Delete: resourceKsyunKrdsBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the krds instance.",
+			},
+			"preferred_backup_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{2}:00-\d{2}:00$`),
+					"the format must be HH:00-HH:00"),
+				Description: "The time window of the automatic backup, such as 01:00-02:00.",
+			},
+			"backup_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 730),
+				Description:  "The days to keep the automatic backups, valid values are from 1 to 730.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsBackupPolicyCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	err = modifyKrdsBackupPolicy(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating krds backup policy, error is %s", err)
+	}
+	d.SetId(d.Get("db_instance_identifier").(string))
+	return resourceKsyunKrdsBackupPolicyRead(d, meta)
+}
+
+func resourceKsyunKrdsBackupPolicyRead(d *schema.ResourceData, meta interface{}) (err error) {
+	err = readAndSetKrdsBackupPolicy(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading krds backup policy %q, error is %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	err = modifyKrdsBackupPolicy(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on updating krds backup policy %q, error is %s", d.Id(), err)
+	}
+	return resourceKsyunKrdsBackupPolicyRead(d, meta)
+}
+
+func resourceKsyunKrdsBackupPolicyDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	// the policy can't be deleted, it's removed from the state only
+	d.SetId("")
+	return err
+}
@@ -0,0 +1,155 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccKrdsBackupConfig = `
+resource "ksyun_krds_backup" "foo" {
+  db_instance_identifier = "a2a7a9ab-3c1d-4f7e-9ee0-3a3d6ef7f2b1"
+  backup_name            = "tf-acc-backup"
+}
+
+data "ksyun_krds_backups" "foo" {
+  db_instance_identifier = ksyun_krds_backup.foo.db_instance_identifier
+  backup_mode            = "Manual"
+}
+`
+
+func TestAccKsyunKrdsBackup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_krds_backup.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKrdsBackupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKrdsBackupExists("ksyun_krds_backup.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup.foo", "status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKrdsBackup_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + `
+resource "ksyun_krds_backup" "foo" {
+  db_instance_identifier = "00000000-0000-0000-0000-0000000000db"
+  backup_name            = "tf-unit-backup"
+}
+
+data "ksyun_krds_backups" "foo" {
+  db_instance_identifier = ksyun_krds_backup.foo.db_instance_identifier
+  name_regex             = "^tf-unit"
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKrdsBackupExists("ksyun_krds_backup.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup.foo", "status", "COMPLETED"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup.foo", "backup_mode", "Manual"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup.foo", "backup_size", "1048576"),
+					resource.TestCheckResourceAttr("data.ksyun_krds_backups.foo", "total_count", "1"),
+					resource.TestCheckResourceAttrPair("data.ksyun_krds_backups.foo", "backups.0.db_backup_identifier",
+						"ksyun_krds_backup.foo", "db_backup_identifier"),
+				),
+			},
+			{
+				Config:            config,
+				ResourceName:      "ksyun_krds_backup.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKrdsBackupPolicy_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKrdsBackupPolicyConfig("01:00-02:00", 14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_krds_backup_policy.foo", "id", "00000000-0000-0000-0000-0000000000db"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup_policy.foo", "preferred_backup_time", "01:00-02:00"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup_policy.foo", "backup_retention_period", "14"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKrdsBackupPolicyConfig("03:00-04:00", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_krds_backup_policy.foo", "preferred_backup_time", "03:00-04:00"),
+					resource.TestCheckResourceAttr("ksyun_krds_backup_policy.foo", "backup_retention_period", "30"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testUnitKrdsBackupPolicyConfig("03:00-04:00", 30),
+				ResourceName:      "ksyun_krds_backup_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testUnitKrdsBackupPolicyConfig(backupTime string, retention int) string {
+	return fmt.Sprintf(`
+resource "ksyun_krds_backup_policy" "foo" {
+  db_instance_identifier  = "00000000-0000-0000-0000-0000000000db"
+  preferred_backup_time   = "%s"
+  backup_retention_period = %d
+}
+`, backupTime, retention)
+}
+
+func testAccCheckKrdsBackupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("krds backup id is empty")
+		}
+		_, err := readKrdsBackup(testAccProvider.Meta(), rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["db_backup_identifier"])
+		return err
+	}
+}
+
+func testAccCheckKrdsBackupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_krds_backup" {
+			continue
+		}
+		_, err := readKrdsBackup(testAccProvider.Meta(), rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["db_backup_identifier"])
+		if err == nil {
+			return fmt.Errorf("krds backup still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Provides a KRDS database resource, which manages a database (schema) of the krds instance.
+
+# Example Usage
+
+```hcl
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = "b6a5e1c3-6c2a-4f1a-8d3e-xxxxxxxxxxxx"
+  database_name          = "app"
+  character_set_name     = "utf8mb4"
+  collation_name         = "utf8mb4_general_ci"
+  description            = "database of the app"
+}
+```
+
+# Import
+
+KRDS database can be imported using the id, e.g.
+
+```
+$ terraform import ksyun_krds_database.foo ${db_instance_identifier}:${database_name}
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunKrdsDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKrdsDatabaseCreate,
+		Read:   resourceKsyunKrdsDatabaseRead,
+		Delete: resourceKsyunKrdsDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: commonImport(2, "db_instance_identifier", "database_name"),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the krds instance.",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database.",
+			},
+			"character_set_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "utf8mb4",
+				Description: "The character set of the database, such as utf8, utf8mb4, gbk, latin1.",
+			},
+			"collation_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The collation of the database, the default collation of the character set is used if it's not set.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the database.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the database.",
+			},
+		},
+	}
+}
+
+func resourceKsyunKrdsDatabaseCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	err = createKrdsDatabase(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on creating krds database, error is %s", err)
+	}
+	return resourceKsyunKrdsDatabaseRead(d, meta)
+}
+
+func resourceKsyunKrdsDatabaseRead(d *schema.ResourceData, meta interface{}) (err error) {
+	err = readAndSetKrdsDatabase(d, meta)
+	if err != nil {
+		return fmt.Errorf("error on reading krds database %q, error is %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunKrdsDatabaseDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	return removeKrdsDatabase(d, meta)
+}
@@ -0,0 +1,167 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccKrdsDatabaseConfig = `
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = "a2a7a9ab-3c1d-4f7e-9ee0-3a3d6ef7f2b1"
+  database_name          = "tf_acc_db"
+  character_set_name     = "utf8"
+  description            = "tf acc database"
+}
+`
+
+func TestAccKsyunKrdsDatabase_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_krds_database.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKrdsDatabaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKrdsDatabaseExists("ksyun_krds_database.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_database.foo", "character_set_name", "utf8"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKrdsDatabase_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + `
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = "00000000-0000-0000-0000-0000000000db"
+  database_name          = "tf_unit_db"
+  description            = "tf unit database"
+}
+
+data "ksyun_krds_databases" "foo" {
+  db_instance_identifier = ksyun_krds_database.foo.db_instance_identifier
+  name_regex             = "^tf_unit"
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKrdsDatabaseExists("ksyun_krds_database.foo"),
+					resource.TestCheckResourceAttr("ksyun_krds_database.foo", "id", "00000000-0000-0000-0000-0000000000db:tf_unit_db"),
+					resource.TestCheckResourceAttr("ksyun_krds_database.foo", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("ksyun_krds_database.foo", "character_set_name", "utf8mb4"),
+					resource.TestCheckResourceAttr("ksyun_krds_database.foo", "collation_name", "utf8mb4_general_ci"),
+					resource.TestCheckResourceAttr("data.ksyun_krds_databases.foo", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ksyun_krds_databases.foo", "databases.0.description", "tf unit database"),
+				),
+			},
+			{
+				Config:            config,
+				ResourceName:      "ksyun_krds_database.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitKsyunKrdsDatabase_deleteBusy(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	// the instances are not simulated by the mock api, the status is switched by the steps
+	var status string
+	var failures int
+	server.Handle("DescribeDBInstances", func(s *mockapi.Server, p mockapi.Params) (map[string]interface{}, error) {
+		return map[string]interface{}{"Data": map[string]interface{}{"Instances": []interface{}{
+			map[string]interface{}{"DBInstanceIdentifier": p["DBInstanceIdentifier"], "DBInstanceStatus": status},
+		}}}, nil
+	})
+	deleteDatabase := server.Handler("DeleteInstanceDatabaseAction")
+	server.Handle("DeleteInstanceDatabaseAction", func(s *mockapi.Server, p mockapi.Params) (map[string]interface{}, error) {
+		if failures > 0 {
+			failures--
+			return nil, &mockapi.Error{StatusCode: 400, Code: "OperationDenied", Message: "the instance is " + status}
+		}
+		return deleteDatabase(s, p)
+	})
+
+	providerConfig := testMockApiProviderConfig(server)
+	config := providerConfig + `
+resource "ksyun_krds_database" "foo" {
+  db_instance_identifier = "00000000-0000-0000-0000-0000000000db"
+  database_name          = "tf_unit_db"
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCheckKrdsDatabaseExists("ksyun_krds_database.foo"),
+			},
+			{
+				// the error is returned at once if the instance is not busy
+				PreConfig: func() {
+					status, failures = "ACTIVE", 1000
+				},
+				Config:      providerConfig,
+				ExpectError: regexp.MustCompile("OperationDenied"),
+			},
+			{
+				// the deletion is retried while the instance is busy
+				PreConfig: func() {
+					status, failures = "BACKING_UP", 1
+				},
+				Config: providerConfig,
+			},
+		},
+	})
+}
+
+func testAccCheckKrdsDatabaseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("krds database id is empty")
+		}
+		_, err := readKrdsDatabase(testAccProvider.Meta(), rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["database_name"])
+		return err
+	}
+}
+
+func testAccCheckKrdsDatabaseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_krds_database" {
+			continue
+		}
+		_, err := readKrdsDatabase(testAccProvider.Meta(), rs.Primary.Attributes["db_instance_identifier"], rs.Primary.Attributes["database_name"])
+		if err == nil {
+			return fmt.Errorf("krds database still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestResourceKsyunKrdsParameterGroup_basic(t *testing.T) {
@@ -12,8 +12,8 @@ func TestResourceKsyunKrdsParameterGroup_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_krds_parameter_group.dpg_with_parameters",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_krds_parameter_group.dpg_with_parameters",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckSnapshotDestroy,
 
 		Steps: []resource.TestStep{
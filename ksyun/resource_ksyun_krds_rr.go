@@ -39,7 +39,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var krdsRrNotSupport = []string{
@@ -56,6 +56,7 @@ var krdsRrNotSupport = []string{
 	"availability_zone_1",
 	"db_instance_class",
 	"db_parameter_template_id",
+	"restore_from",
 }
 
 func resourceKsyunKrdsRr() *schema.Resource {
@@ -104,7 +105,7 @@ func resourceKsyunKrdsRr() *schema.Resource {
 		Description: "db engine version only support 5.5|5.6|5.7|8.0.",
 	}
 
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunKrdsRrCreate,
 		Update: resourceKsyunKrdsRrUpdate,
 		Read:   resourceKsyunKrdsRrRead,
@@ -118,7 +119,7 @@ func resourceKsyunKrdsRr() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 		Schema: rrSchema,
-	}
+	})
 }
 
 func resourceKsyunKrdsRrCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -128,7 +129,7 @@ func resourceKsyunKrdsRrCreate(d *schema.ResourceData, meta interface{}) (err er
 	}
 
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "krds", false, true)
 		if err != nil {
@@ -153,7 +154,7 @@ func resourceKsyunKrdsRrUpdate(d *schema.ResourceData, meta interface{}) (err er
 	}
 
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "krds", false, true)
 		if err != nil {
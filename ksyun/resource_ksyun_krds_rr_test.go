@@ -3,8 +3,8 @@ package ksyun
 import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -16,9 +16,9 @@ func TestAccKsyunKrdsRR_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_krds_rr.rds-rr-1",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKrdsRRDestroy,
+		IDRefreshName:     "ksyun_krds_rr.rds-rr-1",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsRRDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -32,8 +32,7 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"time"
 )
 
@@ -111,7 +110,7 @@ func resourceKsyunKrdsSecurityGroup() *schema.Resource {
 
 func secParameterToHash(ruleMap interface{}) int {
 	rule := ruleMap.(map[string]interface{})
-	return hashcode.String(rule["security_group_rule_protocol"].(string) + "|" + rule["security_group_rule_name"].(string))
+	return schema.HashString(rule["security_group_rule_protocol"].(string) + "|" + rule["security_group_rule_name"].(string))
 }
 
 func resourceKsyunKrdsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -3,8 +3,8 @@ package ksyun
 import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -16,9 +16,9 @@ func TestAccKsyunKrdsSecrityGroup_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_krds_security_group.krds_sec_group_239",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKrdsSecrityGroupDestroy,
+		IDRefreshName:     "ksyun_krds_security_group.krds_sec_group_239",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsSecrityGroupDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -2,11 +2,13 @@ package ksyun
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunKrds_basic(t *testing.T) {
@@ -17,9 +19,9 @@ func TestAccKsyunKrds_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_krds.rds_terraform_3",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKrdsDestroy,
+		IDRefreshName:     "ksyun_krds.rds_terraform_3",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKrdsDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -39,6 +41,176 @@ func TestAccKsyunKrds_basic(t *testing.T) {
 	})
 }
 
+func TestUnitKsyunKrds_restoreFrom(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+	// the instances are ACTIVE on the first describe, or each wait of the instance state takes a minute
+	server.PendingDescribes = 0
+
+	// the config of the last step is used to destroy, so it must pass the validation
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testMockApiProviderConfig(server) + testUnitKrdsRestoreFromConfig(`point_in_time = "2021-01-01 08:00:00"`),
+				ExpectError: regexp.MustCompile("to be a valid RFC3339 date"),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKrdsRestoreFromConfig(`
+    backup_id     = "00000000-0000-0000-0000-00000000bbbb"
+    point_in_time = "2021-01-01T08:00:00+08:00"`),
+				ExpectError: regexp.MustCompile("only one of\\s+`restore_from.0.backup_id,restore_from.0.point_in_time` can be specified"),
+			},
+			{
+				Config:      testMockApiProviderConfig(server) + testUnitKrdsRestoreFromConfig(`point_in_time = "2021-01-01T08:00:00+08:00"`),
+				ExpectError: regexp.MustCompile("source_db_instance_identifier is required when restoring to point_in_time"),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitKrdsRestoreConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ksyun_krds.from_backup", "engine_version", "ksyun_krds.source", "engine_version"),
+					resource.TestCheckResourceAttr("ksyun_krds.from_backup", "db_instance_name", "tf-unit-from-backup"),
+					resource.TestCheckResourceAttrPair("ksyun_krds.from_backup", "restore_from.0.backup_id", "ksyun_krds_backup.foo", "db_backup_identifier"),
+					resource.TestCheckResourceAttr("ksyun_krds.to_time", "db_instance_name", "tf-unit-to-time"),
+					resource.TestCheckResourceAttrPair("ksyun_krds.to_time", "restore_from.0.source_db_instance_identifier", "ksyun_krds.source", "id"),
+					testUnitCheckKrdsRestored(server, "ksyun_krds.from_backup", "ksyun_krds.source"),
+					testUnitCheckKrdsRestored(server, "ksyun_krds.to_time", "ksyun_krds.source"),
+					testUnitCheckKrdsRestorableTime(server, "ksyun_krds.source"),
+				),
+			},
+		},
+	})
+}
+
+// testUnitCheckKrdsRestored checks the instance is a new one restored from the source,
+// and the master password of the config is set on it.
+func testUnitCheckKrdsRestored(server *mockapi.Server, n, source string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		restored, sourceInstance := s.RootModule().Resources[n], s.RootModule().Resources[source]
+		if restored == nil || sourceInstance == nil {
+			return fmt.Errorf("not found: %s or %s", n, source)
+		}
+		if restored.Primary.ID == "" || restored.Primary.ID == sourceInstance.Primary.ID {
+			return fmt.Errorf("the instance %s is not restored to a new instance", restored.Primary.ID)
+		}
+		if restored.Primary.Attributes["db_parameter_group_id"] == sourceInstance.Primary.Attributes["db_parameter_group_id"] {
+			return fmt.Errorf("the restored instance %s shares the parameter group of the source", restored.Primary.ID)
+		}
+		for _, req := range server.Requests() {
+			if req.Action == "ModifyDBInstance" && req.Params["DBInstanceIdentifier"] == restored.Primary.ID &&
+				req.Params["MasterUserPassword"] == restored.Primary.Attributes["master_user_password"] {
+				return nil
+			}
+		}
+		return fmt.Errorf("the master password of the restored instance %s is not modified", restored.Primary.ID)
+	}
+}
+
+// testUnitCheckKrdsRestorableTime checks the point_in_time is sent in the time zone of Beijing,
+// the restored point is the creation of the source.
+func testUnitCheckKrdsRestorableTime(server *mockapi.Server, source string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		sourceInstance := s.RootModule().Resources[source]
+		if sourceInstance == nil {
+			return fmt.Errorf("not found: %s", source)
+		}
+		for _, req := range server.Requests() {
+			if req.Action == "RestoreDBInstanceToPointInTime" {
+				if v := req.Params["RestorableTime"]; v != sourceInstance.Primary.Attributes["instance_create_time"] {
+					return fmt.Errorf("the RestorableTime %s is expected to be %s", v, sourceInstance.Primary.Attributes["instance_create_time"])
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("the instance is not restored to a point in time")
+	}
+}
+
+func testUnitKrdsRestoreFromConfig(restore string) string {
+	return fmt.Sprintf(`
+resource "ksyun_krds" "foo" {
+  db_instance_class    = "db.ram.2|db.disk.21"
+  db_instance_name     = "tf-unit-restore"
+  db_instance_type     = "HRDS"
+  engine               = "mysql"
+  engine_version       = "5.7"
+  master_user_name     = "admin"
+  master_user_password = "123qweASD123"
+  vpc_id               = "00000000-0000-0000-0000-00000000aaaa"
+  subnet_id            = "00000000-0000-0000-0000-00000000cccc"
+
+  restore_from {
+    %s
+  }
+}
+`, restore)
+}
+
+// testUnitKrdsRestoreConfig restores the source instance from a backup and to the point in time it's created at,
+// the restored instances match the class, the engine, the network and the master user of the source.
+const testUnitKrdsRestoreConfig = `
+locals {
+  krds = {
+    db_instance_class = "db.ram.2|db.disk.21"
+    engine            = "mysql"
+    engine_version    = "5.7"
+    master_user_name  = "admin"
+    vpc_id            = "00000000-0000-0000-0000-00000000aaaa"
+    subnet_id         = "00000000-0000-0000-0000-00000000cccc"
+  }
+}
+
+resource "ksyun_krds" "source" {
+  db_instance_class    = local.krds.db_instance_class
+  db_instance_name     = "tf-unit-source"
+  db_instance_type     = "HRDS"
+  engine               = local.krds.engine
+  engine_version       = local.krds.engine_version
+  master_user_name     = local.krds.master_user_name
+  master_user_password = "123qweASD123"
+  vpc_id               = local.krds.vpc_id
+  subnet_id            = local.krds.subnet_id
+}
+
+resource "ksyun_krds_backup" "foo" {
+  db_instance_identifier = ksyun_krds.source.id
+  backup_name            = "tf-unit-restore"
+}
+
+resource "ksyun_krds" "from_backup" {
+  db_instance_class    = local.krds.db_instance_class
+  db_instance_name     = "tf-unit-from-backup"
+  db_instance_type     = "HRDS"
+  engine               = local.krds.engine
+  engine_version       = local.krds.engine_version
+  master_user_name     = local.krds.master_user_name
+  master_user_password = "456qweASD456"
+  vpc_id               = local.krds.vpc_id
+  subnet_id            = local.krds.subnet_id
+
+  restore_from {
+    backup_id = ksyun_krds_backup.foo.db_backup_identifier
+  }
+}
+
+resource "ksyun_krds" "to_time" {
+  db_instance_class    = local.krds.db_instance_class
+  db_instance_name     = "tf-unit-to-time"
+  db_instance_type     = "HRDS"
+  engine               = local.krds.engine
+  engine_version       = local.krds.engine_version
+  master_user_name     = local.krds.master_user_name
+  master_user_password = "789qweASD789"
+  vpc_id               = local.krds.vpc_id
+  subnet_id            = local.krds.subnet_id
+
+  restore_from {
+    source_db_instance_identifier = ksyun_krds.source.id
+    point_in_time                 = "${replace(ksyun_krds.source.instance_create_time, " ", "T")}+08:00"
+  }
+}
+`
+
 func testCheckKrdsExists(n string, val *map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		res, ok := s.RootModule().Resources[n]
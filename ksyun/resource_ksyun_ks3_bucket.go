@@ -145,6 +145,50 @@ resource "ksyun_ks3_bucket" "bucket-create2" {
   }
 }
 
+resource "ksyun_ks3_bucket" "bucket-website" {
+  bucket = "bucket-20240301-102030"
+  acl    = "public-read"
+  #多版本
+  versioning {
+    status = "Enabled"
+  }
+  #默认加密
+  server_side_encryption_rule {
+    sse_algorithm = "AES256"
+  }
+  #静态网站托管
+  website {
+    index_document = "index.html"
+    error_document = "error.html"
+    routing_rules {
+      key_prefix_equals       = "docs/"
+      redirect_type           = "External"
+      protocol                = "https"
+      host_name               = "www.example.com"
+      replace_key_prefix_with = "documents/"
+      http_redirect_code      = 301
+    }
+  }
+  #跨区域复制
+  replication {
+    target_bucket        = "bucket-20240206-104450"
+    region               = "BEIJING"
+    prefixes             = ["docs/"]
+    delete_marker_status = "Enabled"
+  }
+  #历史版本过期
+  lifecycle_rule {
+    id      = "noncurrent"
+    enabled = true
+    filter {
+      prefix = "docs/"
+    }
+    noncurrent_version_expiration {
+      noncurrent_days = 30
+    }
+  }
+}
+
 ```
 */
 
@@ -152,11 +196,11 @@ package ksyun
 
 import (
 	"bytes"
+	"encoding/xml"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
 	"log"
 	"strings"
@@ -164,7 +208,7 @@ import (
 )
 
 func resourceKsyunKs3Bucket() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunKs3BucketCreate,
 		Read:   resourceKsyunKs3BucketRead,
 		Update: resourceKsyunKs3BucketUpdate,
@@ -390,6 +434,22 @@ func resourceKsyunKs3Bucket() *schema.Resource {
 								},
 							},
 						},
+						"noncurrent_version_expiration": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Specifies when the noncurrent versions of the objects expire, it only takes effect when the versioning of the bucket is enabled or suspended.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The number of days after the objects become noncurrent before they are deleted.",
+									},
+								},
+							},
+						},
 						"abort_incomplete_multipart_upload": {
 							Type:        schema.TypeSet,
 							Optional:    true,
@@ -416,14 +476,173 @@ func resourceKsyunKs3Bucket() *schema.Resource {
 			},
 
 			"policy": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: policyDocumentDiffSuppressFunc,
+				Description:      "Bucket Policy is an authorization policy for Bucket introduced by KS3. You can authorize other users to access the KS3 resources you specify through the space policy. If you want to turn off this setting, just leave it blank in the configuration.",
+			},
+
+			"versioning": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The versioning configuration of the bucket. Once the versioning is enabled, it can only be suspended, and removing this block suspends it.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Enabled", "Suspended"}, false),
+							Description:  "The versioning status of the bucket. Valid values: Enabled, Suspended.",
+						},
+					},
+				},
+			},
+
+			"server_side_encryption_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The default server-side encryption of the objects uploaded to the bucket. If you want to turn off this setting, just leave it blank in the configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sse_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"AES256"}, false),
+							Description:  "The server-side encryption algorithm. Valid values: AES256.",
+						},
+					},
+				},
+			},
+
+			"website": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The static website hosting configuration of the bucket. If you want to turn off this setting, just leave it blank in the configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The object returned when a directory of the website is requested, such as index.html.",
+						},
+						"error_document": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The object returned when a 4XX error occurs.",
+						},
+						"routing_rules": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The redirect rules of the website.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_number": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The sequence number of the rule, the rules are matched in the ascending order of it.",
+									},
+									"key_prefix_equals": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The key prefix of the requests to redirect.",
+									},
+									"http_error_code_returned_equals": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(400, 599),
+										Description:  "The http error code of the requests to redirect.",
+									},
+									"redirect_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice([]string{"External", "Internal"}, false),
+										Description:  "The type of the redirect. Valid values: External, Internal.",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+										Description:  "The protocol used in the redirect. Valid values: http, https.",
+									},
+									"host_name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The host name used in the redirect.",
+									},
+									"replace_key_prefix_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The prefix replacing the `key_prefix_equals` of the key in the redirect. It conflicts with `replace_key_with`.",
+									},
+									"replace_key_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The key replacing the whole key in the redirect.",
+									},
+									"http_redirect_code": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntInSlice([]int{301, 302, 307}),
+										Description:  "The http code of the redirect. Valid values: 301, 302, 307.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"replication": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "Bucket Policy is an authorization policy for Bucket introduced by KS3. You can authorize other users to access the KS3 resources you specify through the space policy. If you want to turn off this setting, just leave it blank in the configuration.",
+				MaxItems:    1,
+				Description: "The cross-region replication configuration of the bucket. If you want to turn off this setting, just leave it blank in the configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_bucket": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the bucket that the objects are replicated to.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The region of the target bucket, such as SHANGHAI.",
+						},
+						"prefixes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    10,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The key prefixes of the objects to replicate, all the objects are replicated if it's not set.",
+						},
+						"delete_marker_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Disabled",
+							ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+							Description:  "Whether the delete operations are replicated to the target bucket. Valid values: Enabled, Disabled.",
+						},
+						"historical_object_replication": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Disabled",
+							ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+							Description:  "Whether the objects uploaded before the replication is configured are replicated. Valid values: Enabled, Disabled.",
+						},
+					},
+				},
 			},
 
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 
 func resourceKsyunKs3BucketCreate(d *schema.ResourceData, meta interface{}) error {
@@ -530,7 +749,7 @@ func resourceKsyunKs3BucketRead(d *schema.ResourceData, meta interface{}) error
 
 	// Read the lifecycle rule configuration
 	raw, err = client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
-		return ks3Client.GetBucketLifecycle(d.Id())
+		return ks3Client.GetBucketLifecycleXml(d.Id())
 	})
 	if err != nil && !ks3NotFoundError(err) {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketLifecycle", KsyunKs3GoSdk)
@@ -538,7 +757,12 @@ func resourceKsyunKs3BucketRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Ks3 bucket:  %s, raw: %#v", d.Id(), raw)
 	addDebug("GetBucketLifecycle", raw, requestInfo, request)
 	lifecycleRules := make([]map[string]interface{}, 0)
-	lifecycle, _ := raw.(ks3.GetBucketLifecycleResult)
+	var lifecycle ks3LifecycleConfiguration
+	if err == nil {
+		if lifecycle, err = parseKs3LifecycleXml(raw.(string)); err != nil {
+			return WrapError(err)
+		}
+	}
 	for _, lifecycleRule := range lifecycle.Rules {
 		rule := make(map[string]interface{})
 		rule["id"] = lifecycleRule.ID
@@ -636,6 +860,15 @@ func resourceKsyunKs3BucketRead(d *schema.ResourceData, meta interface{}) error
 			rule["abort_incomplete_multipart_upload"] = schema.NewSet(abortMulHash, []interface{}{abortMul})
 		}
 
+		// NoncurrentVersionExpiration
+		if lifecycleRule.NoncurrentVersionExpiration != nil {
+			rule["noncurrent_version_expiration"] = []interface{}{
+				map[string]interface{}{
+					"noncurrent_days": lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays,
+				},
+			}
+		}
+
 		lifecycleRules = append(lifecycleRules, rule)
 	}
 
@@ -663,6 +896,104 @@ func resourceKsyunKs3BucketRead(d *schema.ResourceData, meta interface{}) error
 		return WrapError(err)
 	}
 
+	// Read the versioning configuration
+	raw, err = client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		return ks3Client.GetBucketVersioning(d.Id())
+	})
+	if err != nil && !ks3NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketVersioning", KsyunKs3GoSdk)
+	}
+	addDebug("GetBucketVersioning", raw, requestInfo, request)
+	versioning := make([]map[string]interface{}, 0)
+	versioningResult, _ := raw.(ks3.GetBucketVersioningResult)
+	// a suspended versioning is the state after the versioning block is removed
+	if versioningResult.Status == "Enabled" ||
+		(versioningResult.Status == "Suspended" && len(d.Get("versioning").([]interface{})) > 0) {
+		versioning = append(versioning, map[string]interface{}{"status": versioningResult.Status})
+	}
+	if err := d.Set("versioning", versioning); err != nil {
+		return WrapError(err)
+	}
+
+	// Read the encryption configuration
+	raw, err = client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		return ks3Client.GetBucketEncryption(d.Id())
+	})
+	if err != nil && !ks3NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketEncryption", KsyunKs3GoSdk)
+	}
+	addDebug("GetBucketEncryption", raw, requestInfo, request)
+	encryption := make([]map[string]interface{}, 0)
+	encryptionResult, _ := raw.(ks3.ServerSideEncryptionConfiguration)
+	if algorithm := encryptionResult.ServerSideEncryptionRule.ApplyServerSideEncryptionByDefault.SSEAlgorithm; err == nil && algorithm != "" {
+		encryption = append(encryption, map[string]interface{}{"sse_algorithm": algorithm})
+	}
+	if err := d.Set("server_side_encryption_rule", encryption); err != nil {
+		return WrapError(err)
+	}
+
+	// Read the website configuration
+	raw, err = client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		return ks3Client.GetBucketWebsite(d.Id())
+	})
+	if err != nil && !ks3NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketWebsite", KsyunKs3GoSdk)
+	}
+	addDebug("GetBucketWebsite", raw, requestInfo, request)
+	website := make([]map[string]interface{}, 0)
+	websiteResult, _ := raw.(ks3.GetBucketWebsiteResult)
+	if err == nil && websiteResult.IndexDocument.Suffix != "" {
+		routingRules := make([]map[string]interface{}, 0, len(websiteResult.RoutingRules))
+		for _, r := range websiteResult.RoutingRules {
+			routingRules = append(routingRules, map[string]interface{}{
+				"rule_number":                     r.RuleNumber,
+				"key_prefix_equals":               r.Condition.KeyPrefixEquals,
+				"http_error_code_returned_equals": r.Condition.HTTPErrorCodeReturnedEquals,
+				"redirect_type":                   r.Redirect.RedirectType,
+				"protocol":                        r.Redirect.Protocol,
+				"host_name":                       r.Redirect.HostName,
+				"replace_key_prefix_with":         r.Redirect.ReplaceKeyPrefixWith,
+				"replace_key_with":                r.Redirect.ReplaceKeyWith,
+				"http_redirect_code":              r.Redirect.HttpRedirectCode,
+			})
+		}
+		website = append(website, map[string]interface{}{
+			"index_document": websiteResult.IndexDocument.Suffix,
+			"error_document": websiteResult.ErrorDocument.Key,
+			"routing_rules":  routingRules,
+		})
+	}
+	if err := d.Set("website", website); err != nil {
+		return WrapError(err)
+	}
+
+	// Read the replication configuration
+	raw, err = client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		return ks3Client.GetBucketReplication(d.Id())
+	})
+	if err != nil && !ks3NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketReplication", KsyunKs3GoSdk)
+	}
+	addDebug("GetBucketReplication", raw, requestInfo, request)
+	replication := make([]map[string]interface{}, 0)
+	replicationResult, _ := raw.(ks3.GetBucketReplicationResult)
+	if err == nil && replicationResult.TargetBucket != "" {
+		prefixes := replicationResult.Prefix
+		if prefixes == nil {
+			prefixes = []string{}
+		}
+		replication = append(replication, map[string]interface{}{
+			"target_bucket":                 replicationResult.TargetBucket,
+			"region":                        replicationResult.Region,
+			"prefixes":                      prefixes,
+			"delete_marker_status":          replicationResult.DeleteMarkerStatus,
+			"historical_object_replication": replicationResult.HistoricalObjectReplication,
+		})
+	}
+	if err := d.Set("replication", replication); err != nil {
+		return WrapError(err)
+	}
+
 	// Read the tags configuration
 	raw, err = client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
 		return ks3Client.GetBucketTagging(d.Id())
@@ -705,18 +1036,40 @@ func resourceKsyunKs3BucketUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
+	if d.HasChange("versioning") {
+		if err := resourceKsyunKs3BucketVersioningUpdate(client, d); err != nil {
+			return WrapError(err)
+		}
+	}
+
 	if d.HasChange("cors_rule") {
 		if err := resourceKsyunKs3BucketCorsUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("cors_rule")
 	}
 
 	if d.HasChange("logging") {
 		if err := resourceKsyunKs3BucketLoggingUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("logging")
+	}
+
+	if d.HasChange("server_side_encryption_rule") {
+		if err := resourceKsyunKs3BucketEncryptionUpdate(client, d); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if d.HasChange("website") {
+		if err := resourceKsyunKs3BucketWebsiteUpdate(client, d); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if d.HasChange("replication") {
+		if err := resourceKsyunKs3BucketReplicationUpdate(client, d); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
@@ -729,14 +1082,12 @@ func resourceKsyunKs3BucketUpdate(d *schema.ResourceData, meta interface{}) erro
 		if err := resourceKsyunKs3BucketPolicyUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("policy")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := resourceKsyunKs3BucketTaggingUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	d.Partial(false)
@@ -846,6 +1197,157 @@ func resourceKsyunKs3BucketLoggingUpdate(client *KsyunClient, d *schema.Resource
 	return nil
 }
 
+func resourceKsyunKs3BucketVersioningUpdate(client *KsyunClient, d *schema.ResourceData) error {
+	versioning := d.Get("versioning").([]interface{})
+	var requestInfo *ks3.Client
+	config := ks3.VersioningConfig{Status: "Suspended"}
+	if len(versioning) > 0 && versioning[0] != nil {
+		config.Status = versioning[0].(map[string]interface{})["status"].(string)
+	} else if d.IsNewResource() {
+		// versioning is disabled on a new bucket, there is nothing to suspend
+		return nil
+	}
+
+	raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		requestInfo = ks3Client
+		return nil, ks3Client.SetBucketVersioning(d.Id(), config)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "SetBucketVersioning", KsyunKs3GoSdk)
+	}
+	addDebug("SetBucketVersioning", raw, requestInfo, map[string]interface{}{
+		"bucketName": d.Id(),
+		"status":     config.Status,
+	})
+	return nil
+}
+
+func resourceKsyunKs3BucketEncryptionUpdate(client *KsyunClient, d *schema.ResourceData) error {
+	encryption := d.Get("server_side_encryption_rule").([]interface{})
+	var requestInfo *ks3.Client
+	if len(encryption) == 0 || encryption[0] == nil {
+		raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+			requestInfo = ks3Client
+			return nil, ks3Client.DeleteBucketEncryption(d.Id())
+		})
+		if err != nil && !ks3NotFoundError(err) {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucketEncryption", KsyunKs3GoSdk)
+		}
+		addDebug("DeleteBucketEncryption", raw, requestInfo, map[string]string{"bucketName": d.Id()})
+		return nil
+	}
+
+	rule := ks3.ServerSideEncryptionRule{
+		ApplyServerSideEncryptionByDefault: ks3.ApplyServerSideEncryptionByDefault{
+			SSEAlgorithm: encryption[0].(map[string]interface{})["sse_algorithm"].(string),
+		},
+	}
+	raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		requestInfo = ks3Client
+		return nil, ks3Client.PutBucketEncryption(d.Id(), rule)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketEncryption", KsyunKs3GoSdk)
+	}
+	addDebug("PutBucketEncryption", raw, requestInfo, map[string]interface{}{
+		"bucketName": d.Id(),
+		"rule":       rule,
+	})
+	return nil
+}
+
+func resourceKsyunKs3BucketWebsiteUpdate(client *KsyunClient, d *schema.ResourceData) error {
+	website := d.Get("website").([]interface{})
+	var requestInfo *ks3.Client
+	if len(website) == 0 || website[0] == nil {
+		raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+			requestInfo = ks3Client
+			return nil, ks3Client.DeleteBucketWebsite(d.Id())
+		})
+		if err != nil && !ks3NotFoundError(err) {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucketWebsite", KsyunKs3GoSdk)
+		}
+		addDebug("DeleteBucketWebsite", raw, requestInfo, map[string]string{"bucketName": d.Id()})
+		return nil
+	}
+
+	w := website[0].(map[string]interface{})
+	config := ks3.WebsiteXML{}
+	config.IndexDocument.Suffix = w["index_document"].(string)
+	config.ErrorDocument.Key = w["error_document"].(string)
+	for i, r := range w["routing_rules"].([]interface{}) {
+		ruleMap := r.(map[string]interface{})
+		rule := ks3.RoutingRule{RuleNumber: ruleMap["rule_number"].(int)}
+		if rule.RuleNumber == 0 {
+			rule.RuleNumber = i + 1
+		}
+		rule.Condition.KeyPrefixEquals = ruleMap["key_prefix_equals"].(string)
+		rule.Condition.HTTPErrorCodeReturnedEquals = ruleMap["http_error_code_returned_equals"].(int)
+		rule.Redirect.RedirectType = ruleMap["redirect_type"].(string)
+		rule.Redirect.Protocol = ruleMap["protocol"].(string)
+		rule.Redirect.HostName = ruleMap["host_name"].(string)
+		rule.Redirect.ReplaceKeyPrefixWith = ruleMap["replace_key_prefix_with"].(string)
+		rule.Redirect.ReplaceKeyWith = ruleMap["replace_key_with"].(string)
+		rule.Redirect.HttpRedirectCode = ruleMap["http_redirect_code"].(int)
+		if rule.Redirect.ReplaceKeyPrefixWith != "" && rule.Redirect.ReplaceKeyWith != "" {
+			return fmt.Errorf("replace_key_prefix_with and replace_key_with of the routing rule %d can not be set at the same time", rule.RuleNumber)
+		}
+		config.RoutingRules = append(config.RoutingRules, rule)
+	}
+
+	raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		requestInfo = ks3Client
+		return nil, ks3Client.SetBucketWebsiteDetail(d.Id(), config)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "SetBucketWebsite", KsyunKs3GoSdk)
+	}
+	addDebug("SetBucketWebsite", raw, requestInfo, map[string]interface{}{
+		"bucketName": d.Id(),
+		"website":    config,
+	})
+	return nil
+}
+
+func resourceKsyunKs3BucketReplicationUpdate(client *KsyunClient, d *schema.ResourceData) error {
+	replication := d.Get("replication").([]interface{})
+	var requestInfo *ks3.Client
+	if len(replication) == 0 || replication[0] == nil {
+		raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+			requestInfo = ks3Client
+			return nil, ks3Client.DeleteBucketReplication(d.Id())
+		})
+		if err != nil && !ks3NotFoundError(err) {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucketReplication", KsyunKs3GoSdk)
+		}
+		addDebug("DeleteBucketReplication", raw, requestInfo, map[string]string{"bucketName": d.Id()})
+		return nil
+	}
+
+	r := replication[0].(map[string]interface{})
+	config := ks3.Replication{
+		TargetBucket:                r["target_bucket"].(string),
+		Region:                      r["region"].(string),
+		DeleteMarkerStatus:          r["delete_marker_status"].(string),
+		HistoricalObjectReplication: r["historical_object_replication"].(string),
+	}
+	for _, prefix := range r["prefixes"].([]interface{}) {
+		config.Prefix = append(config.Prefix, prefix.(string))
+	}
+	raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
+		requestInfo = ks3Client
+		return nil, ks3Client.PutBucketReplication(d.Id(), config)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketReplication", KsyunKs3GoSdk)
+	}
+	addDebug("PutBucketReplication", raw, requestInfo, map[string]interface{}{
+		"bucketName":  d.Id(),
+		"replication": config,
+	})
+	return nil
+}
+
 func resourceKsyunKs3BucketLifecycleRuleUpdate(client *KsyunClient, d *schema.ResourceData) error {
 	bucket := d.Id()
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
@@ -865,11 +1367,11 @@ func resourceKsyunKs3BucketLifecycleRuleUpdate(client *KsyunClient, d *schema.Re
 		return nil
 	}
 
-	rules := make([]ks3.LifecycleRule, 0, len(lifecycleRules))
+	rules := make([]ks3LifecycleRule, 0, len(lifecycleRules))
 
 	for i, lifecycleRule := range lifecycleRules {
 		r := lifecycleRule.(map[string]interface{})
-		rule := ks3.LifecycleRule{}
+		rule := ks3LifecycleRule{}
 		// ID
 		if val, ok := r["id"].(string); ok && val != "" {
 			rule.ID = val
@@ -961,12 +1463,25 @@ func resourceKsyunKs3BucketLifecycleRuleUpdate(client *KsyunClient, d *schema.Re
 			rule.AbortIncompleteMultipartUpload = abortMulVal
 		}
 
+		// NoncurrentVersionExpiration
+		noncurrentList := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_expiration", i)).(*schema.Set).List()
+		if len(noncurrentList) > 0 {
+			noncurrent := noncurrentList[0].(map[string]interface{})
+			rule.NoncurrentVersionExpiration = &ks3.LifecycleVersionExpiration{
+				NoncurrentDays: noncurrent["noncurrent_days"].(int),
+			}
+		}
+
 		rules = append(rules, rule)
 	}
 	log.Printf("[DEBUG] Ks3 bucket: %s, put Lifecycle: %#v", d.Id(), rules)
+	lifecycleXml, err := buildKs3LifecycleXml(rules)
+	if err != nil {
+		return WrapError(err)
+	}
 	raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
 		requestInfo = ks3Client
-		return nil, ks3Client.SetBucketLifecycle(bucket, rules)
+		return nil, ks3Client.SetBucketLifecycleXml(bucket, lifecycleXml)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "SetBucketLifecycle", KsyunKs3GoSdk)
@@ -1007,7 +1522,7 @@ func resourceKsyunKs3BucketPolicyUpdate(client *KsyunClient, d *schema.ResourceD
 }
 
 func resourceKsyunKs3BucketTaggingUpdate(client *KsyunClient, d *schema.ResourceData) error {
-	tagsMap := d.Get("tags").(map[string]interface{})
+	tagsMap := d.Get("tags_all").(map[string]interface{})
 	var requestInfo *ks3.Client
 	if tagsMap == nil || len(tagsMap) == 0 {
 		raw, err := client.WithKs3Client(func(ks3Client *ks3.Client) (interface{}, error) {
@@ -1112,7 +1627,7 @@ func transitionsHash(v interface{}) int {
 	if v, ok := m["days"]; ok {
 		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
 	}
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func expirationHash(v interface{}) int {
@@ -1124,7 +1639,7 @@ func expirationHash(v interface{}) int {
 	if v, ok := m["days"]; ok {
 		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
 	}
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func abortMulHash(v interface{}) int {
@@ -1136,5 +1651,38 @@ func abortMulHash(v interface{}) int {
 	if v, ok := m["days_after_initiation"]; ok {
 		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
 	}
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
+}
+
+// ks3LifecycleRule is ks3.LifecycleRule with the noncurrent version expiration,
+// which is not supported by the sdk.
+type ks3LifecycleRule struct {
+	XMLName                        xml.Name                                     `xml:"Rule"`
+	ID                             string                                       `xml:"ID,omitempty"`
+	Prefix                         string                                       `xml:"Prefix,omitempty"`
+	Filter                         *ks3.LifecycleFilter                         `xml:"Filter,omitempty"`
+	Status                         string                                       `xml:"Status"`
+	Expiration                     *ks3.LifecycleExpiration                     `xml:"Expiration,omitempty"`
+	Transitions                    []ks3.LifecycleTransition                    `xml:"Transition,omitempty"`
+	AbortIncompleteMultipartUpload *ks3.LifecycleAbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+	NoncurrentVersionExpiration    *ks3.LifecycleVersionExpiration              `xml:"NoncurrentVersionExpiration,omitempty"`
+}
+
+type ks3LifecycleConfiguration struct {
+	XMLName xml.Name           `xml:"LifecycleConfiguration"`
+	Rules   []ks3LifecycleRule `xml:"Rule"`
+}
+
+func buildKs3LifecycleXml(rules []ks3LifecycleRule) (string, error) {
+	bs, err := xml.Marshal(ks3LifecycleConfiguration{Rules: rules})
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+func parseKs3LifecycleXml(body string) (ks3LifecycleConfiguration, error) {
+	var lifecycle ks3LifecycleConfiguration
+	err := xml.Unmarshal([]byte(body), &lifecycle)
+	return lifecycle, err
 }
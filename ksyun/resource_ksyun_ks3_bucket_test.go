@@ -1,8 +1,11 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
 )
 
 func TestAccKsyunKS3ResourceCreate(t *testing.T) {
@@ -10,7 +13,7 @@ func TestAccKsyunKS3ResourceCreate(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers: testAccProviders,
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccKS3BucketConfig,
@@ -164,3 +167,120 @@ resource "ksyun_ks3_bucket" "bucket-create2" {
   }
 }
 `
+
+func TestAccKsyunKS3BucketVersioningWebsite(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKS3BucketVersioningWebsiteConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_ks3_bucket.website"),
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.website", "versioning.0.status", "Enabled"),
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.website", "server_side_encryption_rule.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.website", "website.0.index_document", "index.html"),
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.website", "website.0.routing_rules.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.website", "replication.0.target_bucket", "bucket-20240206-104450"),
+					resource.TestCheckResourceAttr("ksyun_ks3_bucket.website", "lifecycle_rule.0.noncurrent_version_expiration.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestKs3LifecycleXml(t *testing.T) {
+	rules := []ks3LifecycleRule{
+		{
+			ID:     "id1",
+			Status: string(ExpirationStatusEnabled),
+			Filter: &ks3.LifecycleFilter{Prefix: "logs"},
+			Expiration: &ks3.LifecycleExpiration{
+				Days: 30,
+			},
+			NoncurrentVersionExpiration: &ks3.LifecycleVersionExpiration{
+				NoncurrentDays: 7,
+			},
+		},
+		{
+			ID:     "id2",
+			Status: string(ExpirationStatusDisabled),
+			Prefix: "tmp",
+		},
+	}
+	body, err := buildKs3LifecycleXml(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := "<NoncurrentVersionExpiration><NoncurrentDays>7</NoncurrentDays></NoncurrentVersionExpiration>"
+	if !strings.Contains(body, expected) {
+		t.Fatalf("expected %s in %s", expected, body)
+	}
+	if strings.Count(body, "<NoncurrentVersionExpiration>") != 1 {
+		t.Fatalf("expected only one noncurrent version expiration in %s", body)
+	}
+
+	lifecycle, err := parseKs3LifecycleXml(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(lifecycle.Rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(lifecycle.Rules))
+	}
+	if v := lifecycle.Rules[0].NoncurrentVersionExpiration; v == nil || v.NoncurrentDays != 7 {
+		t.Fatalf("expected noncurrent days 7, got %#v", v)
+	}
+	if lifecycle.Rules[0].Expiration.Days != 30 || lifecycle.Rules[0].Filter.Prefix != "logs" {
+		t.Fatalf("unexpected rule: %#v", lifecycle.Rules[0])
+	}
+	if lifecycle.Rules[1].NoncurrentVersionExpiration != nil || lifecycle.Rules[1].Prefix != "tmp" {
+		t.Fatalf("unexpected rule: %#v", lifecycle.Rules[1])
+	}
+}
+
+const testAccKS3BucketVersioningWebsiteConfig = `
+provider "ksyun" {
+  endpoint = "ks3-cn-beijing.ksyuncs.com"
+}
+
+resource "ksyun_ks3_bucket" "website" {
+  bucket = "bucket-20240301-102030"
+  acl    = "public-read"
+  versioning {
+    status = "Enabled"
+  }
+  server_side_encryption_rule {
+    sse_algorithm = "AES256"
+  }
+  website {
+    index_document = "index.html"
+    error_document = "error.html"
+    routing_rules {
+      key_prefix_equals       = "docs/"
+      redirect_type           = "External"
+      protocol                = "https"
+      host_name               = "www.example.com"
+      replace_key_prefix_with = "documents/"
+      http_redirect_code      = 301
+    }
+  }
+  replication {
+    target_bucket        = "bucket-20240206-104450"
+    region               = "BEIJING"
+    prefixes             = ["docs/"]
+    delete_marker_status = "Enabled"
+  }
+  lifecycle_rule {
+    id      = "noncurrent"
+    enabled = true
+    filter {
+      prefix = "docs/"
+    }
+    noncurrent_version_expiration {
+      noncurrent_days = 30
+    }
+  }
+}
+`
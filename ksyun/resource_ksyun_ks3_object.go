@@ -0,0 +1,350 @@
+/*
+Provides a KS3 object resource, which uploads the content or a local file to the bucket.
+
+~> **NOTE:** The object is uploaded again when its etag differs from the md5 of `content` or `source`,
+so the changes made out of terraform are reverted. The drift of the objects uploaded by parts or encrypted by kms
+can only be detected by setting `etag`.
+
+# Example Usage
+
+```hcl
+resource "ksyun_ks3_object" "bootstrap" {
+  bucket        = "bucket-20240206-104450"
+  key           = "scripts/bootstrap.sh"
+  source        = "${path.module}/bootstrap.sh"
+  content_type  = "text/x-sh"
+  acl           = "private"
+  storage_class = "STANDARD"
+  etag          = filemd5("${path.module}/bootstrap.sh")
+
+  metadata = {
+    owner = "ops"
+  }
+}
+
+resource "ksyun_ks3_object" "config" {
+  bucket                 = "bucket-20240206-104450"
+  key                    = "config/app.json"
+  content                = jsonencode({ env = "prod" })
+  content_type           = "application/json"
+  server_side_encryption = "AES256"
+}
+```
+
+# Import
+
+KS3 object can be imported using the bucket and the key, e.g.
+
+```
+$ terraform import ksyun_ks3_object.config bucket-20240206-104450:config/app.json
+```
+*/
+
+package ksyun
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
+)
+
+// ks3ObjectUploadFields are the fields that require the object to be uploaded again when they are changed
+var ks3ObjectUploadFields = []string{
+	"source",
+	"content",
+	"content_type",
+	"storage_class",
+	"metadata",
+	"server_side_encryption",
+	"etag",
+}
+
+func resourceKsyunKs3Object() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunKs3ObjectCreate,
+		Read:   resourceKsyunKs3ObjectRead,
+		Update: resourceKsyunKs3ObjectUpdate,
+		Delete: resourceKsyunKs3ObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKs3Object,
+		},
+		CustomizeDiff: ks3ObjectCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+				Description:  "The name of the bucket to put the object in.",
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The key of the object.",
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content"},
+				Description:   "The path of the local file to upload. Conflicts with `content`.",
+			},
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+				Description:   "The literal content of the object. Conflicts with `source`.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The MIME type of the object, it's detected by the extension of the key if it's not set.",
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(ks3.ACLPrivate),
+				ValidateFunc: validation.StringInSlice([]string{"private", "public-read", "public-read-write"}, false),
+				Description:  "The canned ACL of the object. Valid values are private, public-read, and public-read-write. Defaults to private.",
+			},
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ks3.StorageStandard),
+					string(ks3.StorageIA),
+					string(ks3.StorageDeepIA),
+					string(ks3.StorageArchive),
+				}, false),
+				Description: "The storage class of the object. Valid values are STANDARD, STANDARD_IA, DEEP_IA and ARCHIVE. The storage class of the bucket is used if it's not set.",
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateKs3ObjectMetadata,
+				Description:  "The user metadata of the object, which is sent as the `x-kss-meta-` headers. The keys must be lowercase.",
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AES256"}, false),
+				Description:  "The server-side encryption algorithm of the object. Valid value is AES256. The default encryption of the bucket is used if it's not set.",
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The etag of the object, which is the md5 of the content for the objects uploaded at once. Set it to `filemd5(source)` to upload the object again when the local file is changed.",
+			},
+			"content_length": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the object in bytes.",
+			},
+		},
+	}
+}
+
+func validateKs3ObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("the key %q of %s must be lowercase, it's stored in lowercase by ks3", key, k))
+		}
+	}
+	return
+}
+
+func importKs3Object(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// the key is allowed to contain ':', so only the first one separates the bucket
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("the id of ksyun_ks3_object must be ${bucket}:${key}, got %s", d.Id())
+	}
+	_ = d.Set("bucket", parts[0])
+	_ = d.Set("key", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// ks3ObjectCustomizeDiff marks the etag as new computed value when the object is going to be uploaded again,
+// including the case that the etag in ks3 differs from the md5 of the content or the source file.
+func ks3ObjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	for _, field := range ks3ObjectUploadFields {
+		if field != "etag" && d.HasChange(field) {
+			return d.SetNewComputed("etag")
+		}
+	}
+	if d.HasChange("etag") {
+		return nil
+	}
+	etag := d.Get("etag").(string)
+	// the etag of the objects uploaded by parts is not the md5 of the content
+	if etag == "" || strings.Contains(etag, "-") {
+		return nil
+	}
+	sum, err := ks3ObjectContentMd5(d.Get("content").(string), d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+	if sum != "" && sum != etag {
+		return d.SetNewComputed("etag")
+	}
+	return nil
+}
+
+// ks3ObjectContentMd5 returns the hex md5 of the content, or the source file if the content is empty
+func ks3ObjectContentMd5(content, source string) (string, error) {
+	h := md5.New()
+	if source == "" {
+		_, _ = io.WriteString(h, content)
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+	path, err := getAbsPath(source)
+	if err != nil {
+		return "", err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("error opening the source file %q: %s", source, err)
+	}
+	defer file.Close()
+	if _, err = io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("error reading the source file %q: %s", source, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func resourceKsyunKs3ObjectPut(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*KsyunClient)
+	bucketName := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	var body io.Reader
+	if source, ok := d.GetOk("source"); ok {
+		path, err := getAbsPath(source.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return WrapErrorf(err, "error opening the source file %q", source)
+		}
+		defer file.Close()
+		body = file
+	} else {
+		body = strings.NewReader(d.Get("content").(string))
+	}
+
+	options := []ks3.Option{ks3.ObjectACL(ks3.ACLType(d.Get("acl").(string)))}
+	if v, ok := d.GetOk("content_type"); ok {
+		options = append(options, ks3.ContentType(v.(string)))
+	}
+	if v, ok := d.GetOk("storage_class"); ok {
+		options = append(options, ks3.ObjectStorageClass(ks3.StorageClassType(v.(string))))
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		options = append(options, ks3.ServerSideEncryption(v.(string)))
+	}
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		options = append(options, ks3.Meta(k, v.(string)))
+	}
+
+	raw, err := client.WithKs3BucketByName(bucketName, func(bucket *ks3.Bucket) (interface{}, error) {
+		return nil, bucket.PutObject(key, body, options...)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, key, "PutObject", KsyunKs3GoSdk)
+	}
+	addDebug("PutObject", raw, map[string]string{"bucketName": bucketName, "key": key})
+	return nil
+}
+
+func resourceKsyunKs3ObjectCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceKsyunKs3ObjectPut(d, meta); err != nil {
+		return err
+	}
+	d.SetId(AssembleIds(d.Get("bucket").(string), d.Get("key").(string)))
+	return resourceKsyunKs3ObjectRead(d, meta)
+}
+
+func resourceKsyunKs3ObjectRead(d *schema.ResourceData, meta interface{}) error {
+	ks3Service := Ks3Service{meta.(*KsyunClient)}
+	bucketName := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	header, err := ks3Service.DescribeKs3Object(bucketName, key)
+	if err != nil {
+		if notFoundErrorNew(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	acl, err := ks3Service.DescribeKs3ObjectAcl(bucketName, key)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("acl", string(acl))
+	for k, v := range flattenKs3ObjectHeader(header) {
+		if k == "last_modified" {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
+
+func resourceKsyunKs3ObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges(ks3ObjectUploadFields...) {
+		if err := resourceKsyunKs3ObjectPut(d, meta); err != nil {
+			return err
+		}
+		return resourceKsyunKs3ObjectRead(d, meta)
+	}
+	if d.HasChange("acl") {
+		client := meta.(*KsyunClient)
+		key := d.Get("key").(string)
+		raw, err := client.WithKs3BucketByName(d.Get("bucket").(string), func(bucket *ks3.Bucket) (interface{}, error) {
+			return nil, bucket.SetObjectACL(key, ks3.ACLType(d.Get("acl").(string)))
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, key, "SetObjectACL", KsyunKs3GoSdk)
+		}
+		addDebug("SetObjectACL", raw, map[string]string{"key": key, "acl": d.Get("acl").(string)})
+	}
+	return resourceKsyunKs3ObjectRead(d, meta)
+}
+
+func resourceKsyunKs3ObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*KsyunClient)
+	key := d.Get("key").(string)
+	raw, err := client.WithKs3BucketByName(d.Get("bucket").(string), func(bucket *ks3.Bucket) (interface{}, error) {
+		return nil, bucket.DeleteObject(key)
+	})
+	if err != nil {
+		if ks3NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, key, "DeleteObject", KsyunKs3GoSdk)
+	}
+	addDebug("DeleteObject", raw, map[string]string{"bucketName": d.Get("bucket").(string), "key": key})
+	return nil
+}
@@ -0,0 +1,152 @@
+package ksyun
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKsyunKs3Object_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_ks3_object.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKs3ObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKs3ObjectConfig("echo hello", "private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKs3ObjectExists("ksyun_ks3_object.foo"),
+					resource.TestCheckResourceAttr("ksyun_ks3_object.foo", "etag", "cd18203adcdc4404664fea34541d8717"),
+					resource.TestCheckResourceAttr("ksyun_ks3_object.foo", "content_type", "text/x-sh"),
+					resource.TestCheckResourceAttr("ksyun_ks3_object.foo", "metadata.owner", "tf-acc"),
+				),
+			},
+			{
+				Config: testAccKs3ObjectConfig("echo world", "public-read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_ks3_object.foo", "acl", "public-read"),
+					resource.TestCheckResourceAttr("ksyun_ks3_object.foo", "content_length", "10"),
+				),
+			},
+			{
+				Config:                  testAccKs3ObjectConfig("echo world", "public-read"),
+				ResourceName:            "ksyun_ks3_object.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
+func testAccKs3ObjectConfig(content, acl string) string {
+	return fmt.Sprintf(`
+provider "ksyun" {
+  endpoint = "ks3-cn-beijing.ksyuncs.com"
+}
+
+resource "ksyun_ks3_bucket" "foo" {
+  bucket = "tf-acc-ks3-object"
+}
+
+resource "ksyun_ks3_object" "foo" {
+  bucket       = ksyun_ks3_bucket.foo.bucket
+  key          = "scripts/bootstrap.sh"
+  content      = "%s"
+  content_type = "text/x-sh"
+  acl          = "%s"
+
+  metadata = {
+    owner = "tf-acc"
+  }
+}
+
+data "ksyun_ks3_object" "foo" {
+  bucket = ksyun_ks3_object.foo.bucket
+  key    = ksyun_ks3_object.foo.key
+}
+
+data "ksyun_ks3_objects" "foo" {
+  bucket    = ksyun_ks3_object.foo.bucket
+  prefix    = "scripts/"
+  delimiter = "/"
+}
+`, content, acl)
+}
+
+func TestKs3ObjectContentMd5(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "bootstrap.sh")
+	if err := ioutil.WriteFile(source, []byte("echo hello"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		content, source, expected string
+	}{
+		{content: "echo hello", expected: "cd18203adcdc4404664fea34541d8717"},
+		{source: source, expected: "cd18203adcdc4404664fea34541d8717"},
+		{content: "", expected: "d41d8cd98f00b204e9800998ecf8427e"},
+	}
+	for _, c := range cases {
+		sum, err := ks3ObjectContentMd5(c.content, c.source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sum != c.expected {
+			t.Errorf("the md5 of %q/%q is %s, expected %s", c.content, c.source, sum, c.expected)
+		}
+	}
+	if _, err := ks3ObjectContentMd5("", filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected the error of the missing source file")
+	}
+}
+
+func TestImportKs3Object(t *testing.T) {
+	d := resourceKsyunKs3Object().TestResourceData()
+	d.SetId("tf-bucket:config/app:prod.json")
+	if _, err := importKs3Object(d, nil); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("bucket") != "tf-bucket" || d.Get("key") != "config/app:prod.json" {
+		t.Errorf("unexpected bucket %v and key %v", d.Get("bucket"), d.Get("key"))
+	}
+	d.SetId("tf-bucket")
+	if _, err := importKs3Object(d, nil); err == nil {
+		t.Error("expected the error of the id without key")
+	}
+}
+
+func testAccCheckKs3ObjectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		ks3Service := Ks3Service{testAccProvider.Meta().(*KsyunClient)}
+		_, err := ks3Service.DescribeKs3Object(rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"])
+		return err
+	}
+}
+
+func testAccCheckKs3ObjectDestroy(s *terraform.State) error {
+	ks3Service := Ks3Service{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_ks3_object" {
+			continue
+		}
+		_, err := ks3Service.DescribeKs3Object(rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"])
+		if err == nil {
+			return fmt.Errorf("ks3 object %s still exists", rs.Primary.ID)
+		}
+		if !notFoundErrorNew(err) {
+			return err
+		}
+	}
+	return nil
+}
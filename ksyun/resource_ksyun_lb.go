@@ -25,12 +25,12 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunLb() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunLbCreate,
 		Read:   resourceKsyunLbRead,
 		Update: resourceKsyunLbUpdate,
@@ -152,7 +152,7 @@ func resourceKsyunLb() *schema.Resource {
 				Description: "Bucket for storing access logs.",
 			},
 		},
-	}
+	})
 }
 func resourceKsyunLbCreate(d *schema.ResourceData, meta interface{}) (err error) {
 	slbService := SlbService{meta.(*KsyunClient)}
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"strings"
 	"testing"
 )
@@ -14,9 +14,9 @@ func TestAccKsyunLbAclEntry_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_acl_entry.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbAclEntryDestroy,
+		IDRefreshName:     "ksyun_lb_acl_entry.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbAclEntryDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbAclEntryConfig,
@@ -35,9 +35,9 @@ func TestAccKsyunLbAclEntry_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_acl_entry.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbAclEntryDestroy,
+		IDRefreshName:     "ksyun_lb_acl_entry.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbAclEntryDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbAclEntryConfig,
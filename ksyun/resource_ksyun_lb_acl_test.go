@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunLbAcl_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_acl.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbAclDestroy,
+		IDRefreshName:     "ksyun_lb_acl.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbAclDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbAclConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunLbAcl_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_acl.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbAclDestroy,
+		IDRefreshName:     "ksyun_lb_acl.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbAclDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbAclConfig,
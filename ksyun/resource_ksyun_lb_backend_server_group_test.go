@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunLbBackendServerGroup_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_backend_server_group.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbBackendServerGroupDestroy,
+		IDRefreshName:     "ksyun_lb_backend_server_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbBackendServerGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbBackendServerGroupConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunLbBackendServerGroup_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_backend_server_group.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbBackendServerGroupDestroy,
+		IDRefreshName:     "ksyun_lb_backend_server_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbBackendServerGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbBackendServerGroupConfig,
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunListenerHostHeader_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_host_header.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckListenerHostHeaderDestroy,
+		IDRefreshName:     "ksyun_lb_host_header.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckListenerHostHeaderDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccListenerHostHeaderConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunListenerHostHeader_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_host_header.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckListenerHostHeaderDestroy,
+		IDRefreshName:     "ksyun_lb_host_header.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckListenerHostHeaderDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccListenerHostHeaderConfig,
@@ -5,8 +5,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
 
@@ -16,9 +16,9 @@ func TestAccKsyunListenerAssociateAcl_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_listener_associate_acl.default",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckListenerAssociateAclDestroy,
+		IDRefreshName:     "ksyun_lb_listener_associate_acl.default",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckListenerAssociateAclDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAlbListenerAssociateAclConfig,
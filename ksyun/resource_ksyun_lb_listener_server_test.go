@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunListenerServer_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_listener_server.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckListenerServerDestroy,
+		IDRefreshName:     "ksyun_lb_listener_server.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckListenerServerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccListenerServerConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunListenerServer_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_listener_server.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckListenerServerDestroy,
+		IDRefreshName:     "ksyun_lb_listener_server.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckListenerServerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccListenerServerConfig,
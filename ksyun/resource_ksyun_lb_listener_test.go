@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunListener_basic(t *testing.T) {
@@ -14,9 +14,9 @@ func TestAccKsyunListener_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_listener.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckListenerDestroy,
+		IDRefreshName:     "ksyun_lb_listener.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckListenerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -35,9 +35,9 @@ func TestAccKsyunListener_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_listener.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckListenerDestroy,
+		IDRefreshName:     "ksyun_lb_listener.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckListenerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccListenerConfig,
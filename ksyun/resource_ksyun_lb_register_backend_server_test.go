@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunRegisterBackendServer_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_register_backend_server.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckRegisterBackendServerDestroy,
+		IDRefreshName:     "ksyun_lb_register_backend_server.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckRegisterBackendServerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRegisterBackendServerConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunRegisterBackendServer_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_register_backend_server.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckRegisterBackendServerDestroy,
+		IDRefreshName:     "ksyun_lb_register_backend_server.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckRegisterBackendServerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRegisterBackendServerConfig,
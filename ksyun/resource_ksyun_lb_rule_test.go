@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -13,9 +13,9 @@ func TestAccKsyunLbRule_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_rule.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbRuleDestroy,
+		IDRefreshName:     "ksyun_lb_rule.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbRuleConfig,
@@ -34,9 +34,9 @@ func TestAccKsyunLbRule_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb_rule.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbRuleDestroy,
+		IDRefreshName:     "ksyun_lb_rule.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbRuleConfig,
@@ -2,19 +2,19 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 	"testing"
 )
 
 func TestAccKsyunLb_basic(t *testing.T) {
 	var val map[string]interface{}
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
 		IDRefreshName: "ksyun_lb.foo",
-		Providers:     testAccProviders,
 		CheckDestroy:  testAccCheckLbDestroy,
 		Steps: []resource.TestStep{
 			{
@@ -34,9 +34,9 @@ func TestAccKsyunLb_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_lb.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckLbDestroy,
+		IDRefreshName:     "ksyun_lb.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccLbConfig,
@@ -56,6 +56,28 @@ func TestAccKsyunLb_update(t *testing.T) {
 	})
 }
 
+func TestUnitKsyunLb_basic(t *testing.T) {
+	var val map[string]interface{}
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckLbDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitLbConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLbExists("ksyun_lb.foo", &val),
+					testAccCheckLbAttributes(&val),
+					resource.TestCheckResourceAttr("ksyun_lb.foo", "load_balancer_state", "stop"),
+					resource.TestCheckResourceAttrSet("ksyun_lb.foo", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLbExists(n string, val *map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -153,3 +175,16 @@ resource "ksyun_lb" "foo" {
   private_ip_address = ""
 }
 `
+
+const testUnitLbConfig = `
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-unit-vpc"
+  cidr_block = "10.5.0.0/21"
+}
+resource "ksyun_lb" "foo" {
+  vpc_id              = "${ksyun_vpc.default.id}"
+  load_balancer_name  = "tf-unit-lb"
+  type                = "public"
+  load_balancer_state = "stop"
+}
+`
@@ -37,13 +37,13 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunMongodbInstance() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceMongodbInstanceCreate,
 		Delete: resourceMongodbInstanceDelete,
 		Update: resourceMongodbInstanceUpdate,
@@ -294,7 +294,7 @@ func resourceKsyunMongodbInstance() *schema.Resource {
 				Description: "instance specification.",
 			},
 		},
-	}
+	})
 }
 
 func resourceMongodbInstanceCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -303,7 +303,7 @@ func resourceMongodbInstanceCreate(d *schema.ResourceData, meta interface{}) (er
 		return err
 	}
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "mongodb-instance", false, true)
 		if err != nil {
@@ -337,7 +337,7 @@ func resourceMongodbInstanceUpdate(d *schema.ResourceData, meta interface{}) (er
 		return err
 	}
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "mongodb-instance", false, true)
 		if err != nil {
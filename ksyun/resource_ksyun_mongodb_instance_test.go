@@ -3,8 +3,8 @@ package ksyun
 import (
 	"errors"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 	"strings"
 	"testing"
@@ -15,8 +15,8 @@ func TestAccKsyunMongodbInstance_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMongodbInstanceDestroy,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMongodbInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMongodbInstanceConfig,
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 	"strings"
 	"testing"
@@ -14,8 +14,8 @@ func TestAccKsyunMongodbShardInstanceNode_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMongodbShardInstanceNodeDestroy,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMongodbShardInstanceNodeDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMongodbShardInstanceNodeConfig,
@@ -0,0 +1,118 @@
+/*
+Provides a Monitor alarm contact resource.
+
+The `id` of the contact is used as the `contact_id` of the `user_notice` of `ksyun_monitor_alarm_policy`.
+
+# Example Usage
+
+```hcl
+resource "ksyun_monitor_alarm_contact_group" "foo" {
+  group_name = "tf-ops"
+}
+
+resource "ksyun_monitor_alarm_contact" "foo" {
+  contact_name      = "tf-ops-user"
+  email             = "ops@example.com"
+  phone             = "13800000000"
+  contact_group_ids = [ksyun_monitor_alarm_contact_group.foo.id]
+}
+```
+
+# Import
+
+Monitor alarm contact can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_monitor_alarm_contact.foo 4423
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunMonitorAlarmContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunMonitorAlarmContactCreate,
+		Read:   resourceKsyunMonitorAlarmContactRead,
+		Update: resourceKsyunMonitorAlarmContactUpdate,
+		Delete: resourceKsyunMonitorAlarmContactDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"contact_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the contact.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The email of the contact.",
+			},
+			"phone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The phone number of the contact.",
+			},
+			"contact_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set:         schema.HashString,
+				Description: "The IDs of the contact groups the contact belongs to.",
+			},
+			"status": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+				Description:  "The status of the contact, 1: enabled, 0: disabled. Default is 1.",
+			},
+		},
+	}
+}
+
+func resourceKsyunMonitorAlarmContactCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.CreateAlarmContact(d)
+	if err != nil {
+		return fmt.Errorf("error on creating monitor alarm contact %q, %s", d.Get("contact_name"), err)
+	}
+	return resourceKsyunMonitorAlarmContactRead(d, meta)
+}
+
+func resourceKsyunMonitorAlarmContactRead(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.ReadAndSetAlarmContact(d)
+	if err != nil {
+		return fmt.Errorf("error on reading monitor alarm contact %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunMonitorAlarmContactUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.ModifyAlarmContact(d)
+	if err != nil {
+		return fmt.Errorf("error on updating monitor alarm contact %q, %s", d.Id(), err)
+	}
+	return resourceKsyunMonitorAlarmContactRead(d, meta)
+}
+
+func resourceKsyunMonitorAlarmContactDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.DeleteAlarmContact(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting monitor alarm contact %q, %s", d.Id(), err)
+	}
+	return
+}
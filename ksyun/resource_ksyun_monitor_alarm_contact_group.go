@@ -0,0 +1,87 @@
+/*
+Provides a Monitor alarm contact group resource.
+
+# Example Usage
+
+```hcl
+resource "ksyun_monitor_alarm_contact_group" "foo" {
+  group_name = "tf-ops"
+}
+```
+
+# Import
+
+Monitor alarm contact group can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_monitor_alarm_contact_group.foo 4423
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunMonitorAlarmContactGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunMonitorAlarmContactGroupCreate,
+		Read:   resourceKsyunMonitorAlarmContactGroupRead,
+		Update: resourceKsyunMonitorAlarmContactGroupUpdate,
+		Delete: resourceKsyunMonitorAlarmContactGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the contact group.",
+			},
+			"user_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the contacts in the group.",
+			},
+		},
+	}
+}
+
+func resourceKsyunMonitorAlarmContactGroupCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.CreateAlarmContactGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on creating monitor alarm contact group %q, %s", d.Get("group_name"), err)
+	}
+	return resourceKsyunMonitorAlarmContactGroupRead(d, meta)
+}
+
+func resourceKsyunMonitorAlarmContactGroupRead(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.ReadAndSetAlarmContactGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on reading monitor alarm contact group %q, %s", d.Id(), err)
+	}
+	return
+}
+
+func resourceKsyunMonitorAlarmContactGroupUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.ModifyAlarmContactGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on updating monitor alarm contact group %q, %s", d.Id(), err)
+	}
+	return resourceKsyunMonitorAlarmContactGroupRead(d, meta)
+}
+
+func resourceKsyunMonitorAlarmContactGroupDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	monitorService := MonitorService{meta.(*KsyunClient)}
+	err = monitorService.DeleteAlarmContactGroup(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting monitor alarm contact group %q, %s", d.Id(), err)
+	}
+	return
+}
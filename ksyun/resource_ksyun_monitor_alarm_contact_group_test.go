@@ -0,0 +1,99 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+func TestAccKsyunMonitorAlarmContactGroup_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_monitor_alarm_contact_group.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMonitorAlarmContactGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMonitorAlarmContactGroupConfig("tf-acc-contact-group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorAlarmContactGroupExists("ksyun_monitor_alarm_contact_group.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunMonitorAlarmContactGroup_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMonitorAlarmContactGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testMonitorAlarmContactGroupConfig("tf-unit-contact-group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorAlarmContactGroupExists("ksyun_monitor_alarm_contact_group.foo"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact_group.foo", "group_name", "tf-unit-contact-group"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact_group.foo", "user_count", "0"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testMonitorAlarmContactGroupConfig("tf-unit-contact-group-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorAlarmContactGroupExists("ksyun_monitor_alarm_contact_group.foo"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact_group.foo", "group_name", "tf-unit-contact-group-renamed"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testMonitorAlarmContactGroupConfig("tf-unit-contact-group-renamed"),
+				ResourceName:      "ksyun_monitor_alarm_contact_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testMonitorAlarmContactGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ksyun_monitor_alarm_contact_group" "foo" {
+  group_name = "%s"
+}
+`, name)
+}
+
+func testAccCheckMonitorAlarmContactGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		monitorService := MonitorService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := monitorService.ReadAlarmContactGroup(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckMonitorAlarmContactGroupDestroy(s *terraform.State) error {
+	monitorService := MonitorService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_monitor_alarm_contact_group" {
+			continue
+		}
+		_, err := monitorService.ReadAlarmContactGroup(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("monitor alarm contact group still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
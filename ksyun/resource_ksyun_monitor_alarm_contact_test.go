@@ -0,0 +1,130 @@
+package ksyun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testMonitorAlarmContactGroupsConfig = `
+resource "ksyun_monitor_alarm_contact_group" "ops" {
+  group_name = "tf-unit-ops"
+}
+
+resource "ksyun_monitor_alarm_contact_group" "dev" {
+  group_name = "tf-unit-dev"
+}
+`
+
+func TestAccKsyunMonitorAlarmContact_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_monitor_alarm_contact.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMonitorAlarmContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMonitorAlarmContactGroupsConfig + testMonitorAlarmContactConfig("tf-acc-contact", "ksyun_monitor_alarm_contact_group.ops.id", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorAlarmContactExists("ksyun_monitor_alarm_contact.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunMonitorAlarmContact_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMonitorAlarmContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testMonitorAlarmContactGroupsConfig +
+					testMonitorAlarmContactConfig("tf-unit-contact", "ksyun_monitor_alarm_contact_group.ops.id", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorAlarmContactExists("ksyun_monitor_alarm_contact.foo"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact.foo", "contact_name", "tf-unit-contact"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact.foo", "status", "1"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact.foo", "contact_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact_group.ops", "user_count", "0"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testMonitorAlarmContactGroupsConfig +
+					testMonitorAlarmContactConfig("tf-unit-contact-renamed",
+						"ksyun_monitor_alarm_contact_group.ops.id, ksyun_monitor_alarm_contact_group.dev.id", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorAlarmContactExists("ksyun_monitor_alarm_contact.foo"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact.foo", "contact_name", "tf-unit-contact-renamed"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact.foo", "status", "0"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact.foo", "contact_group_ids.#", "2"),
+				),
+			},
+			{
+				// the groups are refreshed after the contact is changed
+				Config: testMockApiProviderConfig(server) + testMonitorAlarmContactGroupsConfig +
+					testMonitorAlarmContactConfig("tf-unit-contact-renamed",
+						"ksyun_monitor_alarm_contact_group.ops.id, ksyun_monitor_alarm_contact_group.dev.id", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact_group.ops", "user_count", "1"),
+					resource.TestCheckResourceAttr("ksyun_monitor_alarm_contact_group.dev", "user_count", "1"),
+				),
+			},
+			{
+				Config:            testMockApiProviderConfig(server) + testMonitorAlarmContactGroupsConfig + testMonitorAlarmContactConfig("tf-unit-contact-renamed", "ksyun_monitor_alarm_contact_group.ops.id, ksyun_monitor_alarm_contact_group.dev.id", 0),
+				ResourceName:      "ksyun_monitor_alarm_contact.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testMonitorAlarmContactConfig(name, groupIds string, status int) string {
+	return fmt.Sprintf(`
+resource "ksyun_monitor_alarm_contact" "foo" {
+  contact_name      = "%s"
+  email             = "ops@example.com"
+  phone             = "13800000000"
+  contact_group_ids = [%s]
+  status            = %d
+}
+`, name, groupIds, status)
+}
+
+func testAccCheckMonitorAlarmContactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		monitorService := MonitorService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := monitorService.ReadAlarmContact(rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckMonitorAlarmContactDestroy(s *terraform.State) error {
+	monitorService := MonitorService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_monitor_alarm_contact" {
+			continue
+		}
+		_, err := monitorService.ReadAlarmContact(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("monitor alarm contact still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
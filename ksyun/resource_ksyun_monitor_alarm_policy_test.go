@@ -5,8 +5,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -15,8 +15,8 @@ func TestAccKsyunMonitorAlarmPolicy_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMonitorAlarmPolicyDestroy,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMonitorAlarmPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMonitorAlarmPolicyConfig,
@@ -48,8 +48,8 @@ func TestAccKsyunMonitorAlarmPolicy_withUserNotice(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMonitorAlarmPolicyDestroy,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMonitorAlarmPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMonitorAlarmPolicyWithUserNoticeConfig,
@@ -70,8 +70,8 @@ func TestAccKsyunMonitorAlarmPolicy_withUrlNotice(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMonitorAlarmPolicyDestroy,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMonitorAlarmPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMonitorAlarmPolicyWithUrlNoticeConfig,
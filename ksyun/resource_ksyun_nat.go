@@ -34,12 +34,12 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunNat() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunNatCreate,
 		Update: resourceKsyunNatUpdate,
 		Read:   resourceKsyunNatRead,
@@ -165,7 +165,7 @@ func resourceKsyunNat() *schema.Resource {
 				Description: "The time of creation of Nat.",
 			},
 		},
-	}
+	})
 }
 
 func resourceKsyunNatCreate(d *schema.ResourceData, meta interface{}) (err error) {
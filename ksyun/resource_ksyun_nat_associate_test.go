@@ -5,8 +5,8 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -18,9 +18,9 @@ func TestAccKsyunNatAssociation_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_nat_associate.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckNatAssociationDestroy,
+		IDRefreshName:     "ksyun_nat_associate.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckNatAssociationDestroy,
 
 		Steps: []resource.TestStep{
 			{
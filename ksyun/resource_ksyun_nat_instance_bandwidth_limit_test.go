@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunNatInstanceAssociation_basic(t *testing.T) {
@@ -16,8 +16,8 @@ func TestAccKsyunNatInstanceAssociation_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_nat_instance_bandwidth_limit.foo",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_nat_instance_bandwidth_limit.foo",
+		ProviderFactories: testAccProviderFactories,
 
 		Steps: []resource.TestStep{
 			{
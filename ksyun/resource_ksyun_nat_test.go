@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -15,9 +15,9 @@ func TestAccKsyunNat_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_nat.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckNatDestroy,
+		IDRefreshName:     "ksyun_nat.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckNatDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -42,9 +42,9 @@ func TestAccKsyunNat_update(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_nat.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckNatDestroy,
+		IDRefreshName:     "ksyun_nat.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckNatDestroy,
 
 		Steps: []resource.TestStep{
 			{
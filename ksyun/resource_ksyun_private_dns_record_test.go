@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccKsyunPrivateDnsRecord_basic(t *testing.T) {
@@ -14,8 +14,8 @@ func TestAccKsyunPrivateDnsRecord_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_private_dns_record.foo",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_private_dns_record.foo",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckPrivateDnsDestroy,
 
 		Steps: []resource.TestStep{
@@ -37,8 +37,8 @@ func TestAccKsyunPrivateDnsRecord_update(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_private_dns_record.foo",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_private_dns_record.foo",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckPrivateDnsDestroy,
 
 		Steps: []resource.TestStep{
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunPrivateDnsZone_basic(t *testing.T) {
@@ -16,9 +16,9 @@ func TestAccKsyunPrivateDnsZone_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_private_dns_zone.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckPrivateDnsDestroy,
+		IDRefreshName:     "ksyun_private_dns_zone.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPrivateDnsDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -39,9 +39,9 @@ func TestAccKsyunPrivateDnsZone_update(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_private_dns_zone.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckPrivateDnsDestroy,
+		IDRefreshName:     "ksyun_private_dns_zone.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPrivateDnsDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccKsyunPrivateDnsVpcAttachment_basic(t *testing.T) {
@@ -14,8 +14,8 @@ func TestAccKsyunPrivateDnsVpcAttachment_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_private_dns_zone_vpc_attachment.example",
-		Providers:     testAccProviders,
+		IDRefreshName:     "ksyun_private_dns_zone_vpc_attachment.example",
+		ProviderFactories: testAccProviderFactories,
 		// CheckDestroy:  testAccCheckPrivateDnsDestroy,
 
 		Steps: []resource.TestStep{
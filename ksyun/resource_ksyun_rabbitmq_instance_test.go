@@ -3,8 +3,8 @@ package ksyun
 import (
 	"errors"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 	"strings"
 	"testing"
@@ -16,8 +16,8 @@ func TestAccKsyunRabbitmqInstance_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckRabbitmqInstanceDestroy,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckRabbitmqInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRabbitmqInstanceConfig,
@@ -105,16 +105,16 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
 // instance
 func resourceRedisInstance() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceRedisInstanceCreate,
 		Delete: resourceRedisInstanceDelete,
 		Update: resourceRedisInstanceUpdate,
@@ -396,7 +396,7 @@ func resourceRedisInstance() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*KsyunClient).kcsv1conn
@@ -487,7 +487,7 @@ func resourceRedisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "redis-instance", false, true)
 		if err != nil {
@@ -594,7 +594,7 @@ func resourceRedisInstanceUpdate(d *schema.ResourceData, meta interface{}) (err
 	err = d.Set("reset_all_parameters", d.Get("reset_all_parameters"))
 
 	client := meta.(*KsyunClient)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagService := TagService{client}
 		tagCall, err := tagService.ReplaceResourcesTagsWithResourceCall(d, resourceKsyunKrds(), "redis-instance", false, true)
 		if err != nil {
@@ -646,7 +646,7 @@ func resourceRedisInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		Field: "capacity",
 	}
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		err = mergeTagsData(d, &item, meta.(*KsyunClient), "redis-instance")
 		if err != nil {
 			return fmt.Errorf("reading tags error: %s", err)
@@ -3,8 +3,8 @@ package ksyun
 import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 	"testing"
 )
@@ -14,9 +14,9 @@ func TestAccKcs_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_redis_instance.default",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckKcsDestroy,
+		IDRefreshName:     "ksyun_redis_instance.default",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKcsDestroy,
 		Steps: []resource.TestStep{
 			// 集群创建
 			{
@@ -40,7 +40,7 @@ func TestAccKcs_basic(t *testing.T) {
 	//		testAccPreCheck(t)
 	//	},
 	//	IDRefreshName: "ksyun_redis_instance.single",
-	//	Providers:     testAccProviders,
+	//	ProviderFactories: testAccProviderFactories,
 	//	CheckDestroy:  testAccCheckKcsDestroy,
 	//	Steps: []resource.TestStep{
 	//		// 主从创建
@@ -30,8 +30,10 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunRoute() *schema.Resource {
@@ -42,6 +44,9 @@ func resourceKsyunRoute() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:        schema.TypeString,
@@ -88,7 +93,7 @@ func resourceKsyunRoute() *schema.Resource {
 				Type:        schema.TypeString,
 				ForceNew:    true,
 				Optional:    true,
-				Description: "The id of the Peering, If route_type is Peering, This Field is Required.",
+				Description: "The id of the Peering, If route_type is Peering, This Field is Required. The route waits for the peering to be active until the create timeout.",
 			},
 			"direct_connect_gateway_id": {
 				Type:        schema.TypeString,
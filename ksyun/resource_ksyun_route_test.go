@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -15,9 +15,9 @@ func TestAccKsyunRoute_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_route.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckRouteDestroy,
+		IDRefreshName:     "ksyun_route.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckRouteDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -15,9 +15,9 @@ func TestAccKsyunScalingConfiguration_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_scaling_configuration.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckScalingConfigurationDestroy,
+		IDRefreshName:     "ksyun_scaling_configuration.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalingConfigurationDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -40,9 +40,9 @@ func TestAccKsyunScalingConfiguration_update(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_scaling_configuration.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckScalingConfigurationDestroy,
+		IDRefreshName:     "ksyun_scaling_configuration.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalingConfigurationDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -33,10 +33,11 @@ $ terraform import ksyun_scaling_group.example scaling-group-abc123456
 package ksyun
 
 import (
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 	"strconv"
 	"strings"
@@ -52,7 +53,7 @@ func resourceKsyunScalingGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		CustomizeDiff: func(diff *schema.ResourceDiff, i interface{}) (err error) {
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) (err error) {
 			if diff.HasChange("security_group_id") {
 				err = diff.SetNewComputed("security_group_id_set")
 			}
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"strings"
 	"testing"
 )
@@ -16,9 +16,9 @@ func TestAccKsyunScalingScheduledTask_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_scaling_scheduled_task.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckScalingScheduledTaskDestroy,
+		IDRefreshName:     "ksyun_scaling_scheduled_task.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalingScheduledTaskDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -41,9 +41,9 @@ func TestAccKsyunScalingScheduledTask_update(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_scaling_scheduled_task.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckScalingScheduledTaskDestroy,
+		IDRefreshName:     "ksyun_scaling_scheduled_task.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalingScheduledTaskDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -3,7 +3,7 @@ package ksyun
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccKsyunSecurityGroupEntryLite_basic(t *testing.T) {
@@ -12,9 +12,9 @@ func TestAccKsyunSecurityGroupEntryLite_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_security_group_entry_lite.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSecurityGroupEntryDestroy,
+		IDRefreshName:     "ksyun_security_group_entry_lite.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSecurityGroupEntryDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSecurityGroupEntryLiteConfig,
@@ -30,9 +30,9 @@ func TestAccKsyunSecurityGroupEntryLite_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_security_group_entry_lite.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSecurityGroupEntryDestroy,
+		IDRefreshName:     "ksyun_security_group_entry_lite.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSecurityGroupEntryDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSecurityGroupEntryLiteConfig,
@@ -2,8 +2,8 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 	"log"
 	"testing"
@@ -15,9 +15,9 @@ func TestAccKsyunSecurityGroupEntry_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_security_group_entry.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSecurityGroupEntryDestroy,
+		IDRefreshName:     "ksyun_security_group_entry.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSecurityGroupEntryDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSecurityGroupEntryConfig,
@@ -36,9 +36,9 @@ func TestAccKsyunSecurityGroupEntry_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_security_group_entry.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSecurityGroupEntryDestroy,
+		IDRefreshName:     "ksyun_security_group_entry.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSecurityGroupEntryDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSecurityGroupEntryConfig,
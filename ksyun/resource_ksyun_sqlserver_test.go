@@ -3,8 +3,8 @@ package ksyun
 import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"testing"
 )
 
@@ -16,9 +16,9 @@ func TestAccKsyunSqlServer_basic(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_sqlserver.ks-ss-233",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSqlServerDestroy,
+		IDRefreshName:     "ksyun_sqlserver.ks-ss-233",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSqlServerDestroy,
 
 		Steps: []resource.TestStep{
 			{
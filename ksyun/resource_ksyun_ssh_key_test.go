@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccKsyunSSHKey_basic(t *testing.T) {
@@ -14,9 +14,9 @@ func TestAccKsyunSSHKey_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_ssh_key.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSSHKeyDestroy,
+		IDRefreshName:     "ksyun_ssh_key.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSSHKeyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSSHKeyConfig,
@@ -35,9 +35,9 @@ func TestAccKsyunSSHKey_update(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		IDRefreshName: "ksyun_ssh_key.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSSHKeyDestroy,
+		IDRefreshName:     "ksyun_ssh_key.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSSHKeyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSSHKeyConfig,
@@ -4,20 +4,20 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunSubnet_basic(t *testing.T) {
 	var val map[string]interface{}
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
 
 		IDRefreshName: "ksyun_subnet.foo",
-		Providers:     testAccProviders,
 		CheckDestroy:  testAccCheckSubnetDestroy,
 
 		Steps: []resource.TestStep{
@@ -41,9 +41,9 @@ func TestAccKsyunSubnet_update(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_subnet.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckSubnetDestroy,
+		IDRefreshName:     "ksyun_subnet.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSubnetDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -66,6 +66,40 @@ func TestAccKsyunSubnet_update(t *testing.T) {
 	})
 }
 
+func TestUnitKsyunSubnet_basic(t *testing.T) {
+	var val map[string]interface{}
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSubnetDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitSubnetConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists("ksyun_subnet.foo", &val),
+					testAccCheckSubnetAttributes(&val),
+					resource.TestCheckResourceAttr("ksyun_subnet.foo", "subnet_name", "tf-unit-subnet"),
+					resource.TestCheckResourceAttr("ksyun_subnet.foo", "dhcp_ip_from", "10.7.0.2"),
+					resource.TestCheckResourceAttr("ksyun_subnet.foo", "dhcp_ip_to", "10.7.7.253"),
+					resource.TestCheckResourceAttr("ksyun_subnet.foo", "availability_zone", "cn-beijing-6a"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitSubnetUpdateConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubnetExists("ksyun_subnet.foo", &val),
+					resource.TestCheckResourceAttr("ksyun_subnet.foo", "subnet_name", "tf-unit-subnet-update"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSubnetExists(n string, val *map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -138,6 +172,40 @@ func testAccCheckSubnetDestroy(s *terraform.State) error {
 	return nil
 }
 
+const testUnitSubnetConfig = `
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-unit-vpc"
+  cidr_block = "10.7.0.0/21"
+}
+resource "ksyun_subnet" "foo" {
+  subnet_name       = "tf-unit-subnet"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Normal"
+  vpc_id            = "${ksyun_vpc.default.id}"
+  gateway_ip        = "10.7.0.1"
+  dns1              = "198.18.254.41"
+  dns2              = "198.18.254.40"
+  availability_zone = "cn-beijing-6a"
+}
+`
+
+const testUnitSubnetUpdateConfig = `
+resource "ksyun_vpc" "default" {
+  vpc_name   = "tf-unit-vpc"
+  cidr_block = "10.7.0.0/21"
+}
+resource "ksyun_subnet" "foo" {
+  subnet_name       = "tf-unit-subnet-update"
+  cidr_block        = "10.7.0.0/21"
+  subnet_type       = "Normal"
+  vpc_id            = "${ksyun_vpc.default.id}"
+  gateway_ip        = "10.7.0.1"
+  dns1              = "198.18.254.41"
+  dns2              = "198.18.254.40"
+  availability_zone = "cn-beijing-6a"
+}
+`
+
 const testAccSubnetConfig = `
 provider "ksyun" {
 	region = "cn-guangzhou-1"
@@ -25,8 +25,9 @@ $ terraform import ksyun_tag.kec_tag ${tag_key}:${tag_value},${resource_type}:${
 package ksyun
 
 import (
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunTag() *schema.Resource {
@@ -65,7 +66,7 @@ func resourceKsyunTag() *schema.Resource {
 }
 
 func resourceKsyunTagDiff() schema.CustomizeDiffFunc {
-	return func(diff *schema.ResourceDiff, i interface{}) (err error) {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) (err error) {
 		keys := []string{"key", "value", "resource_type", "resource_id"}
 
 		for _, k := range keys {
@@ -24,9 +24,10 @@ $ terraform import ksyun_tag_v2.tag ${tagv2_key}:${tagv2_value}
 package ksyun
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunTagv2() *schema.Resource {
@@ -55,7 +56,7 @@ func resourceKsyunTagv2() *schema.Resource {
 }
 
 func resourceKsyunTagv2Diff() schema.CustomizeDiffFunc {
-	return func(diff *schema.ResourceDiff, i interface{}) (err error) {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) (err error) {
 		keys := []string{"key", "value", "resource_type", "resource_id"}
 
 		for _, k := range keys {
@@ -34,9 +34,10 @@ $ terraform import ksyun_tag_v2_attachment.tag ${tag_key}:${tag_value},${resourc
 package ksyun
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunTagv2Attachment() *schema.Resource {
@@ -81,7 +82,7 @@ func resourceKsyunTagv2Attachment() *schema.Resource {
 }
 
 func resourceKsyunTagv2AttachmentDiff() schema.CustomizeDiffFunc {
-	return func(diff *schema.ResourceDiff, i interface{}) (err error) {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) (err error) {
 		keys := []string{"key", "value", "resource_type", "resource_id"}
 
 		for _, k := range keys {
@@ -35,13 +35,13 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKsyunVolume() *schema.Resource {
-	return &schema.Resource{
+	return withDefaultTags(&schema.Resource{
 		Create: resourceKsyunVolumeCreate,
 		Update: resourceKsyunVolumeUpdate,
 		Read:   resourceKsyunVolumeRead,
@@ -155,7 +155,7 @@ func resourceKsyunVolume() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
-	}
+	})
 }
 
 func resourceKsyunVolumeCreate(d *schema.ResourceData, meta interface{}) (err error) {
@@ -5,9 +5,9 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccKsyunVolumeAttach_basic(t *testing.T) {
@@ -15,8 +15,8 @@ func TestAccKsyunVolumeAttach_basic(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckVolumeAttachDestory,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVolumeAttachDestory,
 		Steps: []resource.TestStep{
 			{
 				Config: attachConfig,
@@ -0,0 +1,170 @@
+/*
+Provides a VPC peering connection resource, which connects two VPCs of the same or different accounts and regions.
+
+~> **NOTE:** The peering connection between the VPCs of different accounts must be accepted by the peer account,
+use `ksyun_vpc_peering_connection_accepter` with the provider of the peer account to accept it.
+
+# Example Usage
+
+```hcl
+resource "ksyun_vpc" "requester" {
+  vpc_name   = "tf-peering-requester"
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "ksyun_vpc" "accepter" {
+  vpc_name   = "tf-peering-accepter"
+  cidr_block = "10.2.0.0/16"
+}
+
+resource "ksyun_vpc_peering_connection" "foo" {
+  vpc_id       = ksyun_vpc.requester.id
+  peer_vpc_id  = ksyun_vpc.accepter.id
+  peering_name = "tf-peering"
+  auto_accept  = true
+}
+
+resource "ksyun_route" "foo" {
+  vpc_id                    = ksyun_vpc.requester.id
+  destination_cidr_block    = ksyun_vpc.accepter.cidr_block
+  route_type                = "Peering"
+  vpc_peering_connection_id = ksyun_vpc_peering_connection.foo.id
+}
+```
+
+# Import
+
+VPC peering connection can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_vpc_peering_connection.foo 7b1c7a4f-8e8a-4f1a-9b5e-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceKsyunVpcPeeringConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunVpcPeeringConnectionCreate,
+		Read:   resourceKsyunVpcPeeringConnectionRead,
+		Update: resourceKsyunVpcPeeringConnectionUpdate,
+		Delete: resourceKsyunVpcPeeringConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the requester VPC.",
+			},
+			"peer_vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the accepter VPC.",
+			},
+			"peer_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region of the accepter VPC, it's the region of the provider if not set.",
+			},
+			"peer_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The account ID of the accepter VPC, it's the account of the provider if not set.",
+			},
+			"peering_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the peering connection.",
+			},
+			"band_width": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The bandwidth of the peering connection in Mbps, it only takes effect on the peering connection across regions.",
+			},
+			"auto_accept": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to accept the peering connection after it's created, only the peering connection of the same account can be accepted by the requester.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the requester VPC.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account ID of the requester VPC.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the peering connection, such as pending-acceptance and active.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the peering connection.",
+			},
+		},
+	}
+}
+
+func resourceKsyunVpcPeeringConnectionCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	vpcService := VpcService{meta.(*KsyunClient)}
+	err = vpcService.CreateVpcPeeringConnection(d, resourceKsyunVpcPeeringConnection())
+	if err != nil {
+		return fmt.Errorf("error on creating vpc peering connection %q, %s", d.Id(), err)
+	}
+	return resourceKsyunVpcPeeringConnectionRead(d, meta)
+}
+
+func resourceKsyunVpcPeeringConnectionRead(d *schema.ResourceData, meta interface{}) (err error) {
+	vpcService := VpcService{meta.(*KsyunClient)}
+	err = vpcService.ReadAndSetVpcPeeringConnection(d, resourceKsyunVpcPeeringConnection())
+	if err != nil {
+		return fmt.Errorf("error on reading vpc peering connection %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunVpcPeeringConnectionUpdate(d *schema.ResourceData, meta interface{}) (err error) {
+	vpcService := VpcService{meta.(*KsyunClient)}
+	err = vpcService.ModifyVpcPeeringConnection(d, resourceKsyunVpcPeeringConnection())
+	if err != nil {
+		return fmt.Errorf("error on updating vpc peering connection %q, %s", d.Id(), err)
+	}
+	return resourceKsyunVpcPeeringConnectionRead(d, meta)
+}
+
+func resourceKsyunVpcPeeringConnectionDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	vpcService := VpcService{meta.(*KsyunClient)}
+	err = vpcService.RemoveVpcPeeringConnection(d)
+	if err != nil {
+		return fmt.Errorf("error on deleting vpc peering connection %q, %s", d.Id(), err)
+	}
+	return err
+}
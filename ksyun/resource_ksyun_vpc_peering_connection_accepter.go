@@ -0,0 +1,138 @@
+/*
+Provides a resource to accept the VPC peering connection requested by another account.
+
+~> **NOTE:** Destroying the resource only removes it from the state, the peering connection is deleted by the requester.
+
+# Example Usage
+
+```hcl
+provider "ksyun" {
+  alias  = "peer"
+  region = "cn-beijing-6"
+}
+
+resource "ksyun_vpc_peering_connection" "foo" {
+  vpc_id          = "5f3d4e1a-6c2b-4a7e-8f9d-xxxxxxxxxxxx"
+  peer_vpc_id     = "9a8b7c6d-5e4f-4a3b-2c1d-xxxxxxxxxxxx"
+  peer_account_id = "2000012345"
+}
+
+resource "ksyun_vpc_peering_connection_accepter" "foo" {
+  provider                  = ksyun.peer
+  vpc_peering_connection_id = ksyun_vpc_peering_connection.foo.id
+}
+```
+
+# Import
+
+VPC peering connection accepter can be imported using the `id`, e.g.
+
+```
+$ terraform import ksyun_vpc_peering_connection_accepter.foo 7b1c7a4f-8e8a-4f1a-9b5e-xxxxxxxxxxxx
+```
+*/
+
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKsyunVpcPeeringConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKsyunVpcPeeringConnectionAccepterCreate,
+		Read:   resourceKsyunVpcPeeringConnectionAccepterRead,
+		Delete: resourceKsyunVpcPeeringConnectionAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"vpc_peering_connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the peering connection to accept.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the requester VPC.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the requester VPC.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account ID of the requester VPC.",
+			},
+			"peer_vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the accepter VPC.",
+			},
+			"peer_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the accepter VPC.",
+			},
+			"peer_account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account ID of the accepter VPC.",
+			},
+			"peering_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the peering connection.",
+			},
+			"band_width": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The bandwidth of the peering connection in Mbps.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the peering connection.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of creation of the peering connection.",
+			},
+		},
+	}
+}
+
+func resourceKsyunVpcPeeringConnectionAccepterCreate(d *schema.ResourceData, meta interface{}) (err error) {
+	vpcService := VpcService{meta.(*KsyunClient)}
+	err = vpcService.CreateVpcPeeringConnectionAccepter(d)
+	if err != nil {
+		return fmt.Errorf("error on accepting vpc peering connection %q, %s", d.Get("vpc_peering_connection_id"), err)
+	}
+	return resourceKsyunVpcPeeringConnectionAccepterRead(d, meta)
+}
+
+func resourceKsyunVpcPeeringConnectionAccepterRead(d *schema.ResourceData, meta interface{}) (err error) {
+	vpcService := VpcService{meta.(*KsyunClient)}
+	err = vpcService.ReadAndSetVpcPeeringConnectionAccepter(d, resourceKsyunVpcPeeringConnectionAccepter())
+	if err != nil {
+		return fmt.Errorf("error on reading vpc peering connection accepter %q, %s", d.Id(), err)
+	}
+	return err
+}
+
+func resourceKsyunVpcPeeringConnectionAccepterDelete(d *schema.ResourceData, meta interface{}) (err error) {
+	// the peering connection is owned by the requester, so it's only removed from the state
+	d.SetId("")
+	return err
+}
@@ -0,0 +1,303 @@
+package ksyun
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
+)
+
+const testAccVpcPeeringConnectionConfig = `
+resource "ksyun_vpc" "requester" {
+  vpc_name   = "tf-acc-peering-requester"
+  cidr_block = "10.11.0.0/16"
+}
+
+resource "ksyun_vpc" "accepter" {
+  vpc_name   = "tf-acc-peering-accepter"
+  cidr_block = "10.12.0.0/16"
+}
+
+resource "ksyun_vpc_peering_connection" "foo" {
+  vpc_id       = ksyun_vpc.requester.id
+  peer_vpc_id  = ksyun_vpc.accepter.id
+  peering_name = "tf-acc-peering"
+  auto_accept  = true
+}
+
+resource "ksyun_route" "foo" {
+  vpc_id                    = ksyun_vpc.requester.id
+  destination_cidr_block    = ksyun_vpc.accepter.cidr_block
+  route_type                = "Peering"
+  vpc_peering_connection_id = ksyun_vpc_peering_connection.foo.id
+}
+`
+
+func TestAccKsyunVpcPeeringConnection_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName:     "ksyun_vpc_peering_connection.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcPeeringConnectionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionExists("ksyun_vpc_peering_connection.foo"),
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "state", "active"),
+					testAccCheckIDExists("ksyun_route.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitKsyunVpcPeeringConnection_basic(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitVpcPeeringConnectionConfig("tf-unit-peering"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcPeeringConnectionExists("ksyun_vpc_peering_connection.foo"),
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "state", "active"),
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "peering_name", "tf-unit-peering"),
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "peer_region", mockapi.DefaultRegion),
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "peer_account_id", mockapi.DefaultAccountId),
+					resource.TestCheckResourceAttrPair("ksyun_route.foo", "vpc_peering_connection_id",
+						"ksyun_vpc_peering_connection.foo", "id"),
+					resource.TestCheckResourceAttr("data.ksyun_vpc_peering_connections.foo", "total_count", "1"),
+					resource.TestCheckResourceAttrPair("data.ksyun_vpc_peering_connections.foo", "vpc_peering_connections.0.peer_vpc_id",
+						"ksyun_vpc.accepter", "id"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitVpcPeeringConnectionConfig("tf-unit-peering-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "peering_name", "tf-unit-peering-renamed"),
+				),
+			},
+			{
+				Config:                  testMockApiProviderConfig(server) + testUnitVpcPeeringConnectionConfig("tf-unit-peering-renamed"),
+				ResourceName:            "ksyun_vpc_peering_connection.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_accept"},
+			},
+		},
+	})
+}
+
+func TestUnitKsyunVpcPeeringConnection_accepter(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	config := testMockApiProviderConfig(server) + `
+resource "ksyun_vpc" "requester" {
+  vpc_name   = "tf-unit-peering-requester"
+  cidr_block = "10.11.0.0/16"
+}
+
+resource "ksyun_vpc" "accepter" {
+  vpc_name   = "tf-unit-peering-accepter"
+  cidr_block = "10.12.0.0/16"
+}
+
+resource "ksyun_vpc_peering_connection" "foo" {
+  vpc_id      = ksyun_vpc.requester.id
+  peer_vpc_id = ksyun_vpc.accepter.id
+}
+
+resource "ksyun_vpc_peering_connection_accepter" "foo" {
+  vpc_peering_connection_id = ksyun_vpc_peering_connection.foo.id
+}
+`
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "state", "pending-acceptance"),
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection_accepter.foo", "state", "active"),
+					resource.TestCheckResourceAttrPair("ksyun_vpc_peering_connection_accepter.foo", "id",
+						"ksyun_vpc_peering_connection.foo", "id"),
+					resource.TestCheckResourceAttrPair("ksyun_vpc_peering_connection_accepter.foo", "vpc_id",
+						"ksyun_vpc.requester", "id"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ksyun_vpc_peering_connection.foo", "state", "active"),
+				),
+			},
+			{
+				Config:            config,
+				ResourceName:      "ksyun_vpc_peering_connection_accepter.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestUnitKsyunRoute_peeringAccepted creates the route together with the accepter,
+// the route waits for the peering connection to be accepted.
+func TestUnitKsyunRoute_peeringAccepted(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitRoutePeeringConfig + testUnitRouteConfig + `
+resource "ksyun_vpc_peering_connection_accepter" "foo" {
+  vpc_peering_connection_id = ksyun_vpc_peering_connection.foo.id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("ksyun_route.foo"),
+					resource.TestCheckResourceAttrPair("ksyun_route.foo", "vpc_peering_connection_id", "ksyun_vpc_peering_connection.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+// TestUnitKsyunRoute_peeringRejected fails the route to a peering connection rejected after it's created,
+// without waiting for the timeout.
+func TestUnitKsyunRoute_peeringRejected(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitRoutePeeringConfig,
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitRoutePeeringConfig + testUnitRouteConfig,
+				PreConfig: func() {
+					describe := server.Handler("DescribeVpcPeeringConnections")
+					server.Handle("DescribeVpcPeeringConnections", func(s *mockapi.Server, p mockapi.Params) (map[string]interface{}, error) {
+						resp, err := describe(s, p)
+						if err != nil {
+							return nil, err
+						}
+						for _, peering := range resp["VpcPeeringConnectionSet"].([]interface{}) {
+							if peering := peering.(map[string]interface{}); peering["State"] == "pending-acceptance" {
+								peering["State"] = "rejected"
+							}
+						}
+						return resp, nil
+					})
+				},
+				ExpectError: regexp.MustCompile("must be active when RouteType is Peering, .*state:rejected"),
+			},
+		},
+	})
+}
+
+const testUnitRoutePeeringConfig = `
+resource "ksyun_vpc" "requester" {
+  vpc_name   = "tf-unit-peering-requester"
+  cidr_block = "10.11.0.0/16"
+}
+
+resource "ksyun_vpc" "accepter" {
+  vpc_name   = "tf-unit-peering-accepter"
+  cidr_block = "10.12.0.0/16"
+}
+
+resource "ksyun_vpc_peering_connection" "foo" {
+  vpc_id      = ksyun_vpc.requester.id
+  peer_vpc_id = ksyun_vpc.accepter.id
+}
+`
+
+const testUnitRouteConfig = `
+resource "ksyun_route" "foo" {
+  vpc_id                    = ksyun_vpc.requester.id
+  destination_cidr_block    = ksyun_vpc.accepter.cidr_block
+  route_type                = "Peering"
+  vpc_peering_connection_id = ksyun_vpc_peering_connection.foo.id
+}
+`
+
+func testUnitVpcPeeringConnectionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "ksyun_vpc" "requester" {
+  vpc_name   = "tf-unit-peering-requester"
+  cidr_block = "10.11.0.0/16"
+}
+
+resource "ksyun_vpc" "accepter" {
+  vpc_name   = "tf-unit-peering-accepter"
+  cidr_block = "10.12.0.0/16"
+}
+
+resource "ksyun_vpc_peering_connection" "foo" {
+  vpc_id       = ksyun_vpc.requester.id
+  peer_vpc_id  = ksyun_vpc.accepter.id
+  peering_name = "%s"
+  auto_accept  = true
+}
+
+resource "ksyun_route" "foo" {
+  vpc_id                    = ksyun_vpc.requester.id
+  destination_cidr_block    = ksyun_vpc.accepter.cidr_block
+  route_type                = "Peering"
+  vpc_peering_connection_id = ksyun_vpc_peering_connection.foo.id
+}
+
+data "ksyun_vpc_peering_connections" "foo" {
+  vpc_id = [ksyun_vpc_peering_connection.foo.vpc_id]
+  state  = ["active"]
+}
+`, name)
+}
+
+func testAccCheckVpcPeeringConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("vpc peering connection id is empty")
+		}
+		vpcService := VpcService{testAccProvider.Meta().(*KsyunClient)}
+		_, err := vpcService.ReadVpcPeeringConnection(nil, rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckVpcPeeringConnectionDestroy(s *terraform.State) error {
+	vpcService := VpcService{testAccProvider.Meta().(*KsyunClient)}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ksyun_vpc_peering_connection" {
+			continue
+		}
+		_, err := vpcService.ReadVpcPeeringConnection(nil, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("vpc peering connection still exists: %s", rs.Primary.ID)
+		}
+		if !notFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,20 +4,20 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mockapi"
 )
 
 func TestAccKsyunVPC_basic(t *testing.T) {
 	var val map[string]interface{}
 
-	resource.ParallelTest(t, resource.TestCase{
+	testAccCassetteTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
 		},
 
 		IDRefreshName: "ksyun_vpc.foo",
-		Providers:     testAccProviders,
 		CheckDestroy:  testAccCheckVPCDestroy,
 
 		Steps: []resource.TestStep{
@@ -44,9 +44,9 @@ func TestAccKsyunVPC_update(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_vpc.foo",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckVPCDestroy,
+		IDRefreshName:     "ksyun_vpc.foo",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVPCDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -72,6 +72,39 @@ func TestAccKsyunVPC_update(t *testing.T) {
 	})
 }
 
+func TestUnitKsyunVPC_basic(t *testing.T) {
+	var val map[string]interface{}
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	unitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVPCDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testMockApiProviderConfig(server) + testUnitVPCConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCExists("ksyun_vpc.foo", &val),
+					testAccCheckVPCAttributes(&val),
+					resource.TestCheckResourceAttr("ksyun_vpc.foo", "vpc_name", "tf-unit-vpc"),
+					resource.TestCheckResourceAttr("ksyun_vpc.foo", "cidr_block", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr("ksyun_vpc.foo", "ipv6_cidr_block_association_set.#", "1"),
+				),
+			},
+			{
+				Config: testMockApiProviderConfig(server) + testUnitVPCConfigUpdate,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCExists("ksyun_vpc.foo", &val),
+					resource.TestCheckResourceAttr("ksyun_vpc.foo", "vpc_name", "tf-unit-vpc-update"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVPCExists(n string, val *map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -144,6 +177,22 @@ func testAccCheckVPCDestroy(s *terraform.State) error {
 	return nil
 }
 
+const testUnitVPCConfig = `
+resource "ksyun_vpc" "foo" {
+	vpc_name                 = "tf-unit-vpc"
+	cidr_block               = "192.168.0.0/16"
+	provided_ipv6_cidr_block = true
+}
+`
+
+const testUnitVPCConfigUpdate = `
+resource "ksyun_vpc" "foo" {
+	vpc_name                 = "tf-unit-vpc-update"
+	cidr_block               = "192.168.0.0/16"
+	provided_ipv6_cidr_block = true
+}
+`
+
 const testAccVPCConfig = `
 provider "ksyun" {
 	region = "cn-guangzhou-1"
@@ -26,8 +26,8 @@ package ksyun
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceKsyunVpnCustomerGateway() *schema.Resource {
@@ -60,12 +60,6 @@ func resourceKsyunVpnCustomerGateway() *schema.Resource {
 				Description: "The ha customer gateway address of the vpn customer gateway.",
 			},
 
-			"customer_gateway_mame": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The name of the vpn customer gateway. **Warning this field was removed**.",
-				Removed:     "this field has been replaced by `customer_gateway_name`.",
-			},
 			"customer_gateway_name": {
 				Type:        schema.TypeString,
 				Required:    true,
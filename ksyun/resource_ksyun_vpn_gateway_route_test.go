@@ -5,7 +5,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func Test_resourceKsyunVpnGatewayRoute(t *testing.T) {
@@ -14,9 +14,9 @@ func Test_resourceKsyunVpnGatewayRoute(t *testing.T) {
 			testAccPreCheck(t)
 		},
 
-		IDRefreshName: "ksyun_vpn_gateway_route.default",
-		Providers:     testAccProviders,
-		CheckDestroy:  testAccCheckVPCDestroy,
+		IDRefreshName:     "ksyun_vpn_gateway_route.default",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVPCDestroy,
 
 		Steps: []resource.TestStep{
 			{
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/helper"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
@@ -306,6 +306,7 @@ func (s *BareMetalService) CreateBareMetalCall(d *schema.ResourceData, resource
 		},
 		"force_re_install": {Ignore: true},
 		"tags":             {Ignore: true},
+		"tags_all":         {Ignore: true},
 	}
 	req, err := SdkRequestAutoMapping(d, resource, false, transform, nil, SdkReqParameter{
 		onlyTransform: false,
@@ -597,6 +598,7 @@ func (s *BareMetalService) ReinstallBareMetalCall(d *schema.ResourceData, resour
 		"host_status":                    {Ignore: true},
 		"roce_network":                   {Ignore: true},
 		"tags":                           {Ignore: true},
+		"tags_all":                       {Ignore: true},
 		"storage_roce_network_card_name": {Ignore: true},
 	}
 	if d.HasChange("force_re_install") && d.Get("force_re_install").(bool) {
@@ -5,8 +5,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -55,7 +55,7 @@ func (s *BwsService) ReadBandWidthShare(d *schema.ResourceData, bwsId string) (d
 		return data, err
 	}
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		req["IsContainTag"] = true
 	}
 
@@ -261,7 +261,7 @@ func (s *BwsService) ModifyBandWidthShare(d *schema.ResourceData, r *schema.Reso
 		return err
 	}
 	calls = append(calls, call)
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		tagsService := TagService{client: s.client}
 		tagsCall, err := tagsService.ReplaceResourcesTagsWithResourceCall(d, r, "bws", true, false)
 		if err != nil {
@@ -2,10 +2,11 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
 type CenService struct {
@@ -212,3 +213,651 @@ func (s *CenService) RemoveCen(d *schema.ResourceData) (err error) {
 	}
 	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
 }
+
+func (s *CenService) readCenSet(action, setName string, condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	if condition == nil {
+		condition = map[string]interface{}{}
+	}
+	resp, err = sendActionRequest(s.client.cenconn.Client, "GET", action, condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue(setName, *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = results.([]interface{})
+	return data, err
+}
+
+func (s *CenService) waitCenState(refresh resource.StateRefreshFunc, target []string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{},
+		Target:       target,
+		Refresh:      refresh,
+		Timeout:      timeout,
+		PollInterval: 2 * time.Second,
+		Delay:        1 * time.Second,
+		MinTimeout:   1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// waitCenDeleted waits until the read function reports that the object is not found
+func (s *CenService) waitCenDeleted(read func() error, timeout time.Duration) (err error) {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		callErr := read()
+		if callErr == nil {
+			return resource.RetryableError(fmt.Errorf("the object is still being deleted"))
+		}
+		if notFoundError(callErr) {
+			return nil
+		}
+		return resource.NonRetryableError(callErr)
+	})
+}
+
+func (s *CenService) ReadCenInstances(condition map[string]interface{}) (data []interface{}, err error) {
+	return s.readCenSet("DescribeCenInstances", "CenInstanceSet", condition)
+}
+
+func (s *CenService) ReadCenInstance(cenId, instanceId string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	results, err = s.ReadCenInstances(map[string]interface{}{
+		"CenId":            cenId,
+		"Filter.1.Name":    "instance-id",
+		"Filter.1.Value.1": instanceId,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if item := v.(map[string]interface{}); item["InstanceId"] == instanceId {
+			data = item
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("CenInstance %s not exist in cen %s ", instanceId, cenId)
+	}
+	return data, err
+}
+
+func (s *CenService) ReadAndSetCenInstanceAttachment(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadCenInstance(d.Get("cen_id").(string), d.Get("instance_id").(string))
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+func (s *CenService) ReadAndSetCenInstances(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"instance_type": {
+			mapping: "instance-type",
+			Type:    TransformWithFilter,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadCenInstances(req)
+	if err != nil {
+		return err
+	}
+
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		idFiled:     "InstanceId",
+		targetField: "instances",
+		extra: map[string]SdkResponseMapping{
+			"InstanceId": {
+				Field:    "id",
+				KeepAuto: true,
+			},
+		},
+	})
+}
+
+func (s *CenService) checkCenInstanceState(cenId, instanceId string, target []string, timeout time.Duration) (err error) {
+	return s.waitCenState(func() (interface{}, string, error) {
+		data, err := s.ReadCenInstance(cenId, instanceId)
+		if err != nil {
+			return nil, "", err
+		}
+		state := fmt.Sprintf("%v", data["Status"])
+		if state == "Failed" {
+			return nil, "", fmt.Errorf("cen instance %s state error, state:%v", instanceId, state)
+		}
+		return data, state, nil
+	}, target, timeout)
+}
+
+func cenInstanceReq(d *schema.ResourceData) map[string]interface{} {
+	req := map[string]interface{}{
+		"CenId":                   d.Get("cen_id"),
+		"Instance.1.InstanceType": d.Get("instance_type"),
+		"Instance.1.InstanceId":   d.Get("instance_id"),
+	}
+	if v, ok := d.GetOk("instance_region"); ok {
+		req["Instance.1.InstanceRegion"] = v
+	}
+	if v, ok := d.GetOk("instance_account_id"); ok {
+		req["Instance.1.InstanceAccountId"] = v
+	}
+	return req
+}
+
+func (s *CenService) AttachCenInstanceCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	req := cenInstanceReq(d)
+	callback = ApiCall{
+		param:  &req,
+		action: "AttachCenInstances",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			cenId := d.Get("cen_id").(string)
+			instanceId := d.Get("instance_id").(string)
+			d.SetId(AssembleIds(cenId, instanceId))
+			return s.checkCenInstanceState(cenId, instanceId, []string{"Attached"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *CenService) AttachCenInstance(d *schema.ResourceData) (err error) {
+	call, err := s.AttachCenInstanceCall(d)
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *CenService) DetachCenInstanceCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	req := cenInstanceReq(d)
+	cenId := d.Get("cen_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	callback = ApiCall{
+		param:  &req,
+		action: "DetachCenInstances",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+				_, callErr := s.ReadCenInstance(cenId, instanceId)
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					}
+					return resource.NonRetryableError(fmt.Errorf("error on reading cen instance when detach %q, %s", d.Id(), callErr))
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return s.waitCenDeleted(func() error {
+				_, err := s.ReadCenInstance(cenId, instanceId)
+				return err
+			}, d.Timeout(schema.TimeoutDelete))
+		},
+	}
+	return callback, err
+}
+
+func (s *CenService) DetachCenInstance(d *schema.ResourceData) (err error) {
+	call, err := s.DetachCenInstanceCall(d)
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *CenService) ReadCenBandwidthPackages(condition map[string]interface{}) (data []interface{}, err error) {
+	return s.readCenSet("DescribeCenBandwidthPackages", "CenBandwidthPackageSet", condition)
+}
+
+func (s *CenService) ReadCenBandwidthPackage(d *schema.ResourceData, packageId string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	if packageId == "" {
+		packageId = d.Id()
+	}
+	results, err = s.ReadCenBandwidthPackages(map[string]interface{}{
+		"CenBandwidthPackageId.1": packageId,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("CenBandwidthPackage %s not exist ", packageId)
+	}
+	return data, err
+}
+
+func (s *CenService) ReadAndSetCenBandwidthPackage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadCenBandwidthPackage(d, "")
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+func (s *CenService) CreateCenBandwidthPackageCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"cen_id": {Ignore: true},
+	}
+	req, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: "CreateCenBandwidthPackage",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("CenBandwidthPackageId", *resp)
+			if err != nil {
+				return err
+			}
+			d.SetId(id.(string))
+			return err
+		},
+	}
+	return callback, err
+}
+
+// CenBandwidthPackageAssociationCall associates the bandwidth package with the cen or disassociates it,
+// the id of the package is taken from d when it's created in the same run.
+func (s *CenService) CenBandwidthPackageAssociationCall(cenId string, associate bool) (callback ApiCall, err error) {
+	action := "DisassociateCenBandwidthPackage"
+	if associate {
+		action = "AssociateCenBandwidthPackage"
+	}
+	req := map[string]interface{}{
+		"CenId": cenId,
+	}
+	callback = ApiCall{
+		param:         &req,
+		action:        action,
+		disableDryRun: true,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			(*call.param)["CenBandwidthPackageId"] = d.Id()
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *CenService) CreateCenBandwidthPackage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	var (
+		calls []ApiCall
+		call  ApiCall
+	)
+	call, err = s.CreateCenBandwidthPackageCall(d, r)
+	if err != nil {
+		return err
+	}
+	calls = append(calls, call)
+	if cenId, ok := d.GetOk("cen_id"); ok {
+		call, err = s.CenBandwidthPackageAssociationCall(cenId.(string), true)
+		if err != nil {
+			return err
+		}
+		calls = append(calls, call)
+	}
+	return ksyunApiCallNew(calls, d, s.client, true)
+}
+
+func (s *CenService) ModifyCenBandwidthPackageCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"cen_id": {Ignore: true},
+	}
+	req, err := SdkRequestAutoMapping(d, r, true, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+	if len(req) > 0 {
+		req["CenBandwidthPackageId"] = d.Id()
+		callback = ApiCall{
+			param:  &req,
+			action: "ModifyCenBandwidthPackage",
+			executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+				logger.Debug(logger.RespFormat, call.action, *(call.param))
+				resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+				return resp, err
+			},
+			afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+				logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+				return err
+			},
+		}
+	}
+	return callback, err
+}
+
+func (s *CenService) ModifyCenBandwidthPackage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	var (
+		calls []ApiCall
+		call  ApiCall
+	)
+	call, err = s.ModifyCenBandwidthPackageCall(d, r)
+	if err != nil {
+		return err
+	}
+	calls = append(calls, call)
+	if d.HasChange("cen_id") {
+		o, n := d.GetChange("cen_id")
+		if o.(string) != "" {
+			call, err = s.CenBandwidthPackageAssociationCall(o.(string), false)
+			if err != nil {
+				return err
+			}
+			calls = append(calls, call)
+		}
+		if n.(string) != "" {
+			call, err = s.CenBandwidthPackageAssociationCall(n.(string), true)
+			if err != nil {
+				return err
+			}
+			calls = append(calls, call)
+		}
+	}
+	return ksyunApiCallNew(calls, d, s.client, true)
+}
+
+func (s *CenService) RemoveCenBandwidthPackageCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"CenBandwidthPackageId": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteCenBandwidthPackage",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadCenBandwidthPackage(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					}
+					return resource.NonRetryableError(fmt.Errorf("error on reading cen bandwidth package when delete %q, %s", d.Id(), callErr))
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *CenService) RemoveCenBandwidthPackage(d *schema.ResourceData) (err error) {
+	var (
+		calls []ApiCall
+		call  ApiCall
+	)
+	// the package must be disassociated from the cen before it's deleted
+	if cenId, ok := d.GetOk("cen_id"); ok {
+		call, err = s.CenBandwidthPackageAssociationCall(cenId.(string), false)
+		if err != nil {
+			return err
+		}
+		calls = append(calls, call)
+	}
+	call, err = s.RemoveCenBandwidthPackageCall(d)
+	if err != nil {
+		return err
+	}
+	calls = append(calls, call)
+	return ksyunApiCallNew(calls, d, s.client, true)
+}
+
+func (s *CenService) ReadCenRegionBandwidthLimit(cenId, localRegion, oppositeRegion string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	results, err = s.readCenSet("DescribeCenRegionBandwidthLimits", "CenRegionBandwidthLimitSet", map[string]interface{}{
+		"CenId": cenId,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		item := v.(map[string]interface{})
+		if item["LocalRegion"] == localRegion && item["OppositeRegion"] == oppositeRegion {
+			data = item
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("CenRegionBandwidthLimit %s to %s not exist in cen %s ", localRegion, oppositeRegion, cenId)
+	}
+	return data, err
+}
+
+func (s *CenService) ReadAndSetCenRegionBandwidthLimit(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadCenRegionBandwidthLimit(d.Get("cen_id").(string), d.Get("local_region").(string), d.Get("opposite_region").(string))
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+func (s *CenService) cenRegionBandwidthLimitCall(d *schema.ResourceData, action string) (callback ApiCall, err error) {
+	req := map[string]interface{}{
+		"CenId":          d.Get("cen_id"),
+		"LocalRegion":    d.Get("local_region"),
+		"OppositeRegion": d.Get("opposite_region"),
+	}
+	if action != "DeleteCenRegionBandwidthLimit" {
+		req["BandWidth"] = d.Get("band_width")
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			if call.action == "CreateCenRegionBandwidthLimit" {
+				d.SetId(AssembleIds(d.Get("cen_id").(string), d.Get("local_region").(string), d.Get("opposite_region").(string)))
+			}
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *CenService) CreateCenRegionBandwidthLimit(d *schema.ResourceData) (err error) {
+	call, err := s.cenRegionBandwidthLimitCall(d, "CreateCenRegionBandwidthLimit")
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *CenService) ModifyCenRegionBandwidthLimit(d *schema.ResourceData) (err error) {
+	if !d.HasChange("band_width") {
+		return err
+	}
+	call, err := s.cenRegionBandwidthLimitCall(d, "ModifyCenRegionBandwidthLimit")
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *CenService) RemoveCenRegionBandwidthLimit(d *schema.ResourceData) (err error) {
+	call, err := s.cenRegionBandwidthLimitCall(d, "DeleteCenRegionBandwidthLimit")
+	if err != nil {
+		return err
+	}
+	err = ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+	if err != nil && notFoundErrorNew(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *CenService) ReadCenGrant(cenId, instanceId string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	results, err = s.readCenSet("DescribeCenGrants", "CenGrantSet", map[string]interface{}{
+		"Filter.1.Name":    "instance-id",
+		"Filter.1.Value.1": instanceId,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		item := v.(map[string]interface{})
+		if item["CenId"] == cenId && item["InstanceId"] == instanceId {
+			data = item
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("CenGrant of instance %s to cen %s not exist ", instanceId, cenId)
+	}
+	return data, err
+}
+
+func (s *CenService) ReadAndSetCenGrant(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadCenGrant(d.Get("cen_id").(string), d.Get("instance_id").(string))
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+func (s *CenService) cenGrantCall(d *schema.ResourceData, action string) (callback ApiCall, err error) {
+	req := map[string]interface{}{
+		"CenId":        d.Get("cen_id"),
+		"CenAccountId": d.Get("cen_account_id"),
+		"InstanceType": d.Get("instance_type"),
+		"InstanceId":   d.Get("instance_id"),
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = sendActionRequest(client.cenconn.Client, "GET", call.action, *(call.param))
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			if call.action == "CreateCenGrant" {
+				d.SetId(AssembleIds(d.Get("cen_id").(string), d.Get("instance_id").(string)))
+			}
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *CenService) CreateCenGrant(d *schema.ResourceData) (err error) {
+	call, err := s.cenGrantCall(d, "CreateCenGrant")
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *CenService) RemoveCenGrant(d *schema.ResourceData) (err error) {
+	call, err := s.cenGrantCall(d, "DeleteCenGrant")
+	if err != nil {
+		return err
+	}
+	err = ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+	if err != nil && notFoundErrorNew(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *CenService) ReadAndSetCenRoutes(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"instance_id": {
+			mapping: "instance-id",
+			Type:    TransformWithFilter,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.readCenSet("DescribeCenRoutes", "CenRouteSet", req)
+	if err != nil {
+		return err
+	}
+
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		idFiled:     "DestinationCidrBlock",
+		targetField: "routes",
+		extra:       map[string]SdkResponseMapping{},
+	})
+}
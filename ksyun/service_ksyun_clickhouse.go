@@ -0,0 +1,329 @@
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type ClickhouseService struct {
+	client *KsyunClient
+}
+
+func (s *ClickhouseService) ReadInstance(instanceId string) (data map[string]interface{}, err error) {
+	req := map[string]interface{}{"InstanceId": instanceId}
+	action := "DescribeInstance"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := s.client.clickhouseconn.DescribeInstance(&req)
+	if err != nil {
+		return data, err
+	}
+	data, _ = (*resp)["Data"].(map[string]interface{})
+	if len(data) == 0 || data["InstanceId"] != instanceId {
+		return data, fmt.Errorf("clickhouse instance %s not exist ", instanceId)
+	}
+	return data, err
+}
+
+func (s *ClickhouseService) ReadAndSetInstance(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadInstance(d.Id())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	shards, _ := data["ShardList"].([]interface{})
+	extra := map[string]SdkResponseMapping{
+		// the name of the field is misspelled by the api
+		"InstacneConfig": {Field: "instance_config"},
+		"Az":             {Field: "availability_zone"},
+		// the ProductType is the numeric id of the product type
+		"ProductType":     {Field: "product_type_id"},
+		"ProductTypeName": {Field: "product_type"},
+	}
+	SdkResponseAutoResourceData(d, r, data, extra)
+	return d.Set("shard_num", len(shards))
+}
+
+func (s *ClickhouseService) CreateInstance(d *schema.ResourceData) (err error) {
+	req := map[string]interface{}{
+		"InstanceName":    d.Get("instance_name"),
+		"ProductType":     d.Get("product_type"),
+		"EngineVersion":   d.Get("engine_version"),
+		"InstanceConfig":  d.Get("instance_config"),
+		"ShardNum":        d.Get("shard_num"),
+		"EbsType":         d.Get("ebs_type"),
+		"EbsSize":         d.Get("ebs_size"),
+		"VpcId":           d.Get("vpc_id"),
+		"SubnetId":        d.Get("subnet_id"),
+		"Az":              d.Get("availability_zone"),
+		"AdminPassword":   d.Get("admin_password"),
+		"BillType":        d.Get("bill_type"),
+		"SecurityGroupId": d.Get("security_group_id"),
+	}
+	for k, v := range map[string]string{"Replicas": "replicas", "AdminUser": "admin_user", "ProjectId": "project_id"} {
+		if value, ok := d.GetOk(v); ok {
+			req[k] = value
+		}
+	}
+	action := "CreateInstance"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := s.client.clickhouseconn.CreateInstance(&req)
+	if err != nil {
+		return err
+	}
+	instanceId, err := getSdkValue("Data.InstanceId", *resp)
+	if err != nil {
+		return err
+	}
+	d.SetId(instanceId.(string))
+	return s.waitInstanceRunning(d.Id(), d.Timeout(schema.TimeoutCreate))
+}
+
+func (s *ClickhouseService) ModifyInstance(d *schema.ResourceData) (err error) {
+	if d.HasChange("project_id") {
+		param := map[string]interface{}{"ProjectId": d.Get("project_id")}
+		if err = ModifyProjectInstance(d.Id(), &param, s.client); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("instance_name") {
+		req := map[string]interface{}{
+			"InstanceId":   d.Id(),
+			"InstanceName": d.Get("instance_name"),
+		}
+		action := "RenameInstance"
+		logger.Debug(logger.ReqFormat, action, req)
+		if _, err = s.client.clickhouseconn.RenameInstance(&req); err != nil {
+			return err
+		}
+	}
+	if d.HasChanges("shard_num", "replicas") {
+		req := map[string]interface{}{
+			"InstanceId": d.Id(),
+			"ShardNum":   d.Get("shard_num"),
+			"Replicas":   d.Get("replicas"),
+		}
+		if _, err = sendActionRequest(s.client.clickhouseconn.Client, "POST", "ResizeInstance", req); err != nil {
+			return err
+		}
+		return s.waitInstanceRunning(d.Id(), d.Timeout(schema.TimeoutUpdate))
+	}
+	return err
+}
+
+func (s *ClickhouseService) DeleteInstance(d *schema.ResourceData) (err error) {
+	req := map[string]interface{}{"InstanceId": d.Id()}
+	action := "DeleteInstance"
+	logger.Debug(logger.ReqFormat, action, req)
+	if _, err = s.client.clickhouseconn.DeleteInstance(&req); err != nil {
+		if notFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, callErr := s.ReadInstance(d.Id())
+		if callErr == nil {
+			return resource.RetryableError(fmt.Errorf("the clickhouse instance %s is still being deleted", d.Id()))
+		}
+		if notFoundError(callErr) {
+			return nil
+		}
+		return resource.NonRetryableError(callErr)
+	})
+}
+
+func (s *ClickhouseService) waitInstanceRunning(instanceId string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{"running"},
+		Refresh: func() (interface{}, string, error) {
+			data, err := s.ReadInstance(instanceId)
+			if err != nil {
+				return nil, "", err
+			}
+			status := fmt.Sprintf("%v", data["Status"])
+			if status == "error" || status == "failed" {
+				return nil, "", fmt.Errorf("the clickhouse instance %s is %s", instanceId, status)
+			}
+			return data, status, nil
+		},
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+		Delay:        1 * time.Second,
+		MinTimeout:   1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *ClickhouseService) ReadAccount(instanceId, accountName string) (data map[string]interface{}, err error) {
+	req := map[string]interface{}{
+		"InstanceId":          instanceId,
+		"InstanceAccountName": accountName,
+	}
+	action := "DescribeInstanceAccounts"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := s.client.clickhouseconn.DescribeInstanceAccounts(&req)
+	if err != nil {
+		return data, err
+	}
+	accounts, _ := getSdkValue("Data.InstanceAccounts", *resp)
+	items, _ := accounts.([]interface{})
+	for _, v := range items {
+		if account := v.(map[string]interface{}); account["InstanceAccountName"] == accountName {
+			data = account
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("clickhouse account %s not exist in instance %s", accountName, instanceId)
+	}
+	return data, err
+}
+
+func (s *ClickhouseService) ReadAndSetAccount(d *schema.ResourceData) (err error) {
+	data, err := s.ReadAccount(d.Get("instance_id").(string), d.Get("account_name").(string))
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for k, v := range map[string]interface{}{
+		"account_description": data["InstanceAccountDescription"],
+		"account_type":        data["InstanceAccountType"],
+		"account_status":      data["InstanceAccountStatus"],
+	} {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *ClickhouseService) CreateAccount(d *schema.ResourceData) (err error) {
+	instanceId := d.Get("instance_id").(string)
+	accountName := d.Get("account_name").(string)
+	req := map[string]interface{}{
+		"InstanceId":              instanceId,
+		"InstanceAccountName":     accountName,
+		"InstanceAccountPassword": d.Get("account_password"),
+	}
+	if v, ok := d.GetOk("account_description"); ok {
+		req["InstanceAccountDescription"] = v
+	}
+	action := "CreateInstanceAccount"
+	logger.Debug(logger.ReqFormat, action, req)
+	if _, err = s.client.clickhouseconn.CreateInstanceAccount(&req); err != nil {
+		return err
+	}
+	d.SetId(AssembleIds(instanceId, accountName))
+	return err
+}
+
+func (s *ClickhouseService) ModifyAccount(d *schema.ResourceData) (err error) {
+	if !d.HasChanges("account_password", "account_description") {
+		return
+	}
+	req := map[string]interface{}{
+		"InstanceId":                 d.Get("instance_id"),
+		"InstanceAccountName":        d.Get("account_name"),
+		"InstanceAccountDescription": d.Get("account_description"),
+	}
+	if d.HasChange("account_password") {
+		req["InstanceAccountPassword"] = d.Get("account_password")
+	}
+	action := "ModifyInstanceAccountInfo"
+	logger.Debug(logger.ReqFormat, action, req)
+	_, err = s.client.clickhouseconn.ModifyInstanceAccountInfo(&req)
+	return err
+}
+
+func (s *ClickhouseService) DeleteAccount(d *schema.ResourceData) (err error) {
+	req := map[string]interface{}{
+		"InstanceId":          d.Get("instance_id"),
+		"InstanceAccountName": d.Get("account_name"),
+	}
+	action := "DeleteInstanceAccount"
+	logger.Debug(logger.ReqFormat, action, req)
+	_, err = s.client.clickhouseconn.DeleteInstanceAccount(&req)
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *ClickhouseService) ReadSecurityRule(securityGroupId, cidr string) (data map[string]interface{}, err error) {
+	req := map[string]interface{}{"SecurityGroupId": securityGroupId}
+	action := "DescribeSecurityGroup"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := s.client.clickhouseconn.DescribeSecurityGroup(&req)
+	if err != nil {
+		return data, err
+	}
+	rules, _ := getSdkValue("Data.SecurityGroupRules", *resp)
+	items, _ := rules.([]interface{})
+	for _, v := range items {
+		if rule := v.(map[string]interface{}); rule["Cidr"] == cidr {
+			data = rule
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("clickhouse security rule %s not exist in security group %s", cidr, securityGroupId)
+	}
+	return data, err
+}
+
+func (s *ClickhouseService) ReadAndSetSecurityRule(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadSecurityRule(d.Get("security_group_id").(string), d.Get("cidr").(string))
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return
+}
+
+func (s *ClickhouseService) CreateSecurityRule(d *schema.ResourceData) (err error) {
+	securityGroupId := d.Get("security_group_id").(string)
+	cidr := d.Get("cidr").(string)
+	req := map[string]interface{}{
+		"SecurityGroupId":               securityGroupId,
+		"SecurityGroupRules.1.Cidr":     cidr,
+		"SecurityGroupRules.1.Protocol": "IPv4",
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req["SecurityGroupRules.1.Description"] = v
+	}
+	action := "CreateSecurityRule"
+	logger.Debug(logger.ReqFormat, action, req)
+	if _, err = s.client.clickhouseconn.CreateSecurityRule(&req); err != nil {
+		return err
+	}
+	d.SetId(AssembleIds(securityGroupId, cidr))
+	return err
+}
+
+func (s *ClickhouseService) DeleteSecurityRule(d *schema.ResourceData) (err error) {
+	req := map[string]interface{}{
+		"SecurityGroupId":       d.Get("security_group_id"),
+		"SecurityGroupRuleId.1": d.Get("security_group_rule_id"),
+	}
+	action := "DeleteSecurityRule"
+	logger.Debug(logger.ReqFormat, action, req)
+	_, err = s.client.clickhouseconn.DeleteSecurityRule(&req)
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 
 	"github.com/KscSDK/ksc-sdk-go/service/kec"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 type DataGuardSrv struct {
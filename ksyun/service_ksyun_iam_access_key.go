@@ -0,0 +1,202 @@
+package ksyun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/encryption"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type IamAccessKeyService struct {
+	client *KsyunClient
+}
+
+func (s *IamAccessKeyService) ReadAccessKeys(userName string) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	condition := map[string]interface{}{
+		"UserName": userName,
+	}
+	conn := s.client.iamconn
+	action := "ListAccessKeys"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.ListAccessKeys(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("ListAccessKeysResult.AccessKeyMetadata.member", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = results.([]interface{})
+	return data, err
+}
+
+func (s *IamAccessKeyService) ReadAccessKey(userName, accessKeyId string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	results, err = s.ReadAccessKeys(userName)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		if item := v.(map[string]interface{}); item["AccessKeyId"] == accessKeyId {
+			data = item
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("AccessKey %s not exist of user %s ", accessKeyId, userName)
+	}
+	return data, err
+}
+
+func (s *IamAccessKeyService) ReadAndSetIamAccessKey(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadAccessKey(d.Get("user_name").(string), d.Id())
+	if err != nil {
+		if notFoundError(err) || isExpectError(err, []string{"UserNoSuchEntity"}) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return
+}
+
+// setIamAccessKeySecret keeps the secret in the state, it's encrypted by the pgp key if given,
+// the secret is only returned by the api on creating.
+func setIamAccessKeySecret(d *schema.ResourceData, secret string) (err error) {
+	if v, ok := d.GetOk("pgp_key"); ok {
+		var encryptionKey, fingerprint, encrypted string
+		encryptionKey, err = encryption.RetrieveGPGKey(v.(string))
+		if err != nil {
+			return err
+		}
+		fingerprint, encrypted, err = encryption.EncryptValue(encryptionKey, secret, "IAM Access Key Secret")
+		if err != nil {
+			return err
+		}
+		if err = d.Set("key_fingerprint", fingerprint); err != nil {
+			return err
+		}
+		return d.Set("encrypted_secret", encrypted)
+	}
+	return d.Set("secret", secret)
+}
+
+func (s *IamAccessKeyService) CreateIamAccessKey(d *schema.ResourceData) error {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	params := map[string]interface{}{
+		"UserName": d.Get("user_name"),
+	}
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "CreateAccessKey",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CreateAccessKey(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			var id, secret interface{}
+			id, err = getSdkValue("CreateAccessKeyResult.AccessKey.AccessKeyId", *resp)
+			if err != nil {
+				return err
+			}
+			secret, err = getSdkValue("CreateAccessKeyResult.AccessKey.SecretAccessKey", *resp)
+			if err != nil {
+				return err
+			}
+			logger.Debug(logger.RespFormat, call.action, *(call.param), id)
+			d.SetId(id.(string))
+			return setIamAccessKeySecret(d, secret.(string))
+		},
+	})
+	if d.Get("status") == "Inactive" {
+		call, err := s.UpdateIamAccessKeyCall(d)
+		if err != nil {
+			return err
+		}
+		apiProcess.PutCalls(call)
+	}
+	return apiProcess.Run()
+}
+
+func (s *IamAccessKeyService) UpdateIamAccessKeyCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	params := map[string]interface{}{
+		"UserName": d.Get("user_name"),
+		"Status":   d.Get("status"),
+	}
+	callback = ApiCall{
+		param:  &params,
+		action: "UpdateAccessKey",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			(*call.param)["AccessKeyId"] = d.Id()
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.UpdateAccessKey(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *IamAccessKeyService) ModifyIamAccessKey(d *schema.ResourceData) error {
+	if !d.HasChange("status") {
+		return nil
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	call, err := s.UpdateIamAccessKeyCall(d)
+	if err != nil {
+		return err
+	}
+	apiProcess.PutCalls(call)
+	return apiProcess.Run()
+}
+
+func (s *IamAccessKeyService) DeleteIamAccessKey(d *schema.ResourceData) error {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	params := map[string]interface{}{
+		"UserName":    d.Get("user_name"),
+		"AccessKeyId": d.Id(),
+	}
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "DeleteAccessKey",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.DeleteAccessKey(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(5*time.Minute, func() *resource.RetryError {
+				if notFoundError(baseErr) || isExpectError(baseErr, []string{
+					"UserNoSuchEntity",
+					"AccessKeyNoSuchEntity",
+				}) {
+					return nil
+				}
+				return resource.RetryableError(baseErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	})
+	return apiProcess.Run()
+}
@@ -2,10 +2,10 @@ package ksyun
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -72,7 +72,8 @@ func (s *IamGroupService) ReadAndSetIamGroup(d *schema.ResourceData, r *schema.R
 		}
 		return err
 	}
-	SdkResponseAutoResourceData(d, r, data, nil)
+	// GetGroup returns the only group
+	SdkResponseAutoResourceData(d, r, data[0], nil)
 
 	return
 }
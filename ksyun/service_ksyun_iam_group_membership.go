@@ -0,0 +1,176 @@
+package ksyun
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type IamGroupMembershipService struct {
+	client *KsyunClient
+}
+
+func (s *IamGroupMembershipService) ReadGroupsForUser(userName string) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	condition := map[string]interface{}{
+		"UserName": userName,
+		"MaxItems": 1000,
+	}
+	conn := s.client.iamconn
+	action := "ListGroupsForUser"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.ListGroupsForUser(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("ListGroupsForUserResult.Groups.member", *resp)
+	if err != nil {
+		return data, nil
+	}
+	data, _ = results.([]interface{})
+	return data, err
+}
+
+// ReadGroupMembers returns the users of the group among the candidates,
+// all the users of the account are checked when there is no candidate, such as on importing.
+func (s *IamGroupMembershipService) ReadGroupMembers(groupName string, candidates []string) (members []string, err error) {
+	if len(candidates) == 0 {
+		userService := IamUserService{s.client}
+		var users []interface{}
+		users, err = userService.ReadUsers(map[string]interface{}{})
+		if err != nil {
+			return members, err
+		}
+		for _, v := range users {
+			if name, ok := v.(map[string]interface{})["UserName"].(string); ok {
+				candidates = append(candidates, name)
+			}
+		}
+	}
+	for _, userName := range candidates {
+		var groups []interface{}
+		groups, err = s.ReadGroupsForUser(userName)
+		if err != nil {
+			if isExpectError(err, []string{"UserNoSuchEntity"}) {
+				continue
+			}
+			return members, err
+		}
+		for _, v := range groups {
+			if v.(map[string]interface{})["GroupName"] == groupName {
+				members = append(members, userName)
+				break
+			}
+		}
+	}
+	return members, nil
+}
+
+func (s *IamGroupMembershipService) ReadAndSetIamGroupMembership(d *schema.ResourceData) (err error) {
+	groupService := IamGroupService{s.client}
+	_, err = groupService.ReadGroup(map[string]interface{}{"GroupName": d.Get("group_name")})
+	if err != nil {
+		if isExpectError(err, []string{"GroupNoSuchEntity"}) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	members, err := s.ReadGroupMembers(d.Get("group_name").(string), SchemaSetToStringSlice(d.Get("user_names")))
+	if err != nil {
+		return err
+	}
+	return d.Set("user_names", members)
+}
+
+func (s *IamGroupMembershipService) groupMembershipCall(groupName, userName string, add bool) ApiCall {
+	action := "RemoveUserFromGroup"
+	if add {
+		action = "AddUserToGroup"
+	}
+	params := map[string]interface{}{
+		"GroupName": groupName,
+		"UserName":  userName,
+	}
+	return ApiCall{
+		param:  &params,
+		action: action,
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			if call.action == "AddUserToGroup" {
+				resp, err = conn.AddUserToGroup(call.param)
+			} else {
+				resp, err = conn.RemoveUserFromGroup(call.param)
+			}
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(5*time.Minute, func() *resource.RetryError {
+				if call.action == "RemoveUserFromGroup" && notFoundError(baseErr) {
+					return nil
+				}
+				if isExpectError(baseErr, []string{
+					"UserNoSuchEntity",
+					"GroupNoSuchEntity",
+					"GroupUserLimitExceeded",
+				}) {
+					return resource.NonRetryableError(baseErr)
+				}
+				return resource.RetryableError(baseErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+func (s *IamGroupMembershipService) CreateIamGroupMembership(d *schema.ResourceData) error {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	groupName := d.Get("group_name").(string)
+	for _, userName := range SchemaSetToStringSlice(d.Get("user_names")) {
+		apiProcess.PutCalls(s.groupMembershipCall(groupName, userName, true))
+	}
+	err := apiProcess.Run()
+	if err != nil {
+		return err
+	}
+	d.SetId(groupName)
+	return nil
+}
+
+func (s *IamGroupMembershipService) ModifyIamGroupMembership(d *schema.ResourceData) error {
+	if !d.HasChange("user_names") {
+		return nil
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	groupName := d.Get("group_name").(string)
+	o, n := d.GetChange("user_names")
+	for _, userName := range SchemaSetToStringSlice(o.(*schema.Set).Difference(n.(*schema.Set))) {
+		apiProcess.PutCalls(s.groupMembershipCall(groupName, userName, false))
+	}
+	for _, userName := range SchemaSetToStringSlice(n.(*schema.Set).Difference(o.(*schema.Set))) {
+		apiProcess.PutCalls(s.groupMembershipCall(groupName, userName, true))
+	}
+	return apiProcess.Run()
+}
+
+func (s *IamGroupMembershipService) DeleteIamGroupMembership(d *schema.ResourceData) error {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	groupName := d.Get("group_name").(string)
+	for _, userName := range SchemaSetToStringSlice(d.Get("user_names")) {
+		apiProcess.PutCalls(s.groupMembershipCall(groupName, userName, false))
+	}
+	return apiProcess.Run()
+}
@@ -0,0 +1,138 @@
+package ksyun
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type IamLoginProfileService struct {
+	client *KsyunClient
+}
+
+func (s *IamLoginProfileService) ReadLoginProfile(userName string) (data map[string]interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	condition := map[string]interface{}{
+		"UserName": userName,
+	}
+	conn := s.client.iamconn
+	action := "GetLoginProfile"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.GetLoginProfile(&condition)
+	if err != nil {
+		return data, err
+	}
+	results, err = getSdkValue("GetLoginProfileResult.LoginProfile", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = results.(map[string]interface{})
+	return data, err
+}
+
+func (s *IamLoginProfileService) ReadAndSetIamLoginProfile(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadLoginProfile(d.Id())
+	if err != nil {
+		if isExpectError(err, []string{"UserNoSuchEntity", "LoginProfileNoSuchEntity"}) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return
+}
+
+func (s *IamLoginProfileService) loginProfileReq(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"UserName":              d.Get("user_name"),
+		"Password":              d.Get("password"),
+		"PasswordResetRequired": d.Get("password_reset_required"),
+	}
+}
+
+func (s *IamLoginProfileService) CreateIamLoginProfile(d *schema.ResourceData) error {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	params := s.loginProfileReq(d)
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "CreateLoginProfile",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, "UserName", (*call.param)["UserName"])
+			resp, err = conn.CreateLoginProfile(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, "UserName", (*call.param)["UserName"], *resp)
+			d.SetId(d.Get("user_name").(string))
+			return err
+		},
+	})
+	return apiProcess.Run()
+}
+
+func (s *IamLoginProfileService) ModifyIamLoginProfile(d *schema.ResourceData) error {
+	if !d.HasChanges("password", "password_reset_required") {
+		return nil
+	}
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	params := s.loginProfileReq(d)
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "UpdateLoginProfile",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, "UserName", (*call.param)["UserName"])
+			resp, err = conn.UpdateLoginProfile(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, "UserName", (*call.param)["UserName"], *resp)
+			return err
+		},
+	})
+	return apiProcess.Run()
+}
+
+func (s *IamLoginProfileService) DeleteIamLoginProfile(d *schema.ResourceData) error {
+	apiProcess := NewApiProcess(context.Background(), d, s.client, false)
+
+	params := map[string]interface{}{
+		"UserName": d.Id(),
+	}
+	apiProcess.PutCalls(ApiCall{
+		param:  &params,
+		action: "DeleteLoginProfile",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.iamconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.DeleteLoginProfile(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(5*time.Minute, func() *resource.RetryError {
+				if notFoundError(baseErr) || isExpectError(baseErr, []string{
+					"UserNoSuchEntity",
+					"LoginProfileNoSuchEntity",
+				}) {
+					return nil
+				}
+				return resource.RetryableError(baseErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	})
+	return apiProcess.Run()
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 	"regexp"
 	"time"
@@ -46,6 +46,11 @@ type ListAttachedUserOrRolePoliciesMembersResult struct {
 	Type          int    `json:"Type,omitempty"`
 }
 
+// iamRelationPolicyId returns the id of the relation policy, name:policy_name:relation_type:policy_type
+func iamRelationPolicyId(req map[string]interface{}) string {
+	return fmt.Sprintf("%v:%v:%v:%v", req["Name"], req["PolicyName"], req["RelationType"], req["PolicyType"])
+}
+
 func (s *IamRelationPolicyService) CreateIAMRelationPolicyCommonCall(req map[string]interface{}, isSetId bool) (callback ApiCall, err error) {
 	if req["RelationType"] == 1 {
 		sendParams := map[string]interface{}{}
@@ -76,7 +81,7 @@ func (s *IamRelationPolicyService) CreateIAMRelationPolicyCommonCall(req map[str
 				logger.Debug(logger.RespFormat, call.action, *(call.param))
 				resp, err = conn.AttachUserPolicy(call.param)
 				if err == nil {
-					d.SetId(fmt.Sprintf("%s", accountId))
+					d.SetId(iamRelationPolicyId(req))
 				}
 				return resp, err
 			},
@@ -102,6 +107,38 @@ func (s *IamRelationPolicyService) CreateIAMRelationPolicyCommonCall(req map[str
 			},
 		}
 		return callback, err
+	} else if req["RelationType"] == 3 {
+		sendParams := map[string]interface{}{}
+		sendParams["GroupName"] = req["Name"]
+
+		accountId, err := s.ReadRelationAccountId(3, req["Name"].(string))
+		if err != nil {
+			return callback, err
+		}
+		if req["PolicyType"] == "system" {
+			sendParams["PolicyKrn"] = fmt.Sprintf("krn:ksc:iam::ksc:policy/%s", req["PolicyName"])
+		} else {
+			sendParams["PolicyKrn"] = fmt.Sprintf("krn:ksc:iam::%s:policy/%s", accountId, req["PolicyName"])
+		}
+
+		callback = ApiCall{
+			param:  &sendParams,
+			action: "AttachGroupPolicy",
+			executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+				conn := client.iamconn
+				logger.Debug(logger.RespFormat, call.action, *(call.param))
+				resp, err = conn.AttachGroupPolicy(call.param)
+				if err == nil {
+					d.SetId(iamRelationPolicyId(req))
+				}
+				return resp, err
+			},
+			afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+				logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+				return err
+			},
+		}
+		return callback, err
 	} else {
 		sendParams := map[string]interface{}{}
 		sendParams["RoleName"] = req["Name"]
@@ -131,7 +168,7 @@ func (s *IamRelationPolicyService) CreateIAMRelationPolicyCommonCall(req map[str
 				logger.Debug(logger.RespFormat, call.action, *(call.param))
 				resp, err = conn.AttachRolePolicy(call.param)
 				if err == nil {
-					d.SetId(fmt.Sprintf("%s", accountId))
+					d.SetId(iamRelationPolicyId(req))
 				}
 				return resp, err
 			},
@@ -180,6 +217,10 @@ func (s *IamRelationPolicyService) DeleteIamRelationPolicy(d *schema.ResourceDat
 
 	deleteCall, err := s.DeleteIamRelationPolicyCall(d)
 	if err != nil {
+		// the policy is detached together with the user, role or group
+		if notFoundError(err) {
+			return nil
+		}
 		return err
 	}
 	apiProcess.PutCalls(deleteCall)
@@ -187,18 +228,27 @@ func (s *IamRelationPolicyService) DeleteIamRelationPolicy(d *schema.ResourceDat
 	return apiProcess.Run()
 }
 
+// relationPolicyKrn returns the krn of the attached policy, the custom policy krn contains the account id
+func (s *IamRelationPolicyService) relationPolicyKrn(d *schema.ResourceData) (string, error) {
+	policyName := d.Get("policy_name").(string)
+	if d.Get("policy_type").(string) == "system" {
+		return fmt.Sprintf("krn:ksc:iam::ksc:policy/%s", policyName), nil
+	}
+	accountId, err := s.ReadRelationAccountId(d.Get("relation_type").(int), d.Get("name").(string))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("krn:ksc:iam::%s:policy/%s", accountId, policyName), nil
+}
+
 func (s *IamRelationPolicyService) DeleteIamRelationPolicyCall(d *schema.ResourceData) (callback ApiCall, err error) {
 	relationType := d.Get("relation_type").(int)
 	if relationType == 1 {
 		// 构成参数
 		params := map[string]interface{}{}
 		params["UserName"] = d.Get("name").(string)
-
-		policyType := d.Get("policy_type").(string)
-		if policyType == "system" {
-			params["PolicyKrn"] = fmt.Sprintf("krn:ksc:iam::ksc:policy/%s", d.Get("policy_name").(string))
-		} else {
-			params["PolicyKrn"] = fmt.Sprintf("krn:ksc:iam::%s:policy/%s", d.Id(), d.Get("policy_name").(string))
+		if params["PolicyKrn"], err = s.relationPolicyKrn(d); err != nil {
+			return callback, err
 		}
 		callback = ApiCall{
 			param:  &params,
@@ -231,15 +281,50 @@ func (s *IamRelationPolicyService) DeleteIamRelationPolicyCall(d *schema.Resourc
 				return err
 			},
 		}
+	} else if relationType == 3 {
+		params := map[string]interface{}{}
+		params["GroupName"] = d.Get("name").(string)
+		if params["PolicyKrn"], err = s.relationPolicyKrn(d); err != nil {
+			return callback, err
+		}
+
+		callback = ApiCall{
+			param:  &params,
+			action: "DetachGroupPolicy",
+			executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+				conn := client.iamconn
+				logger.Debug(logger.RespFormat, call.action, *(call.param))
+				resp, err = conn.DetachGroupPolicy(call.param)
+				return resp, err
+			},
+			callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+				return resource.Retry(5*time.Minute, func() *resource.RetryError {
+					if notFoundError(baseErr) {
+						return nil
+					}
+
+					// it cannot be deleted if this is still using
+					if isExpectError(baseErr, []string{
+						"PolicyNoSuchEntity",
+						"GroupNoSuchEntity",
+						"GroupPolicyNoSuchEntity",
+					}) {
+						return resource.NonRetryableError(baseErr)
+					}
+					return resource.RetryableError(baseErr)
+				})
+			},
+			afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+				logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+				return err
+			},
+		}
 	} else {
 		// 构成参数
 		params := map[string]interface{}{}
 		params["RoleName"] = d.Get("name").(string)
-		policyType := d.Get("policy_type").(string)
-		if policyType == "system" {
-			params["PolicyKrn"] = fmt.Sprintf("krn:ksc:iam::ksc:policy/%s", d.Get("policy_name").(string))
-		} else {
-			params["PolicyKrn"] = fmt.Sprintf("krn:ksc:iam::%s:policy/%s", d.Id(), d.Get("policy_name").(string))
+		if params["PolicyKrn"], err = s.relationPolicyKrn(d); err != nil {
+			return callback, err
 		}
 
 		callback = ApiCall{
@@ -291,6 +376,13 @@ func (s *IamRelationPolicyService) ReadAndSetIamRelationPolicy(d *schema.Resourc
 		d.SetId("")
 		return nil
 	}
+	// the relation policies created by the earlier versions are identified by the account id
+	d.SetId(iamRelationPolicyId(map[string]interface{}{
+		"Name":         name,
+		"PolicyName":   policyName,
+		"RelationType": relationType,
+		"PolicyType":   d.Get("policy_type"),
+	}))
 	SdkResponseAutoResourceData(d, r, data, nil)
 
 	return
@@ -299,6 +391,10 @@ func (s *IamRelationPolicyService) ReadAndSetIamRelationPolicy(d *schema.Resourc
 func (s *IamRelationPolicyService) ReadRelationPolicy(relationType int, name string, policyName string) (data []interface{}, err error) {
 	var resp *map[string]interface{}
 
+	if relationType == 3 {
+		return s.ReadGroupRelationPolicy(name, policyName)
+	}
+
 	var policyMemberResult ListAttachedUserOrRolePoliciesMembersResult
 	if relationType == 1 {
 		var condition = map[string]interface{}{}
@@ -389,3 +485,81 @@ func (s *IamRelationPolicyService) ReadRelationPolicy(relationType int, name str
 
 	return data, err
 }
+
+func (s *IamRelationPolicyService) ReadGroupRelationPolicy(groupName string, policyName string) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	condition := map[string]interface{}{
+		"GroupName": groupName,
+		"MaxItems":  100,
+	}
+	for {
+		conn := s.client.iamconn
+		action := "ListGroupPolicies"
+		logger.Debug(logger.ReqFormat, action, condition)
+		resp, err = conn.ListGroupPolicies(&condition)
+		if err != nil {
+			return data, err
+		}
+		results, err = getSdkValue("ListGroupPoliciesResult.AttachedPolicies.member", *resp)
+		if err != nil {
+			return data, nil
+		}
+		for _, v := range results.([]interface{}) {
+			if item := v.(map[string]interface{}); item["PolicyName"] == policyName {
+				return append(data, item), nil
+			}
+		}
+		marker, _ := getSdkValue("ListGroupPoliciesResult.Marker", *resp)
+		if truncated, _ := getSdkValue("ListGroupPoliciesResult.IsTruncated", *resp); truncated != true || marker == nil {
+			break
+		}
+		condition["Marker"] = marker
+	}
+	return data, err
+}
+
+// ReadRelationAccountId returns the account id of the user, role or group from its krn,
+// which is a part of the krn of the custom policies.
+func (s *IamRelationPolicyService) ReadRelationAccountId(relationType int, name string) (accountId string, err error) {
+	var (
+		resp *map[string]interface{}
+		krn  interface{}
+	)
+	conn := s.client.iamconn
+	switch relationType {
+	case 1:
+		params := map[string]interface{}{"UserName": name}
+		logger.Debug(logger.ReqFormat, "GetUser", params)
+		if resp, err = conn.GetUser(&params); err != nil {
+			return accountId, err
+		}
+		krn, err = getSdkValue("GetUserResult.User.Krn", *resp)
+	case 2:
+		params := map[string]interface{}{"RoleName": name}
+		logger.Debug(logger.ReqFormat, "GetRole", params)
+		if resp, err = conn.GetRole(&params); err != nil {
+			return accountId, err
+		}
+		krn, err = getSdkValue("GetRoleResult.Role.Krn", *resp)
+	case 3:
+		params := map[string]interface{}{"GroupName": name}
+		logger.Debug(logger.ReqFormat, "GetGroup", params)
+		if resp, err = conn.GetGroup(&params); err != nil {
+			return accountId, err
+		}
+		krn, err = getSdkValue("GetGroupResult.Group.Krn", *resp)
+	default:
+		return accountId, fmt.Errorf("relation type %d is not supported", relationType)
+	}
+	if err != nil {
+		return accountId, err
+	}
+	match := regexp.MustCompile(`::(\d+):`).FindStringSubmatch(krn.(string))
+	if len(match) < 2 {
+		return accountId, fmt.Errorf("the krn %s has no account id", krn)
+	}
+	return match[1], err
+}
@@ -2,10 +2,10 @@ package ksyun
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -72,7 +72,9 @@ func (s *IamRoleService) ReadAndSetIamRole(d *schema.ResourceData, r *schema.Res
 		}
 		return err
 	}
-	SdkResponseAutoResourceData(d, r, data, nil)
+	for _, item := range data {
+		SdkResponseAutoResourceData(d, r, item, nil)
+	}
 
 	return
 }
@@ -2,10 +2,10 @@ package ksyun
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -72,9 +72,35 @@ func (s *IamUserService) ReadAndSetIamUser(d *schema.ResourceData, r *schema.Res
 		}
 		return err
 	}
-	SdkResponseAutoResourceData(d, r, data, nil)
+	for _, item := range data {
+		SdkResponseAutoResourceData(d, r, item, nil)
+	}
 
-	return
+	// the console login settings are kept in the login profile, the user created without the password has none
+	iamLoginProfileService := IamLoginProfileService{s.client}
+	profile, err := iamLoginProfileService.ReadLoginProfile(d.Get("user_name").(string))
+	if err != nil {
+		if isExpectError(err, []string{"LoginProfileNoSuchEntity"}) {
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, profile, map[string]SdkResponseMapping{
+		"PasswordResetRequired": {
+			Field: "password_reset_required",
+			FieldRespFunc: func(i interface{}) interface{} {
+				// the login profile returns a bool, while the user takes 0 or 1
+				if b, ok := i.(bool); ok {
+					if b {
+						return 1
+					}
+					return 0
+				}
+				return i
+			},
+		},
+	})
+	return nil
 }
 
 func (s *IamUserService) ReadUser(condition map[string]interface{}) (data []interface{}, err error) {
@@ -1,7 +1,11 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -97,3 +101,365 @@ func (s *ImageService) ReadAndSetKecImages(d *schema.ResourceData, r *schema.Res
 		return result, flag, err
 	})
 }
+
+func (s *ImageService) readKecImage(imageId string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	req := map[string]interface{}{
+		"ImageId.1": imageId,
+	}
+	results, err = s.readKecImages(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("image %s not exist ", imageId)
+	}
+	return data, err
+}
+
+func (s *ImageService) ReadAndSetImage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		data, callErr := s.readKecImage(d.Get("image_id").(string))
+		if callErr != nil {
+			if !d.IsNewResource() {
+				if notFoundError(callErr) {
+					d.SetId("")
+					return nil
+				}
+				return resource.NonRetryableError(callErr)
+			}
+			if notFoundError(callErr) {
+				return resource.RetryableError(callErr)
+			}
+			return resource.NonRetryableError(fmt.Errorf("error on reading image %q, %s", d.Id(), callErr))
+		}
+		extra := map[string]SdkResponseMapping{
+			"Name": {
+				Field: "image_name",
+			},
+		}
+		SdkResponseAutoResourceData(d, r, data, extra)
+		return nil
+	})
+}
+
+func (s *ImageService) checkImageState(d *schema.ResourceData, imageId string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{},
+		Target:       []string{"active"},
+		Refresh:      s.imageStateRefreshFunc(imageId, []string{"error"}),
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Second,
+		MinTimeout:   1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *ImageService) imageStateRefreshFunc(imageId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.readKecImage(imageId)
+		if err != nil {
+			return nil, "", err
+		}
+		state := fmt.Sprintf("%v", data["ImageState"])
+		for _, v := range failStates {
+			if v == state {
+				return nil, "", fmt.Errorf("image %s state error, state:%v", imageId, state)
+			}
+		}
+		return data, state, nil
+	}
+}
+
+// imageCreatedCall returns the afterCall of the create actions, it takes the image id from the response
+// at the path and waits for the image to be available.
+func (s *ImageService) imageCreatedCall(path string) afterCallFunc {
+	return func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+		logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+		imageId, err := getSdkValue(path, *resp)
+		if err != nil {
+			return err
+		}
+		if imageId == nil {
+			return fmt.Errorf("the image id is not returned by %s", call.action)
+		}
+		d.SetId(imageId.(string))
+		_ = d.Set("image_id", imageId)
+		return s.checkImageState(d, imageId.(string), d.Timeout(schema.TimeoutCreate))
+	}
+}
+
+func (s *ImageService) CreateImageCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"image_name": {
+			mapping: "Name",
+		},
+		"instance_id": {},
+		"snapshot_id": {},
+		"data_disk_ids": {
+			mapping: "DataDiskIds",
+			Type:    TransformWithN,
+		},
+	}
+	req, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: true,
+	})
+	if err != nil {
+		return callback, err
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: "CreateImage",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CreateImage(call.param)
+			return resp, err
+		},
+		afterCall: s.imageCreatedCall("ImageId"),
+	}
+	return callback, err
+}
+
+func (s *ImageService) CreateImage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	call, err := s.CreateImageCall(d, r)
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *ImageService) ImportImageCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	req, err := SdkRequestAutoMapping(d, r, false, nil, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: "ImportImage",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.ImportImage(call.param)
+			return resp, err
+		},
+		afterCall: s.imageCreatedCall("ImageId"),
+	}
+	return callback, err
+}
+
+func (s *ImageService) ImportImage(d *schema.ResourceData, r *schema.Resource) (err error) {
+	call, err := s.ImportImageCall(d, r)
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *ImageService) ModifyImageNameCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	if !d.HasChange("image_name") {
+		return callback, err
+	}
+	req := map[string]interface{}{
+		"ImageId": d.Get("image_id"),
+		"Name":    d.Get("image_name"),
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: "ModifyImageAttribute",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.ModifyImageAttribute(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *ImageService) ModifyImage(d *schema.ResourceData) (err error) {
+	call, err := s.ModifyImageNameCall(d)
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *ImageService) RemoveImage(d *schema.ResourceData) (err error) {
+	conn := s.client.kecconn
+	imageId := d.Get("image_id").(string)
+	req := map[string]interface{}{
+		"ImageId.1": imageId,
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		action := "RemoveImages"
+		logger.Debug(logger.ReqFormat, action, req)
+		_, err = conn.RemoveImages(&req)
+		if err == nil {
+			return nil
+		}
+		_, readErr := s.readKecImage(imageId)
+		if readErr != nil && notFoundError(readErr) {
+			return nil
+		}
+		return resource.RetryableError(err)
+	})
+}
+
+func (s *ImageService) CopyImageCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	req := map[string]interface{}{
+		"ImageId.1":           d.Get("source_image_id"),
+		"DestinationRegion.1": d.Get("destination_region"),
+	}
+	if v, ok := d.GetOk("image_name"); ok {
+		req["DestinationImageName"] = v
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: "CopyImage",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CopyImage(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			imageId, err := getSdkValue("ImageIdSet.0.ImageId", *resp)
+			if err != nil {
+				return err
+			}
+			if imageId == nil {
+				return fmt.Errorf("the image id is not returned by %s", call.action)
+			}
+			region := d.Get("destination_region").(string)
+			d.SetId(AssembleIds(region, imageId.(string)))
+			_ = d.Set("image_id", imageId)
+			// the copied image is in the destination region
+			destination, err := s.destination(region)
+			if err != nil {
+				return err
+			}
+			return destination.checkImageState(d, imageId.(string), d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+// destination returns the image service of the destination region of the copied image
+func (s *ImageService) destination(region string) (*ImageService, error) {
+	client, err := s.client.WithRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageService{client}, nil
+}
+
+func (s *ImageService) CopyImage(d *schema.ResourceData) (err error) {
+	call, err := s.CopyImageCall(d)
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *ImageService) readImageSharedAccounts(imageId string) (accounts []string, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.kecconn
+	req := map[string]interface{}{
+		"ImageId": imageId,
+	}
+	action := "DescribeImageSharePermission"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.DescribeImageSharePermission(&req)
+	if err != nil {
+		return accounts, err
+	}
+	results, err = getSdkValue("AccountSet", *resp)
+	if err != nil {
+		return accounts, err
+	}
+	accounts = []string{}
+	if v, ok := results.([]interface{}); ok {
+		for _, account := range v {
+			accounts = append(accounts, fmt.Sprintf("%v", account.(map[string]interface{})["AccountId"]))
+		}
+	}
+	return accounts, err
+}
+
+func (s *ImageService) ReadAndSetImageSharePermission(d *schema.ResourceData) (err error) {
+	imageId := d.Id()
+	if _, err = s.readKecImage(imageId); err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	accounts, err := s.readImageSharedAccounts(imageId)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("image_id", imageId)
+	return d.Set("account_ids", accounts)
+}
+
+func (s *ImageService) modifyImageSharePermissionCall(imageId string, accounts []interface{}, permission string) (callback ApiCall) {
+	if len(accounts) == 0 {
+		return callback
+	}
+	req := map[string]interface{}{
+		"ImageId":    imageId,
+		"Permission": permission,
+	}
+	for i, account := range accounts {
+		req[fmt.Sprintf("AccountId.%d", i+1)] = account
+	}
+	return ApiCall{
+		param:  &req,
+		action: "ModifyImageSharePermission",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.kecconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.ModifyImageSharePermission(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+}
+
+// ModifyImageSharePermission shares the image with the added accounts and cancels the sharing of the removed ones
+func (s *ImageService) ModifyImageSharePermission(d *schema.ResourceData) (err error) {
+	o, n := d.GetChange("account_ids")
+	oldSet := o.(*schema.Set)
+	newSet := n.(*schema.Set)
+	imageId := d.Get("image_id").(string)
+	calls := []ApiCall{
+		s.modifyImageSharePermissionCall(imageId, oldSet.Difference(newSet).List(), "cancel"),
+		s.modifyImageSharePermissionCall(imageId, newSet.Difference(oldSet).List(), "share"),
+	}
+	return ksyunApiCallNew(calls, d, s.client, false)
+}
+
+func (s *ImageService) RemoveImageSharePermission(d *schema.ResourceData) (err error) {
+	call := s.modifyImageSharePermissionCall(d.Get("image_id").(string), d.Get("account_ids").(*schema.Set).List(), "cancel")
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, false)
+}
@@ -8,8 +8,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/helper"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/network"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
@@ -124,7 +124,10 @@ func (s *KecService) readAndSetKecInstance(d *schema.ResourceData, r *schema.Res
 			_ = d.Set("has_modify_system_disk", false)
 			_ = d.Set("has_modify_password", false)
 			_ = d.Set("has_modify_keys", false)
-			return resource.NonRetryableError(err)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
 		}
 	})
 }
@@ -382,7 +385,7 @@ func (s *KecService) createKecInstance(d *schema.ResourceData, resource *schema.
 }
 
 func (s *KecService) kecRelatedAttachTags(d *schema.ResourceData, resource *schema.Resource) (calls []ApiCall, err error) {
-	if !d.HasChange("tags") {
+	if !d.HasChange("tags_all") {
 		return
 	}
 	dataDisksIf, ok := d.GetOk("data_disks")
@@ -411,7 +414,7 @@ func (s *KecService) kecRelatedAttachTags(d *schema.ResourceData, resource *sche
 		volumeIds = append(volumeIds, volumeId)
 	}
 
-	desiredTags := d.Get("tags").(map[string]interface{})
+	desiredTags := d.Get("tags_all").(map[string]interface{})
 	for k, v := range desiredTags {
 		tags = append(tags, &Tag{
 			Key:   k,
@@ -610,7 +613,7 @@ func transKecInstanceParams(d *schema.ResourceData, resource *schema.Resource) (
 	syncTag = d.Get("sync_tag")
 	instanceParams["SyncTag"] = syncTag
 
-	if tags, ok := d.GetOk("tags"); ok {
+	if tags, ok := d.GetOk("tags_all"); ok {
 		tagsMap := tags.(map[string]interface{})
 		idx := 1
 		for k, v := range tagsMap {
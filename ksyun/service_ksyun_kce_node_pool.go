@@ -0,0 +1,501 @@
+package ksyun
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/helper"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func (s *KceService) readKceNodePools(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp        *map[string]interface{}
+		poolResults interface{}
+	)
+
+	return pageQuery(condition, "MaxResults", "Marker", 10, 0, func(condition map[string]interface{}) ([]interface{}, error) {
+		resp, err = s.client.kceconn.DescribeNodePool(&condition)
+		if err != nil {
+			return data, err
+		}
+		poolResults, err = getSdkValue("NodePoolSet", *resp)
+		if err != nil {
+			return data, err
+		}
+		data = poolResults.([]interface{})
+		return data, err
+	})
+}
+
+func (s *KceService) readKceNodePool(clusterId, nodePoolId string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	results, err = s.readKceNodePools(map[string]interface{}{
+		"ClusterId":    clusterId,
+		"NodePoolId.1": nodePoolId,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("node pool %s is not exist", nodePoolId)
+	}
+	return data, err
+}
+
+// flattenKceNodePool converts the node pool of the api to the schema of the resource and the data source
+func flattenKceNodePool(item map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, k := range []string{
+		"NodePoolId", "NodePoolName", "ClusterId", "EnableAutoScale",
+		"MinSize", "MaxSize", "DesiredCapacity", "Status", "CreateTime",
+	} {
+		if v, ok := item[k]; ok {
+			result[Hump2Downline(k)] = v
+		}
+	}
+
+	if template, ok := item["NodeTemplate"].(map[string]interface{}); ok {
+		nodeTemplate := map[string]interface{}{}
+		for k, v := range template {
+			switch k {
+			case "SystemDisk":
+				disk := v.(map[string]interface{})
+				nodeTemplate["system_disk"] = []interface{}{
+					map[string]interface{}{
+						"disk_type": disk["DiskType"],
+						"disk_size": disk["DiskSize"],
+					},
+				}
+			case "DataDisk":
+				var dataDisks []interface{}
+				for _, diskSrc := range v.([]interface{}) {
+					disk := diskSrc.(map[string]interface{})
+					dataDisks = append(dataDisks, map[string]interface{}{
+						"disk_type": disk["Type"],
+						"disk_size": disk["Size"],
+					})
+				}
+				nodeTemplate["data_disk"] = dataDisks
+			default:
+				nodeTemplate[Hump2Downline(k)] = v
+			}
+		}
+		result["node_template"] = []interface{}{nodeTemplate}
+	}
+
+	if setting, ok := item["AdvancedSetting"].(map[string]interface{}); ok {
+		advancedSetting := map[string]interface{}{}
+		for k, v := range setting {
+			switch k {
+			case "DataDisk":
+				advancedSetting["data_disk"] = []interface{}{hump2DownlineMap(v.(map[string]interface{}))}
+			case "Label", "Taints":
+				var items []interface{}
+				for _, itemSrc := range v.([]interface{}) {
+					items = append(items, hump2DownlineMap(itemSrc.(map[string]interface{})))
+				}
+				advancedSetting[Hump2Downline(k)] = items
+			case "ContainerLogMaxFiles", "ContainerLogMaxSize":
+				// the values are returned as strings sometimes
+				if vStr, ok := v.(string); ok {
+					if vInt, err := strconv.Atoi(vStr); err == nil {
+						advancedSetting[Hump2Downline(k)] = vInt
+					}
+				} else {
+					advancedSetting[Hump2Downline(k)] = v
+				}
+			default:
+				advancedSetting[Hump2Downline(k)] = v
+			}
+		}
+		result["advanced_setting"] = []interface{}{advancedSetting}
+	}
+	return result
+}
+
+func hump2DownlineMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[Hump2Downline(k)] = v
+	}
+	return result
+}
+
+func (s *KceService) ReadAndSetNodePool(d *schema.ResourceData, r *schema.Resource) (err error) {
+	ids := DisassembleIds(d.Id())
+	if len(ids) != 2 {
+		return fmt.Errorf("the id %s of the node pool must be in the format of cluster_id:node_pool_id", d.Id())
+	}
+	clusterId, nodePoolId := ids[0], ids[1]
+
+	var data map[string]interface{}
+	data, err = s.readKceNodePool(clusterId, nodePoolId)
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	pool := flattenKceNodePool(data)
+	pool["cluster_id"] = clusterId
+	// the password is not returned by the api
+	if templates, ok := pool["node_template"].([]interface{}); ok && len(templates) > 0 {
+		templates[0].(map[string]interface{})["instance_password"] = d.Get("node_template.0.instance_password")
+	}
+	for k, v := range pool {
+		if _, ok := r.Schema[k]; !ok {
+			continue
+		}
+		if err = d.Set(k, v); err != nil {
+			return fmt.Errorf("error on setting %s: %s", k, err)
+		}
+	}
+	return nil
+}
+
+func (s *KceService) ReadAndSetKceNodePools(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"cluster_id": {
+			mapping: "ClusterId",
+			Type:    TransformDefault,
+		},
+		"ids": {
+			mapping: "NodePoolId",
+			Type:    TransformWithN,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.readKceNodePools(req)
+	if err != nil {
+		return err
+	}
+
+	var pools []interface{}
+	for _, item := range data {
+		pool, matched, err := mergeNameRegex(d, item.(map[string]interface{}), "NodePoolName")
+		if err != nil {
+			return err
+		}
+		if !matched {
+			pool = item.(map[string]interface{})
+		}
+		if pool != nil {
+			pools = append(pools, pool)
+		}
+	}
+
+	_, _, err = SdkSliceMapping(d, pools, SdkSliceData{
+		IdField: "NodePoolId",
+		IdMappingFunc: func(idField string, item map[string]interface{}) string {
+			return item[idField].(string)
+		},
+		SliceMappingFunc: flattenKceNodePool,
+		TargetName:       "node_pools",
+	})
+	return err
+}
+
+// formatKceNodeTemplateParams adds the params of the node template and the advanced setting,
+// the whole template is sent on both creating and modifying.
+func formatKceNodeTemplateParams(d *schema.ResourceData, params map[string]interface{}) {
+	template, _ := helper.GetSchemaListHeadMap(d, "node_template")
+	params["NodeTemplate.InstanceType"] = template["instance_type"]
+	params["NodeTemplate.ImageId"] = template["image_id"]
+	params["NodeTemplate.SecurityGroupId"] = template["security_group_id"]
+	params["NodeTemplate.ChargeType"] = template["charge_type"]
+	for idx, subnetId := range template["subnet_id"].(*schema.Set).List() {
+		params[fmt.Sprintf("NodeTemplate.SubnetId.%d", idx+1)] = subnetId
+	}
+	for idx, keyId := range template["key_id"].(*schema.Set).List() {
+		params[fmt.Sprintf("NodeTemplate.KeyId.%d", idx+1)] = keyId
+	}
+	if password, ok := template["instance_password"]; ok && password.(string) != "" {
+		params["NodeTemplate.InstancePassword"] = password
+	}
+	for _, diskSrc := range template["system_disk"].([]interface{}) {
+		disk, ok := diskSrc.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if disk["disk_type"] != "" {
+			params["NodeTemplate.SystemDisk.DiskType"] = disk["disk_type"]
+		}
+		if disk["disk_size"] != 0 {
+			params["NodeTemplate.SystemDisk.DiskSize"] = disk["disk_size"]
+		}
+	}
+	for diskIdx, diskSrc := range template["data_disk"].([]interface{}) {
+		disk := diskSrc.(map[string]interface{})
+		params[fmt.Sprintf("NodeTemplate.DataDisk.%d.Type", diskIdx+1)] = disk["disk_type"]
+		params[fmt.Sprintf("NodeTemplate.DataDisk.%d.Size", diskIdx+1)] = disk["disk_size"]
+	}
+
+	advancedSettingParams := map[string]interface{}{}
+	advancedSetting, _ := helper.GetSchemaListHeadMap(d, "advanced_setting")
+	for k, v := range advancedSetting {
+		if _, ok := d.GetOk("advanced_setting.0." + k); !ok {
+			continue
+		}
+		formatAdvancedSettingParams(&advancedSettingParams, Downline2Hump(k), v, true)
+	}
+	for k, v := range advancedSettingParams {
+		params["AdvancedSetting."+k] = v
+	}
+}
+
+func formatKceNodePoolSizeParams(d *schema.ResourceData, params map[string]interface{}) {
+	params["NodePoolName"] = d.Get("node_pool_name")
+	params["EnableAutoScale"] = d.Get("enable_auto_scale")
+	params["MinSize"] = d.Get("min_size")
+	params["MaxSize"] = d.Get("max_size")
+	// the capacity is left to the autoscaler unless it is set on creating
+	if v, ok := d.GetOk("desired_capacity"); ok && (d.IsNewResource() || !d.Get("enable_auto_scale").(bool)) {
+		params["DesiredCapacity"] = v
+	}
+}
+
+func (s *KceService) CreateNodePool(d *schema.ResourceData, r *schema.Resource) (err error) {
+	clusterId := d.Get("cluster_id").(string)
+	params := map[string]interface{}{
+		"ClusterId": clusterId,
+	}
+	formatKceNodePoolSizeParams(d, params)
+	formatKceNodeTemplateParams(d, params)
+
+	var resp *map[string]interface{}
+	logger.Debug(logger.ReqFormat, "CreateNodePool", params)
+	resp, err = s.client.kceconn.CreateNodePool(&params)
+	if err != nil {
+		return err
+	}
+	nodePoolId, err := getSdkValue("NodePoolId", *resp)
+	if err != nil {
+		return err
+	}
+	d.SetId(AssembleIds(clusterId, nodePoolId.(string)))
+	return s.checkNodePoolState(clusterId, nodePoolId.(string), d.Timeout(schema.TimeoutCreate))
+}
+
+func (s *KceService) ModifyNodePool(d *schema.ResourceData, r *schema.Resource) (err error) {
+	clusterId := d.Get("cluster_id").(string)
+	nodePoolId := d.Get("node_pool_id").(string)
+
+	if d.HasChanges("node_pool_name", "enable_auto_scale", "min_size", "max_size", "desired_capacity") {
+		params := map[string]interface{}{
+			"ClusterId":  clusterId,
+			"NodePoolId": nodePoolId,
+		}
+		formatKceNodePoolSizeParams(d, params)
+		logger.Debug(logger.ReqFormat, "ModifyNodePool", params)
+		if _, err = s.client.kceconn.ModifyNodePool(&params); err != nil {
+			return err
+		}
+	}
+
+	// the nodes created from the old template, they are replaced after the template is modified
+	var replacedInstanceIds []string
+	if d.HasChanges("node_template", "advanced_setting") {
+		if _, ok := d.GetOk("rolling_update"); ok {
+			if replacedInstanceIds, err = s.readKceNodePoolInstanceIds(clusterId, nodePoolId); err != nil {
+				return err
+			}
+		}
+		params := map[string]interface{}{
+			"ClusterId":  clusterId,
+			"NodePoolId": nodePoolId,
+		}
+		formatKceNodeTemplateParams(d, params)
+		logger.Debug(logger.ReqFormat, "ModifyNodeTemplate", params)
+		if _, err = s.client.kceconn.ModifyNodeTemplate(&params); err != nil {
+			return err
+		}
+	}
+	if err = s.checkNodePoolState(clusterId, nodePoolId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	if len(replacedInstanceIds) == 0 {
+		return nil
+	}
+	return s.rollingUpdateNodePool(d, replacedInstanceIds, d.Get("rolling_update.0.max_unavailable").(int))
+}
+
+// readKceNodePoolInstances returns the nodes of the node pool, the nodes of the cluster are matched by the NodePoolId.
+func (s *KceService) readKceNodePoolInstances(clusterId, nodePoolId string) (data []map[string]interface{}, err error) {
+	var nodes []interface{}
+	nodes, err = s.getAllNodeWithFilter(clusterId, nil)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range nodes {
+		node := v.(map[string]interface{})
+		if node["NodePoolId"] == nodePoolId {
+			data = append(data, node)
+		}
+	}
+	return data, err
+}
+
+func (s *KceService) readKceNodePoolInstanceIds(clusterId, nodePoolId string) (ids []string, err error) {
+	var nodes []map[string]interface{}
+	nodes, err = s.readKceNodePoolInstances(clusterId, nodePoolId)
+	if err != nil {
+		return ids, err
+	}
+	for _, node := range nodes {
+		ids = append(ids, node["InstanceId"].(string))
+	}
+	return ids, err
+}
+
+// rollingUpdateNodePool replaces the nodes in batches of maxUnavailable. The nodes of a batch are drained and terminated
+// by removing them from the node pool, then the desired capacity is restored, so that the node pool scales out the nodes
+// of the new template. The next batch starts after the new nodes are running.
+func (s *KceService) rollingUpdateNodePool(d *schema.ResourceData, instanceIds []string, maxUnavailable int) (err error) {
+	clusterId := d.Get("cluster_id").(string)
+	nodePoolId := d.Get("node_pool_id").(string)
+	pool, err := s.readKceNodePool(clusterId, nodePoolId)
+	if err != nil {
+		return err
+	}
+	capacity, err := strconv.Atoi(fmt.Sprintf("%v", pool["DesiredCapacity"]))
+	if err != nil {
+		return fmt.Errorf("the desired capacity of the node pool %s is unknown: %s", nodePoolId, err)
+	}
+
+	for start := 0; start < len(instanceIds); start += maxUnavailable {
+		end := start + maxUnavailable
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+		batch := instanceIds[start:end]
+
+		removeParams := map[string]interface{}{
+			"ClusterId":          clusterId,
+			"NodePoolId":         nodePoolId,
+			"InstanceDeleteMode": "Terminate",
+		}
+		for idx, instanceId := range batch {
+			removeParams[fmt.Sprintf("InstanceId.%d", idx+1)] = instanceId
+		}
+		logger.Debug(logger.ReqFormat, "DeleteClusterInstancesFromNodePool", removeParams)
+		if _, err = s.client.kceconn.DeleteClusterInstancesFromNodePool(&removeParams); err != nil {
+			return err
+		}
+
+		// the removed nodes are subtracted from the desired capacity
+		sizeParams := map[string]interface{}{
+			"ClusterId":  clusterId,
+			"NodePoolId": nodePoolId,
+		}
+		formatKceNodePoolSizeParams(d, sizeParams)
+		sizeParams["DesiredCapacity"] = capacity
+		logger.Debug(logger.ReqFormat, "ModifyNodePool", sizeParams)
+		if _, err = s.client.kceconn.ModifyNodePool(&sizeParams); err != nil {
+			return err
+		}
+
+		err = s.checkNodePoolInstancesReplaced(clusterId, nodePoolId, batch, capacity, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error on replacing the nodes %v: %s", batch, err)
+		}
+	}
+	return nil
+}
+
+// checkNodePoolInstancesReplaced waits until the removed nodes are gone and the node pool runs the desired number of nodes.
+func (s *KceService) checkNodePoolInstancesReplaced(clusterId, nodePoolId string, removedIds []string, capacity int, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		nodes, err := s.readKceNodePoolInstances(clusterId, nodePoolId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		running := 0
+		for _, node := range nodes {
+			if stringSliceContains(removedIds, node["InstanceId"].(string)) {
+				return resource.RetryableError(fmt.Errorf("node %s is being removed", node["InstanceId"]))
+			}
+			switch node["InstanceStatus"] {
+			case "normal":
+				running++
+			case "error":
+				return resource.NonRetryableError(fmt.Errorf("node %s status error", node["InstanceId"]))
+			}
+		}
+		if running < capacity {
+			return resource.RetryableError(fmt.Errorf("%d of %d nodes are running", running, capacity))
+		}
+		return nil
+	})
+}
+
+func (s *KceService) DeleteNodePool(d *schema.ResourceData) (err error) {
+	clusterId := d.Get("cluster_id").(string)
+	nodePoolId := d.Get("node_pool_id").(string)
+	_, err = s.client.kceconn.DeleteNodePool(&map[string]interface{}{
+		"ClusterId":          clusterId,
+		"NodePoolId.1":       nodePoolId,
+		"InstanceDeleteMode": d.Get("instance_delete_mode"),
+	})
+	if err != nil {
+		if notFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, queryErr := s.readKceNodePool(clusterId, nodePoolId)
+		if queryErr != nil {
+			if notFoundError(queryErr) {
+				return nil
+			}
+			return resource.NonRetryableError(queryErr)
+		}
+		return resource.RetryableError(errors.New("deleting"))
+	})
+}
+
+func (s *KceService) kceNodePoolStateRefreshFunc(clusterId, nodePoolId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.readKceNodePool(clusterId, nodePoolId)
+		if err != nil {
+			return nil, "", err
+		}
+		status, ok := data["Status"].(string)
+		if !ok {
+			return nil, "", fmt.Errorf("the status of the node pool %s is unknown", nodePoolId)
+		}
+		status = strings.ToLower(status)
+		if stringSliceContains(failStates, status) {
+			return nil, "", fmt.Errorf("node pool status error, status:%v", status)
+		}
+		return data, status, nil
+	}
+}
+
+func (s *KceService) checkNodePoolState(clusterId, nodePoolId string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{},
+		Target:       []string{"active"},
+		Refresh:      s.kceNodePoolStateRefreshFunc(clusterId, nodePoolId, []string{"error"}),
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+		Delay:        2 * time.Second,
+		MinTimeout:   1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
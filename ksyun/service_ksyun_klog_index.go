@@ -0,0 +1,179 @@
+package ksyun
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	klog "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/klog/v20200731"
+	ksyunhttp "github.com/kingsoftcloud/sdk-go/v2/ksyun/common/http"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+// The index apis aren't in the klog sdk, so the requests are defined here and sent by the klog client.
+
+type klogFullTextIndex struct {
+	CaseSensitive  *bool   `json:"CaseSensitive,omitempty"`
+	IncludeChinese *bool   `json:"IncludeChinese,omitempty"`
+	Delimiters     *string `json:"Delimiters,omitempty"`
+}
+
+type klogFieldIndex struct {
+	FieldName      *string `json:"FieldName,omitempty"`
+	FieldType      *string `json:"FieldType,omitempty"`
+	CaseSensitive  *bool   `json:"CaseSensitive,omitempty"`
+	IncludeChinese *bool   `json:"IncludeChinese,omitempty"`
+	Delimiters     *string `json:"Delimiters,omitempty"`
+}
+
+type klogIndexRequest struct {
+	*ksyunhttp.BaseRequest
+	ProjectName   *string            `json:"ProjectName,omitempty"`
+	LogPoolName   *string            `json:"LogPoolName,omitempty"`
+	FullTextIndex *klogFullTextIndex `json:"FullTextIndex,omitempty"`
+	FieldIndex    []*klogFieldIndex  `json:"FieldIndex,omitempty"`
+}
+
+type klogIndexResponse struct {
+	*ksyunhttp.BaseResponse
+	ProjectName   *string            `json:"ProjectName"`
+	LogPoolName   *string            `json:"LogPoolName"`
+	FullTextIndex *klogFullTextIndex `json:"FullTextIndex"`
+	FieldIndex    []*klogFieldIndex  `json:"FieldIndex"`
+}
+
+func (r *klogIndexRequest) ToJsonString() string {
+	b, _ := json.Marshal(r)
+	return string(b)
+}
+
+func newKlogIndexRequest(action, projectName, logPoolName string) *klogIndexRequest {
+	request := &klogIndexRequest{
+		BaseRequest: &ksyunhttp.BaseRequest{},
+		ProjectName: &projectName,
+		LogPoolName: &logPoolName,
+	}
+	request.Init().WithApiInfo("klog", klog.APIVersion, action)
+	return request
+}
+
+type KlogIndexService struct {
+	client *KsyunClient
+}
+
+func (s *KlogIndexService) send(request *klogIndexRequest) (*klogIndexResponse, error) {
+	logger.Debug(logger.ReqFormat, request.GetAction(), request.ToJsonString())
+	response := &klogIndexResponse{BaseResponse: &ksyunhttp.BaseResponse{}}
+	if err := sendSdkRequest(&s.client.klogconn.Client, request, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *KlogIndexService) ReadIndex(projectName, logPoolName string) (resp *klogIndexResponse, err error) {
+	resp, err = s.send(newKlogIndexRequest("DescribeIndex", projectName, logPoolName))
+	if err != nil {
+		return resp, err
+	}
+	if resp.FullTextIndex == nil && len(resp.FieldIndex) == 0 {
+		return resp, fmt.Errorf("klog index of log pool %s not exist in project %s ", logPoolName, projectName)
+	}
+	return resp, err
+}
+
+func (s *KlogIndexService) ReadAndSetIndex(d *schema.ResourceData) (err error) {
+	projectName, logPoolName, err := parseKlogLogPoolId(d.Id())
+	if err != nil {
+		return err
+	}
+	resp, err := s.ReadIndex(projectName, logPoolName)
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	fullText := make([]interface{}, 0, 1)
+	if index := resp.FullTextIndex; index != nil {
+		fullText = append(fullText, map[string]interface{}{
+			"case_sensitive":  index.CaseSensitive != nil && *index.CaseSensitive,
+			"include_chinese": index.IncludeChinese != nil && *index.IncludeChinese,
+			"delimiters":      stringValue(index.Delimiters),
+		})
+	}
+	if err = d.Set("full_text", fullText); err != nil {
+		return err
+	}
+	fields := make([]interface{}, 0, len(resp.FieldIndex))
+	for _, index := range resp.FieldIndex {
+		fields = append(fields, map[string]interface{}{
+			"field_name":      stringValue(index.FieldName),
+			"field_type":      stringValue(index.FieldType),
+			"case_sensitive":  index.CaseSensitive != nil && *index.CaseSensitive,
+			"include_chinese": index.IncludeChinese != nil && *index.IncludeChinese,
+			"delimiters":      stringValue(index.Delimiters),
+		})
+	}
+	return d.Set("fields", fields)
+}
+
+// expandKlogIndexRequest builds the request with the full-text index and the field indexes of the resource
+func expandKlogIndexRequest(d *schema.ResourceData, action string) *klogIndexRequest {
+	request := newKlogIndexRequest(action, d.Get("project_name").(string), d.Get("log_pool_name").(string))
+	for _, v := range d.Get("full_text").([]interface{}) {
+		m := v.(map[string]interface{})
+		caseSensitive := m["case_sensitive"].(bool)
+		includeChinese := m["include_chinese"].(bool)
+		delimiters := m["delimiters"].(string)
+		request.FullTextIndex = &klogFullTextIndex{
+			CaseSensitive:  &caseSensitive,
+			IncludeChinese: &includeChinese,
+			Delimiters:     &delimiters,
+		}
+	}
+	for _, v := range d.Get("fields").([]interface{}) {
+		m := v.(map[string]interface{})
+		fieldName := m["field_name"].(string)
+		fieldType := m["field_type"].(string)
+		caseSensitive := m["case_sensitive"].(bool)
+		includeChinese := m["include_chinese"].(bool)
+		delimiters := m["delimiters"].(string)
+		request.FieldIndex = append(request.FieldIndex, &klogFieldIndex{
+			FieldName:      &fieldName,
+			FieldType:      &fieldType,
+			CaseSensitive:  &caseSensitive,
+			IncludeChinese: &includeChinese,
+			Delimiters:     &delimiters,
+		})
+	}
+	return request
+}
+
+func (s *KlogIndexService) CreateIndex(d *schema.ResourceData) (err error) {
+	if _, err = s.send(expandKlogIndexRequest(d, "CreateIndex")); err != nil {
+		return err
+	}
+	d.SetId(d.Get("project_name").(string) + ":" + d.Get("log_pool_name").(string))
+	return
+}
+
+func (s *KlogIndexService) ModifyIndex(d *schema.ResourceData) (err error) {
+	if !d.HasChange("full_text") && !d.HasChange("fields") {
+		return
+	}
+	_, err = s.send(expandKlogIndexRequest(d, "UpdateIndex"))
+	return err
+}
+
+func (s *KlogIndexService) DeleteIndex(d *schema.ResourceData) (err error) {
+	projectName, logPoolName, err := parseKlogLogPoolId(d.Id())
+	if err != nil {
+		return err
+	}
+	_, err = s.send(newKlogIndexRequest("DeleteIndex", projectName, logPoolName))
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
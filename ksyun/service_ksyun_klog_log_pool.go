@@ -0,0 +1,204 @@
+package ksyun
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	klog "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/klog/v20200731"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+type KlogLogPoolService struct {
+	client *KsyunClient
+}
+
+// ReadLogPools returns the log pools of all pages, or the log pools of the page if it's set in the request
+func (s *KlogLogPoolService) ReadLogPools(req *klog.ListLogPoolsRequest) (data []interface{}, err error) {
+	conn := s.client.klogconn
+	allPages := req.Page == nil
+	if req.Size == nil {
+		size := klogPageSize
+		req.Size = &size
+	}
+	if allPages {
+		page := 0
+		req.Page = &page
+	}
+	for {
+		var resp *klog.ListLogPoolsResponse
+		logger.Debug(logger.ReqFormat, "ListLogPools", req.ToJsonString())
+		resp, err = conn.ListLogPoolsSend(req)
+		if err != nil {
+			return data, err
+		}
+		for _, pool := range resp.LogPools {
+			item := map[string]interface{}{
+				"ProjectName": stringValue(pool.ProjectName),
+				"LogPoolName": stringValue(pool.LogPoolName),
+				"LogPoolId":   stringValue(pool.LogPoolId),
+				"CreateTime":  stringValue(pool.CreateTime),
+				"UpdateTime":  stringValue(pool.UpdateTime),
+				"Status":      stringValue(pool.Status),
+				"Scene":       stringValue(pool.Scene),
+				"WebTracking": pool.WebTracking != nil && *pool.WebTracking,
+			}
+			if item["ProjectName"] == "" && resp.ProjectName != nil {
+				item["ProjectName"] = *resp.ProjectName
+			}
+			if pool.RetentionDays != nil {
+				item["RetentionDays"] = *pool.RetentionDays
+			}
+			if pool.Partitions != nil {
+				item["Partitions"] = *pool.Partitions
+			}
+			tags := make([]interface{}, 0, len(pool.Tags))
+			for _, tag := range pool.Tags {
+				tags = append(tags, map[string]interface{}{
+					"Key":   stringValue(tag.Key),
+					"Value": stringValue(tag.Value),
+				})
+			}
+			item["Tags"] = tags
+			data = append(data, item)
+		}
+		if !allPages || len(resp.LogPools) < *req.Size {
+			return data, err
+		}
+		*req.Page++
+	}
+}
+
+func (s *KlogLogPoolService) ReadLogPool(projectName, logPoolName string) (data map[string]interface{}, err error) {
+	req := klog.NewListLogPoolsRequest()
+	req.ProjectName = &projectName
+	req.LogPoolName = &logPoolName
+	results, err := s.ReadLogPools(req)
+	if err != nil {
+		return data, err
+	}
+	// the log pool name is matched fuzzily in the api
+	for _, v := range results {
+		if item := v.(map[string]interface{}); item["LogPoolName"] == logPoolName {
+			return item, err
+		}
+	}
+	return data, fmt.Errorf("klog log pool %s not exist in project %s ", logPoolName, projectName)
+}
+
+func (s *KlogLogPoolService) ReadAndSetLogPools(d *schema.ResourceData, r *schema.Resource) (err error) {
+	req := klog.NewListLogPoolsRequest()
+	projectName := d.Get("project_name").(string)
+	req.ProjectName = &projectName
+	if v, ok := d.GetOk("log_pool_name"); ok {
+		name := v.(string)
+		req.LogPoolName = &name
+	}
+	data, err := s.ReadLogPools(req)
+	if err != nil {
+		return err
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "LogPoolName",
+		idFiled:     "LogPoolId",
+		targetField: "log_pools",
+		extra: map[string]SdkResponseMapping{
+			"LogPoolId": {
+				Field:    "id",
+				KeepAuto: true,
+			},
+		},
+	})
+}
+
+func (s *KlogLogPoolService) ReadAndSetLogPool(d *schema.ResourceData, r *schema.Resource) (err error) {
+	projectName, logPoolName, err := parseKlogLogPoolId(d.Id())
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadLogPool(projectName, logPoolName)
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return
+}
+
+func (s *KlogLogPoolService) CreateLogPool(d *schema.ResourceData) (err error) {
+	conn := s.client.klogconn
+	req := klog.NewCreateLogPoolRequest()
+	projectName := d.Get("project_name").(string)
+	logPoolName := d.Get("log_pool_name").(string)
+	req.ProjectName = &projectName
+	req.LogPoolName = &logPoolName
+	if v, ok := d.GetOk("retention_days"); ok {
+		retentionDays := v.(int)
+		req.RetentionDays = &retentionDays
+	}
+	if v, ok := d.GetOk("partitions"); ok {
+		partitions := v.(int)
+		req.Partitions = &partitions
+	}
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		req.Description = &description
+	}
+	logger.Debug(logger.ReqFormat, "CreateLogPool", req.ToJsonString())
+	if _, err = conn.CreateLogPoolSend(req); err != nil {
+		return err
+	}
+	d.SetId(projectName + ":" + logPoolName)
+	return
+}
+
+func (s *KlogLogPoolService) ModifyLogPool(d *schema.ResourceData) (err error) {
+	if !d.HasChange("retention_days") && !d.HasChange("partitions") && !d.HasChange("description") {
+		return
+	}
+	conn := s.client.klogconn
+	req := klog.NewUpdateLogPoolRequest()
+	projectName := d.Get("project_name").(string)
+	logPoolName := d.Get("log_pool_name").(string)
+	logPoolId := d.Get("log_pool_id").(string)
+	retentionDays := d.Get("retention_days").(int)
+	partitions := d.Get("partitions").(int)
+	description := d.Get("description").(string)
+	req.ProjectName = &projectName
+	req.LogPoolName = &logPoolName
+	req.LogPoolId = &logPoolId
+	req.RetentionDays = &retentionDays
+	req.Partitions = &partitions
+	req.Description = &description
+	logger.Debug(logger.ReqFormat, "UpdateLogPool", req.ToJsonString())
+	_, err = conn.UpdateLogPoolSend(req)
+	return err
+}
+
+func (s *KlogLogPoolService) DeleteLogPool(d *schema.ResourceData) (err error) {
+	conn := s.client.klogconn
+	req := klog.NewDeleteLogPoolRequest()
+	projectName := d.Get("project_name").(string)
+	logPoolId := d.Get("log_pool_id").(string)
+	req.ProjectName = &projectName
+	req.LogPoolId = &logPoolId
+	logger.Debug(logger.ReqFormat, "DeleteLogPool", req.ToJsonString())
+	_, err = conn.DeleteLogPoolSend(req)
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+// parseKlogLogPoolId splits the id of the log pool and the index, which is project_name:log_pool_name
+func parseKlogLogPoolId(id string) (projectName, logPoolName string, err error) {
+	items := strings.SplitN(id, ":", 2)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return "", "", fmt.Errorf("the id %q must be project_name:log_pool_name", id)
+	}
+	return items[0], items[1], nil
+}
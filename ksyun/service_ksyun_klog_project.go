@@ -1,24 +1,75 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	klog "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/klog/v20200731"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
+// klogPageSize is the page size of the klog list apis when the page isn't specified
+const klogPageSize = 100
+
 type KlogProjectService struct {
 	client *KsyunClient
 }
 
-func (lg *KlogProjectService) ReadAndSetProjects(d *schema.ResourceData, r *schema.Resource) (err error) {
-	var (
-		listProjectsResp *klog.ListProjectsResponse
-	)
-
+// ReadProjects returns the projects of all pages, or the projects of the page if it's set in the request
+func (lg *KlogProjectService) ReadProjects(req *klog.ListProjectsRequest) (data []interface{}, err error) {
 	conn := lg.client.klogconn
+	allPages := req.Page == nil
+	if req.Size == nil {
+		size := klogPageSize
+		req.Size = &size
+	}
+	if allPages {
+		page := 0
+		req.Page = &page
+	}
+	for {
+		var resp *klog.ListProjectsResponse
+		logger.Debug(logger.ReqFormat, "ListProjects", req.ToJsonString())
+		resp, err = conn.ListProjectsSend(req)
+		if err != nil {
+			return data, err
+		}
+		for _, project := range resp.Projects {
+			proj := map[string]interface{}{
+				"project_name":     *project.ProjectName,
+				"iam_project_id":   *project.IamProjectId,
+				"iam_project_name": *project.IamProjectName,
+				"region":           *project.Region,
+				"create_time":      *project.CreateTime,
+				"update_time":      *project.UpdateTime,
+				"status":           *project.Status,
+				"log_pool_num":     *project.LogPoolNum,
+			}
+
+			// 处理标签
+			tags := make([]map[string]interface{}, 0, len(project.Tags))
+			for _, tag := range project.Tags {
+				t := map[string]interface{}{
+					"key":   *tag.Key,
+					"value": *tag.Value,
+				}
+				tags = append(tags, t)
+			}
+			proj["tags"] = tags
 
+			data = append(data, proj)
+		}
+		if !allPages || len(resp.Projects) < *req.Size {
+			return data, err
+		}
+		*req.Page++
+	}
+}
+
+func (lg *KlogProjectService) ReadAndSetProjects(d *schema.ResourceData, r *schema.Resource) (err error) {
 	req := klog.NewListProjectsRequest()
 
-	if page, ok := d.GetOk("page"); ok {
+	if page, ok := d.GetOkExists("page"); ok {
 		p := page.(int)
 		req.Page = &p
 	}
@@ -38,56 +89,107 @@ func (lg *KlogProjectService) ReadAndSetProjects(d *schema.ResourceData, r *sche
 		req.Description = &t
 	}
 
-	listProjectsResp, err = conn.ListProjectsSend(req)
+	projects, err := lg.ReadProjects(req)
 	if err != nil {
 		return
 	}
 
-	// 处理项目列表数据
-	projects := make([]interface{}, 0, len(listProjectsResp.Projects))
-	for _, project := range listProjectsResp.Projects {
-		proj := map[string]interface{}{
-			"project_name":     *project.ProjectName,
-			"iam_project_id":   *project.IamProjectId,
-			"iam_project_name": *project.IamProjectName,
-			"region":           *project.Region,
-			"create_time":      *project.CreateTime,
-			"update_time":      *project.UpdateTime,
-			"status":           *project.Status,
-			"log_pool_num":     *project.LogPoolNum,
-		}
-
-		// 处理标签
-		tags := make([]map[string]interface{}, 0, len(project.Tags))
-		for _, tag := range project.Tags {
-			t := map[string]interface{}{
-				"key":   *tag.Key,
-				"value": *tag.Value,
-			}
-			tags = append(tags, t)
-		}
-		proj["tags"] = tags
-
-		projects = append(projects, proj)
-	}
-
-	// 设置项目列表到ResourceData
-	//if err := d.Set("projects", projects); err != nil {
-	//	return fmt.Errorf("set projects failed: %s", err)
-	//}
-	//
-	//d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
 	return mergeDataSourcesResp(d, r, ksyunDataSource{
 		collection:  projects,
 		idFiled:     "project_name",
 		nameField:   "project_name",
 		targetField: "projects",
-		//extra: map[string]SdkResponseMapping{
-		//	"ProjectName": {
-		//		Field:    "project_name",
-		//		KeepAuto: true,
-		//	},
-		//},
 	})
 }
+
+func (lg *KlogProjectService) ReadProject(projectName string) (data map[string]interface{}, err error) {
+	conn := lg.client.klogconn
+	req := klog.NewDescribeProjectRequest()
+	req.ProjectName = &projectName
+	logger.Debug(logger.ReqFormat, "DescribeProject", req.ToJsonString())
+	resp, err := conn.DescribeProjectSend(req)
+	if err != nil {
+		return data, err
+	}
+	if resp.ProjectName == nil || *resp.ProjectName != projectName {
+		return data, fmt.Errorf("klog project %s not exist ", projectName)
+	}
+	data = map[string]interface{}{
+		"ProjectName":    *resp.ProjectName,
+		"IamProjectName": stringValue(resp.IamProjectName),
+		"CreateTime":     stringValue(resp.CreateTime),
+		"UpdateTime":     stringValue(resp.UpdateTime),
+		"Region":         stringValue(resp.Region),
+		"Status":         stringValue(resp.Status),
+	}
+	if resp.IamProjectId != nil {
+		data["IamProjectId"] = *resp.IamProjectId
+	}
+	return data, err
+}
+
+func (lg *KlogProjectService) ReadAndSetProject(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := lg.ReadProject(d.Id())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return
+}
+
+func (lg *KlogProjectService) CreateProject(d *schema.ResourceData) (err error) {
+	conn := lg.client.klogconn
+	req := klog.NewCreateProjectRequest()
+	name := d.Get("project_name").(string)
+	req.ProjectName = &name
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		req.Description = &description
+	}
+	if v, ok := d.GetOk("iam_project_id"); ok {
+		iamProjectId := v.(int)
+		req.IamProjectId = &iamProjectId
+	}
+	logger.Debug(logger.ReqFormat, "CreateProject", req.ToJsonString())
+	if _, err = conn.CreateProjectSend(req); err != nil {
+		return err
+	}
+	d.SetId(name)
+	return
+}
+
+func (lg *KlogProjectService) ModifyProject(d *schema.ResourceData) (err error) {
+	if !d.HasChange("description") && !d.HasChange("iam_project_id") {
+		return
+	}
+	conn := lg.client.klogconn
+	req := klog.NewUpdateProjectRequest()
+	name := d.Id()
+	description := d.Get("description").(string)
+	req.ProjectName = &name
+	req.Description = &description
+	if v, ok := d.GetOk("iam_project_id"); ok {
+		iamProjectId := v.(int)
+		req.IamProjectId = &iamProjectId
+	}
+	logger.Debug(logger.ReqFormat, "UpdateProject", req.ToJsonString())
+	_, err = conn.UpdateProjectSend(req)
+	return err
+}
+
+func (lg *KlogProjectService) DeleteProject(d *schema.ResourceData) (err error) {
+	conn := lg.client.klogconn
+	req := klog.NewDeleteProjectRequest()
+	name := d.Id()
+	req.ProjectName = &name
+	logger.Debug(logger.ReqFormat, "DeleteProject", req.ToJsonString())
+	_, err = conn.DeleteProjectSend(req)
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
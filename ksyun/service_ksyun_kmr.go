@@ -1,9 +1,18 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kmr "github.com/kingsoftcloud/sdk-go/v2/ksyun/client/kmr/v20210902"
+	ksyunhttp "github.com/kingsoftcloud/sdk-go/v2/ksyun/common/http"
 	"github.com/mitchellh/mapstructure"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/mutexkv"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
 type KmrService struct {
@@ -91,3 +100,509 @@ func (s *KmrService) ReadAndSetClusters(d *schema.ResourceData, r *schema.Resour
 	d.Set("total", resp.Total)
 	return err
 }
+
+// kmrClusterMutex serializes the scaling of a cluster, the cluster accepts one scaling at a time
+var kmrClusterMutex = mutexkv.NewMutexKV()
+
+// kmrBootstrapAction is the script run on the nodes when the cluster is launched,
+// the bootstrap actions and the termination aren't in the kmr sdk yet.
+type kmrBootstrapAction struct {
+	Name       *string `json:"Name,omitempty"`
+	ScriptPath *string `json:"ScriptPath,omitempty"`
+	Args       *string `json:"Args,omitempty"`
+	Target     *string `json:"Target,omitempty"`
+}
+
+type kmrLaunchClusterRequest struct {
+	*kmr.LaunchClusterRequest
+	BootstrapActions []*kmrBootstrapAction `json:"BootstrapActions,omitempty"`
+}
+
+type kmrTerminateClusterRequest struct {
+	*ksyunhttp.BaseRequest
+	ClusterId *string `json:"ClusterId,omitempty"`
+}
+
+type kmrTerminateClusterResponse struct {
+	*ksyunhttp.BaseResponse
+	ClusterId *string `json:"ClusterId"`
+	RequestId *string `json:"RequestId"`
+}
+
+func (s *KmrService) ReadCluster(clusterId string) (resp *kmr.DescribeClusterResponse, err error) {
+	req := kmr.NewDescribeClusterRequest()
+	req.ClusterId = &clusterId
+	logger.Debug(logger.ReqFormat, "DescribeCluster", req.ToJsonString())
+	respInterface, err := s.client.WithKmrClient(func(conn *kmr.Client) (interface{}, error) {
+		return conn.DescribeClusterSend(req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp = respInterface.(*kmr.DescribeClusterResponse)
+	if resp.ClusterId == nil || *resp.ClusterId != clusterId || stringValue(resp.ClusterStatus) == "Terminated" {
+		return nil, fmt.Errorf("kmr cluster %s not exist ", clusterId)
+	}
+	return resp, err
+}
+
+// flattenKmrInstanceGroup returns the instance group in the schema of the resources
+func flattenKmrInstanceGroup(group map[string]interface{}, apiGroup kmrInstanceGroup) map[string]interface{} {
+	instanceIds := make([]interface{}, 0, len(apiGroup.InstanceIds))
+	for _, id := range apiGroup.InstanceIds {
+		instanceIds = append(instanceIds, stringValue(id))
+	}
+	result := make(map[string]interface{}, len(group))
+	for k, v := range group {
+		result[k] = v
+	}
+	result["id"] = stringValue(apiGroup.Id)
+	result["instance_group_type"] = stringValue(apiGroup.InstanceGroupType)
+	result["instance_type"] = stringValue(apiGroup.InstanceType)
+	result["resource_type"] = stringValue(apiGroup.ResourceType)
+	result["volume_type"] = stringValue(apiGroup.VolumeType)
+	if apiGroup.VolumeSize != nil {
+		result["volume_size"] = *apiGroup.VolumeSize
+	}
+	result["instance_count"] = len(instanceIds)
+	result["instance_ids"] = instanceIds
+	return result
+}
+
+// kmrInstanceGroup is the instance group of the DescribeCluster response
+type kmrInstanceGroup = struct {
+	Id                *string   `json:"Id" name:"Id"`
+	InstanceGroupType *string   `json:"InstanceGroupType" name:"InstanceGroupType"`
+	ResourceType      *string   `json:"ResourceType" name:"ResourceType"`
+	InstanceType      *string   `json:"InstanceType" name:"InstanceType"`
+	VolumeSize        *int      `json:"VolumeSize" name:"VolumeSize"`
+	VolumeType        *string   `json:"VolumeType" name:"VolumeType"`
+	InstanceIds       []*string `json:"InstanceIds" name:"InstanceIds"`
+}
+
+// matchKmrInstanceGroups returns the instance groups of the cluster managed by the resource.
+// The groups in the state are matched by id, the new ones are matched by the type and the instance type,
+// and all groups are returned on importing. The other groups, such as the ones of ksyun_kmr_instance_group, are ignored.
+func matchKmrInstanceGroups(groups []interface{}, apiGroups []kmrInstanceGroup) []interface{} {
+	result := make([]interface{}, 0, len(groups))
+	used := make(map[int]bool)
+	if len(groups) == 0 {
+		for _, apiGroup := range apiGroups {
+			result = append(result, flattenKmrInstanceGroup(map[string]interface{}{}, apiGroup))
+		}
+		return result
+	}
+	for _, v := range groups {
+		group := v.(map[string]interface{})
+		id, _ := group["id"].(string)
+		for i, apiGroup := range apiGroups {
+			if used[i] {
+				continue
+			}
+			if (id != "" && id == stringValue(apiGroup.Id)) ||
+				(id == "" && group["instance_group_type"] == stringValue(apiGroup.InstanceGroupType) &&
+					group["instance_type"] == stringValue(apiGroup.InstanceType)) {
+				used[i] = true
+				result = append(result, flattenKmrInstanceGroup(group, apiGroup))
+				break
+			}
+		}
+	}
+	return result
+}
+
+func (s *KmrService) ReadAndSetKmrCluster(d *schema.ResourceData, r *schema.Resource) (err error) {
+	resp, err := s.ReadCluster(d.Id())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	data := map[string]interface{}{
+		"ClusterName":   stringValue(resp.ClusterName),
+		"MainVersion":   stringValue(resp.MainVersion),
+		"VpcDomainId":   stringValue(resp.VpcDomainId),
+		"ChargeType":    stringValue(resp.ChargeType),
+		"ClusterStatus": stringValue(resp.ClusterStatus),
+		"Region":        stringValue(resp.Region),
+		"CreateTime":    stringValue(resp.CreateTime),
+		"UpdateTime":    stringValue(resp.UpdateTime),
+		"EnableEip":     resp.EnableEip != nil && *resp.EnableEip,
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return d.Set("instance_groups", matchKmrInstanceGroups(d.Get("instance_groups").([]interface{}), resp.InstanceGroups))
+}
+
+func expandKmrLaunchInstanceGroup(d *schema.ResourceData, group map[string]interface{}) *kmr.LaunchClusterInstanceGroups {
+	item := &kmr.LaunchClusterInstanceGroups{}
+	groupType := group["instance_group_type"].(string)
+	instanceType := group["instance_type"].(string)
+	count := group["instance_count"].(int)
+	zone := d.Get("availability_zone").(string)
+	subnetId := d.Get("vpc_subnet_id").(string)
+	item.InstanceGroupType = &groupType
+	item.InstanceType = &instanceType
+	item.InstanceCount = &count
+	item.AvailabilityZone = &zone
+	item.VpcSubnetId = &subnetId
+	if v, ok := group["resource_type"].(string); ok && v != "" {
+		item.ResourceType = &v
+	}
+	if v, ok := group["volume_type"].(string); ok && v != "" {
+		item.VolumeType = &v
+	}
+	if v, ok := group["volume_size"].(int); ok && v > 0 {
+		item.VolumeSize = &v
+	}
+	if v, ok := group["volume_count"].(int); ok && v > 0 {
+		item.VolumeCount = &v
+	}
+	if v, ok := group["system_disk_type"].(string); ok && v != "" {
+		item.SystemDiskType = &v
+	}
+	if v, ok := group["system_disk_size"].(int); ok && v > 0 {
+		item.SystemDiskSize = &v
+	}
+	return item
+}
+
+func (s *KmrService) CreateKmrCluster(d *schema.ResourceData) (err error) {
+	req := &kmrLaunchClusterRequest{LaunchClusterRequest: kmr.NewLaunchClusterRequest()}
+	name := d.Get("cluster_name").(string)
+	version := d.Get("main_version").(string)
+	vpcId := d.Get("vpc_domain_id").(string)
+	securityGroupId := d.Get("security_group_id").(string)
+	req.ClusterName = &name
+	req.MainVersion = &version
+	req.VpcDomainId = &vpcId
+	req.SecurityGroupId = &securityGroupId
+	if v, ok := d.GetOk("charge_type"); ok {
+		chargeType := v.(string)
+		req.ChargeType = &chargeType
+	}
+	if v, ok := d.GetOk("project_id"); ok {
+		projectId := v.(int)
+		req.ProjectId = &projectId
+	}
+	for _, v := range SchemaSetToStringSlice(d.Get("services")) {
+		service := v
+		req.Services = append(req.Services, &service)
+	}
+	for _, v := range d.Get("instance_groups").([]interface{}) {
+		req.InstanceGroups = append(req.InstanceGroups, expandKmrLaunchInstanceGroup(d, v.(map[string]interface{})))
+	}
+	for _, v := range d.Get("bootstrap_actions").([]interface{}) {
+		action := v.(map[string]interface{})
+		actionName := action["name"].(string)
+		scriptPath := action["script_path"].(string)
+		args := action["args"].(string)
+		target := action["target"].(string)
+		req.BootstrapActions = append(req.BootstrapActions, &kmrBootstrapAction{
+			Name:       &actionName,
+			ScriptPath: &scriptPath,
+			Args:       &args,
+			Target:     &target,
+		})
+	}
+	b, _ := json.Marshal(req)
+	logger.Debug(logger.ReqFormat, "LaunchCluster", string(b))
+	respInterface, err := s.client.WithKmrClient(func(conn *kmr.Client) (interface{}, error) {
+		resp := kmr.NewLaunchClusterResponse()
+		return resp, sendSdkRequest(&conn.Client, req, resp)
+	})
+	if err != nil {
+		return err
+	}
+	resp := respInterface.(*kmr.LaunchClusterResponse)
+	if resp.ClusterId == nil {
+		return fmt.Errorf("the cluster id is not returned")
+	}
+	d.SetId(*resp.ClusterId)
+	return s.waitKmrClusterRunning(d.Id(), d.Timeout(schema.TimeoutCreate))
+}
+
+// kmrScaleCall is a scaling of the cluster, the cluster is Scaling until it's finished
+type kmrScaleCall func(conn *kmr.Client) (interface{}, error)
+
+func (s *KmrService) scaleKmrCluster(clusterId string, timeout time.Duration, call kmrScaleCall) (err error) {
+	if _, err = s.client.WithKmrClient(call); err != nil {
+		return err
+	}
+	return s.waitKmrClusterRunning(clusterId, timeout)
+}
+
+// scaleOutKmrInstanceGroup adds instances to the instance group, the groups are identified by the type and
+// the InstanceGroupIndex, which is the order in the groups of the same type, a new group is added with the next index.
+func (s *KmrService) scaleOutKmrInstanceGroup(clusterId string, index int, group map[string]interface{}, count int, timeout time.Duration) (err error) {
+	req := kmr.NewScaleOutInstanceGroupsRequest()
+	req.ClusterId = &clusterId
+	item := &kmr.ScaleOutInstanceGroupsInstanceGroups{InstanceGroupIndex: &index, InstanceCount: &count}
+	groupType := group["instance_group_type"].(string)
+	instanceType := group["instance_type"].(string)
+	item.InstanceGroupType = &groupType
+	item.InstanceType = &instanceType
+	for k, p := range map[string]**string{"resource_type": &item.ResourceType, "volume_type": &item.VolumeType, "system_disk_type": &item.SystemDiskType} {
+		if v, ok := group[k].(string); ok && v != "" {
+			*p = &v
+		}
+	}
+	for k, p := range map[string]**int{"volume_size": &item.VolumeSize, "volume_count": &item.VolumeCount, "system_disk_size": &item.SystemDiskSize} {
+		if v, ok := group[k].(int); ok && v > 0 {
+			*p = &v
+		}
+	}
+	req.InstanceGroups = []*kmr.ScaleOutInstanceGroupsInstanceGroups{item}
+	logger.Debug(logger.ReqFormat, "ScaleOutInstanceGroups", req.ToJsonString())
+	return s.scaleKmrCluster(clusterId, timeout, func(conn *kmr.Client) (interface{}, error) {
+		return conn.ScaleOutInstanceGroupsSend(req)
+	})
+}
+
+// scaleInKmrInstanceGroup removes the last instances of the instance group
+func (s *KmrService) scaleInKmrInstanceGroup(clusterId, groupId string, instanceIds []interface{}, count int, timeout time.Duration) (err error) {
+	if count > len(instanceIds) {
+		count = len(instanceIds)
+	}
+	item := &kmr.ScaleInInstanceGroupsInstanceGroups{Id: &groupId}
+	for _, v := range instanceIds[len(instanceIds)-count:] {
+		instanceId := v.(string)
+		item.Instances = append(item.Instances, &kmr.ScaleInInstanceGroupsInstanceGroupsInstances{InstanceId: &instanceId})
+	}
+	req := kmr.NewScaleInInstanceGroupsRequest()
+	req.ClusterId = &clusterId
+	req.InstanceGroups = []*kmr.ScaleInInstanceGroupsInstanceGroups{item}
+	logger.Debug(logger.ReqFormat, "ScaleInInstanceGroups", req.ToJsonString())
+	return s.scaleKmrCluster(clusterId, timeout, func(conn *kmr.Client) (interface{}, error) {
+		return conn.ScaleInInstanceGroupsSend(req)
+	})
+}
+
+// kmrInstanceGroupIndex returns the InstanceGroupIndex of the group, or the index of a new group if it's not found
+func kmrInstanceGroupIndex(apiGroups []kmrInstanceGroup, groupType, groupId string) int {
+	index := 0
+	for _, apiGroup := range apiGroups {
+		if stringValue(apiGroup.InstanceGroupType) != groupType {
+			continue
+		}
+		if stringValue(apiGroup.Id) == groupId {
+			return index
+		}
+		index++
+	}
+	return index
+}
+
+// scaleKmrInstanceGroup scales the instance group to the count in place
+func (s *KmrService) scaleKmrInstanceGroup(clusterId string, group map[string]interface{}, oldCount int, timeout time.Duration) (err error) {
+	count := group["instance_count"].(int)
+	if count == oldCount {
+		return
+	}
+	groupType := group["instance_group_type"].(string)
+	if groupType == "MASTER" {
+		return fmt.Errorf("the instance_count of the MASTER group can't be changed")
+	}
+	kmrClusterMutex.Lock(clusterId)
+	defer kmrClusterMutex.Unlock(clusterId)
+	resp, err := s.ReadCluster(clusterId)
+	if err != nil {
+		return err
+	}
+	groupId := group["id"].(string)
+	if count > oldCount {
+		return s.scaleOutKmrInstanceGroup(clusterId, kmrInstanceGroupIndex(resp.InstanceGroups, groupType, groupId), group, count-oldCount, timeout)
+	}
+	return s.scaleInKmrInstanceGroup(clusterId, groupId, group["instance_ids"].([]interface{}), oldCount-count, timeout)
+}
+
+func (s *KmrService) ModifyKmrCluster(d *schema.ResourceData) (err error) {
+	if !d.HasChange("instance_groups") {
+		return
+	}
+	o, _ := d.GetChange("instance_groups")
+	oldGroups := o.([]interface{})
+	for i, v := range d.Get("instance_groups").([]interface{}) {
+		group := v.(map[string]interface{})
+		oldCount := oldGroups[i].(map[string]interface{})["instance_count"].(int)
+		// the instance ids and the group id are kept in the old state
+		group["id"] = oldGroups[i].(map[string]interface{})["id"]
+		group["instance_ids"] = oldGroups[i].(map[string]interface{})["instance_ids"]
+		if err = s.scaleKmrInstanceGroup(d.Id(), group, oldCount, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+func (s *KmrService) DeleteKmrCluster(d *schema.ResourceData) (err error) {
+	clusterId := d.Id()
+	req := &kmrTerminateClusterRequest{BaseRequest: &ksyunhttp.BaseRequest{}, ClusterId: &clusterId}
+	req.Init().WithApiInfo("kmr", kmr.APIVersion, "TerminateCluster")
+	logger.Debug(logger.ReqFormat, "TerminateCluster", clusterId)
+	_, err = s.client.WithKmrClient(func(conn *kmr.Client) (interface{}, error) {
+		resp := &kmrTerminateClusterResponse{BaseResponse: &ksyunhttp.BaseResponse{}}
+		return resp, sendSdkRequest(&conn.Client, req, resp)
+	})
+	if err != nil {
+		if notFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, callErr := s.ReadCluster(clusterId)
+		if callErr == nil {
+			return resource.RetryableError(fmt.Errorf("the kmr cluster %s is still being terminated", clusterId))
+		}
+		if notFoundError(callErr) {
+			return nil
+		}
+		return resource.NonRetryableError(callErr)
+	})
+}
+
+func (s *KmrService) waitKmrClusterRunning(clusterId string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Launching", "Scaling"},
+		Target:  []string{"Running"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := s.ReadCluster(clusterId)
+			if err != nil {
+				return nil, "", err
+			}
+			return resp, stringValue(resp.ClusterStatus), nil
+		},
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+		Delay:        1 * time.Second,
+		MinTimeout:   1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func parseKmrInstanceGroupId(id string) (clusterId, groupId string, err error) {
+	items := strings.SplitN(id, ":", 2)
+	if len(items) != 2 || items[0] == "" || items[1] == "" {
+		return "", "", fmt.Errorf("the id %q must be cluster_id:instance_group_id", id)
+	}
+	return items[0], items[1], nil
+}
+
+func findKmrInstanceGroup(apiGroups []kmrInstanceGroup, groupId string) *kmrInstanceGroup {
+	for i, apiGroup := range apiGroups {
+		if stringValue(apiGroup.Id) == groupId {
+			return &apiGroups[i]
+		}
+	}
+	return nil
+}
+
+func (s *KmrService) ReadAndSetKmrInstanceGroup(d *schema.ResourceData) (err error) {
+	clusterId, groupId, err := parseKmrInstanceGroupId(d.Id())
+	if err != nil {
+		return err
+	}
+	resp, err := s.ReadCluster(clusterId)
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	apiGroup := findKmrInstanceGroup(resp.InstanceGroups, groupId)
+	if apiGroup == nil {
+		d.SetId("")
+		return nil
+	}
+	group := flattenKmrInstanceGroup(map[string]interface{}{}, *apiGroup)
+	group["cluster_id"] = clusterId
+	group["instance_group_id"] = group["id"]
+	delete(group, "id")
+	for k, v := range group {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return
+}
+
+// kmrInstanceGroupData returns the instance group of the resource in the schema of the cluster instance groups
+func kmrInstanceGroupData(d *schema.ResourceData) map[string]interface{} {
+	group := map[string]interface{}{"instance_group_type": "TASK"}
+	for _, k := range []string{"instance_type", "instance_count", "resource_type", "volume_type", "volume_size",
+		"volume_count", "system_disk_type", "system_disk_size", "instance_ids"} {
+		group[k] = d.Get(k)
+	}
+	group["id"] = d.Get("instance_group_id")
+	return group
+}
+
+// CreateKmrInstanceGroup adds a new TASK group to the cluster, the id of the group is the one not in the cluster before.
+func (s *KmrService) CreateKmrInstanceGroup(d *schema.ResourceData) (err error) {
+	clusterId := d.Get("cluster_id").(string)
+	kmrClusterMutex.Lock(clusterId)
+	defer kmrClusterMutex.Unlock(clusterId)
+	resp, err := s.ReadCluster(clusterId)
+	if err != nil {
+		return err
+	}
+	groupIds := make(map[string]bool)
+	for _, apiGroup := range resp.InstanceGroups {
+		groupIds[stringValue(apiGroup.Id)] = true
+	}
+	group := kmrInstanceGroupData(d)
+	index := kmrInstanceGroupIndex(resp.InstanceGroups, "TASK", "")
+	err = s.scaleOutKmrInstanceGroup(clusterId, index, group, group["instance_count"].(int), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	resp, err = s.ReadCluster(clusterId)
+	if err != nil {
+		return err
+	}
+	for _, apiGroup := range resp.InstanceGroups {
+		if id := stringValue(apiGroup.Id); !groupIds[id] && stringValue(apiGroup.InstanceGroupType) == "TASK" {
+			d.SetId(clusterId + ":" + id)
+			return
+		}
+	}
+	return fmt.Errorf("the new instance group is not found in the kmr cluster %s", clusterId)
+}
+
+func (s *KmrService) ModifyKmrInstanceGroup(d *schema.ResourceData) (err error) {
+	if !d.HasChange("instance_count") {
+		return
+	}
+	o, _ := d.GetChange("instance_count")
+	return s.scaleKmrInstanceGroup(d.Get("cluster_id").(string), kmrInstanceGroupData(d), o.(int), d.Timeout(schema.TimeoutUpdate))
+}
+
+// DeleteKmrInstanceGroup removes all instances of the group, the empty TASK group is removed by the api
+func (s *KmrService) DeleteKmrInstanceGroup(d *schema.ResourceData) (err error) {
+	clusterId, groupId, err := parseKmrInstanceGroupId(d.Id())
+	if err != nil {
+		return err
+	}
+	kmrClusterMutex.Lock(clusterId)
+	defer kmrClusterMutex.Unlock(clusterId)
+	resp, err := s.ReadCluster(clusterId)
+	if err != nil {
+		if notFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	apiGroup := findKmrInstanceGroup(resp.InstanceGroups, groupId)
+	if apiGroup == nil {
+		return nil
+	}
+	instanceIds := make([]interface{}, 0, len(apiGroup.InstanceIds))
+	for _, id := range apiGroup.InstanceIds {
+		instanceIds = append(instanceIds, stringValue(id))
+	}
+	return s.scaleInKmrInstanceGroup(clusterId, groupId, instanceIds, len(instanceIds), d.Timeout(schema.TimeoutDelete))
+}
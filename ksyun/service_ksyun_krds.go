@@ -1,6 +1,7 @@
 package ksyun
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -8,8 +9,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -146,7 +147,7 @@ func readAndSetKrdsInstance(d *schema.ResourceData, meta interface{}, isRR bool)
 			Field: "db_parameter_group_id",
 		},
 	}
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		err = mergeTagsData(d, &data, meta.(*KsyunClient), "krds")
 		if err != nil {
 			return fmt.Errorf("reading tags error: %s", err)
@@ -736,7 +737,25 @@ func createKrdsInstance(d *schema.ResourceData, meta interface{}, isRR bool) (er
 	var (
 		api []ksyunApiCallFunc
 	)
-	if !isRR {
+	if _, ok := d.GetOk("restore_from"); ok && !isRR {
+		// restore instance, the restore actions don't take the security group and the parameter group,
+		// they are modified on the restored instance like a rr instance
+		instanceRestoreCall, err := restoreKrdsDbInstance(d, meta)
+		if err != nil {
+			return err
+		}
+		api = append(api, instanceRestoreCall)
+		modifyDBSg, err := modifyKrdsInstanceSg(d, meta, false)
+		if err != nil {
+			return err
+		}
+		api = append(api, modifyDBSg)
+		modifyParametersCall, err := modifyKrdsParameterGroup(d, meta, true)
+		if err != nil {
+			return err
+		}
+		api = append(api, modifyParametersCall)
+	} else if !isRR {
 		// template parameter
 		tempParameterGroupCall, err := createKrdsTempParameterGroup(d, meta)
 		if err != nil {
@@ -816,6 +835,7 @@ func createKrdsDbInstance(d *schema.ResourceData, meta interface{}) (call ksyunA
 		"force_restart":         {Ignore: true},
 		"availability_zone_1":   {mapping: "AvailabilityZone.1"},
 		"availability_zone_2":   {mapping: "AvailabilityZone.2"},
+		"restore_from":          {Ignore: true},
 	}
 
 	createReq, err := SdkRequestAutoMapping(d, resourceKsyunKrds(), false, transform, nil, SdkReqParameter{
@@ -1350,7 +1370,12 @@ func krdsInstanceStateRefreshFunc(d *schema.ResourceData, meta interface{}, inst
 }
 
 func krdsInstanceCustomizeDiff() schema.CustomizeDiffFunc {
-	return func(diff *schema.ResourceDiff, i interface{}) (err error) {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) (err error) {
+		if v, ok := diff.GetOk("restore_from.0.point_in_time"); ok && v != "" {
+			if source, ok := diff.GetOk("restore_from.0.source_db_instance_identifier"); !ok || source == "" {
+				return fmt.Errorf("restore_from.0.source_db_instance_identifier is required when restoring to point_in_time")
+			}
+		}
 		if diff.HasChange("parameters") {
 			var (
 				data map[string]interface{}
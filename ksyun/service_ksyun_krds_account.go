@@ -0,0 +1,325 @@
+package ksyun
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readKrdsAccounts(meta interface{}, condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp     *map[string]interface{}
+		accounts interface{}
+	)
+	resp, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "DescribeInstanceAccounts", condition)
+	if err != nil {
+		return data, err
+	}
+	accounts, err = getSdkValue("Data.InstanceAccounts", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = accounts.([]interface{})
+	return data, err
+}
+
+func readKrdsAccount(meta interface{}, instanceId, accountName string) (data map[string]interface{}, err error) {
+	var accounts []interface{}
+	accounts, err = readKrdsAccounts(meta, map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"InstanceAccountName":  accountName,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range accounts {
+		if account := v.(map[string]interface{}); account["InstanceAccountName"] == accountName {
+			data = account
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("krds account %s not exist in instance %s", accountName, instanceId)
+	}
+	return data, err
+}
+
+// flattenKrdsAccount converts the account of the api to the schema of the resource and the data source
+func flattenKrdsAccount(item map[string]interface{}) map[string]interface{} {
+	privileges := make([]interface{}, 0)
+	if v, ok := item["InstanceAccountPrivileges"].([]interface{}); ok {
+		for _, p := range v {
+			privilege := p.(map[string]interface{})
+			privileges = append(privileges, map[string]interface{}{
+				"database_name": privilege["Schema"],
+				"privilege":     privilege["Privilege"],
+			})
+		}
+	}
+	return map[string]interface{}{
+		"account_name":        item["InstanceAccountName"],
+		"account_description": item["InstanceAccountDescription"],
+		"account_type":        item["InstanceAccountType"],
+		"account_status":      item["InstanceAccountStatus"],
+		"account_privileges":  privileges,
+	}
+}
+
+func krdsAccountPrivilegesReq(d *schema.ResourceData, req map[string]interface{}) {
+	for idx, v := range d.Get("account_privileges").(*schema.Set).List() {
+		privilege := v.(map[string]interface{})
+		req[fmt.Sprintf("InstanceAccountPrivileges.%d.Schema", idx+1)] = privilege["database_name"]
+		req[fmt.Sprintf("InstanceAccountPrivileges.%d.Privilege", idx+1)] = privilege["privilege"]
+	}
+}
+
+func createKrdsAccount(d *schema.ResourceData, meta interface{}) (err error) {
+	instanceId := d.Get("db_instance_identifier").(string)
+	accountName := d.Get("account_name").(string)
+	req := map[string]interface{}{
+		"DBInstanceIdentifier":    instanceId,
+		"InstanceAccountName":     accountName,
+		"InstanceAccountPassword": d.Get("account_password"),
+	}
+	if v, ok := d.GetOk("account_description"); ok {
+		req["InstanceAccountDescription"] = v
+	}
+	krdsAccountPrivilegesReq(d, req)
+	_, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "CreateInstanceAccount", req)
+	if err != nil {
+		return err
+	}
+	d.SetId(AssembleIds(instanceId, accountName))
+	return err
+}
+
+func readAndSetKrdsAccount(d *schema.ResourceData, meta interface{}) (err error) {
+	var data map[string]interface{}
+	data, err = readKrdsAccount(meta, d.Get("db_instance_identifier").(string), d.Get("account_name").(string))
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for k, v := range flattenKrdsAccount(data) {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func modifyKrdsAccount(d *schema.ResourceData, meta interface{}) (err error) {
+	if d.HasChanges("account_password", "account_description") {
+		req := map[string]interface{}{
+			"DBInstanceIdentifier":       d.Get("db_instance_identifier"),
+			"InstanceAccountName":        d.Get("account_name"),
+			"InstanceAccountDescription": d.Get("account_description"),
+		}
+		if d.HasChange("account_password") {
+			req["InstanceAccountPassword"] = d.Get("account_password")
+		}
+		if _, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "ModifyInstanceAccountInfo", req); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("account_privileges") {
+		req := map[string]interface{}{
+			"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+			"InstanceAccountName":  d.Get("account_name"),
+		}
+		krdsAccountPrivilegesReq(d, req)
+		if _, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "ModifyInstanceAccountPrivileges", req); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func removeKrdsAccount(d *schema.ResourceData, meta interface{}) (err error) {
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"InstanceAccountName":  d.Get("account_name"),
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "DeleteInstanceAccount", req)
+		if err == nil || notFoundErrorNew(err) {
+			return nil
+		}
+		return krdsBusyRetryError(d, meta, err)
+	})
+}
+
+// krdsBusyRetryError retries the action while the instance is running the other tasks,
+// such as a backup or a change of the other accounts, and fails fast on the other errors.
+func krdsBusyRetryError(d *schema.ResourceData, meta interface{}, err error) *resource.RetryError {
+	instance, readErr := readKrdsInstance(d, meta, d.Get("db_instance_identifier").(string))
+	if readErr == nil && instance["DBInstanceStatus"] != "ACTIVE" {
+		return resource.RetryableError(err)
+	}
+	return retryError(err)
+}
+
+func readKrdsDatabases(meta interface{}, condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp      *map[string]interface{}
+		databases interface{}
+	)
+	resp, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "DescribeInstanceDatabases", condition)
+	if err != nil {
+		return data, err
+	}
+	databases, err = getSdkValue("Data.InstanceDatabases", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = databases.([]interface{})
+	return data, err
+}
+
+func readKrdsDatabase(meta interface{}, instanceId, databaseName string) (data map[string]interface{}, err error) {
+	var databases []interface{}
+	databases, err = readKrdsDatabases(meta, map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"InstanceDatabaseName": databaseName,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range databases {
+		if database := v.(map[string]interface{}); database["InstanceDatabaseName"] == databaseName {
+			data = database
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("krds database %s not exist in instance %s", databaseName, instanceId)
+	}
+	return data, err
+}
+
+// flattenKrdsDatabase converts the database of the api to the schema of the resource and the data source
+func flattenKrdsDatabase(item map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"database_name":      item["InstanceDatabaseName"],
+		"character_set_name": item["CharacterSetName"],
+		"collation_name":     item["CollationName"],
+		"description":        item["InstanceDatabaseDescription"],
+		"status":             item["InstanceDatabaseStatus"],
+	}
+}
+
+func createKrdsDatabase(d *schema.ResourceData, meta interface{}) (err error) {
+	instanceId := d.Get("db_instance_identifier").(string)
+	databaseName := d.Get("database_name").(string)
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"InstanceDatabaseName": databaseName,
+		"CharacterSetName":     d.Get("character_set_name"),
+	}
+	if v, ok := d.GetOk("collation_name"); ok {
+		req["CollationName"] = v
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req["InstanceDatabaseDescription"] = v
+	}
+	_, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "CreateInstanceDatabase", req)
+	if err != nil {
+		return err
+	}
+	d.SetId(AssembleIds(instanceId, databaseName))
+	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		data, err := readKrdsDatabase(meta, instanceId, databaseName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if data["InstanceDatabaseStatus"] != "ACTIVE" {
+			return resource.RetryableError(fmt.Errorf("the database %s is %v", databaseName, data["InstanceDatabaseStatus"]))
+		}
+		return nil
+	})
+}
+
+func readAndSetKrdsDatabase(d *schema.ResourceData, meta interface{}) (err error) {
+	var data map[string]interface{}
+	data, err = readKrdsDatabase(meta, d.Get("db_instance_identifier").(string), d.Get("database_name").(string))
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for k, v := range flattenKrdsDatabase(data) {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func removeKrdsDatabase(d *schema.ResourceData, meta interface{}) (err error) {
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"InstanceDatabaseName": d.Get("database_name"),
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "DeleteInstanceDatabaseAction", req)
+		if err == nil || notFoundErrorNew(err) {
+			return nil
+		}
+		return krdsBusyRetryError(d, meta, err)
+	})
+}
+
+func readAndSetKrdsAccounts(d *schema.ResourceData, meta interface{}) (err error) {
+	var data []interface{}
+	data, err = readKrdsAccounts(meta, map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+	})
+	if err != nil {
+		return err
+	}
+	return setKrdsDataSource(d, data, "InstanceAccountName", "InstanceAccountName", "accounts", flattenKrdsAccount)
+}
+
+func readAndSetKrdsDatabases(d *schema.ResourceData, meta interface{}) (err error) {
+	var data []interface{}
+	data, err = readKrdsDatabases(meta, map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+	})
+	if err != nil {
+		return err
+	}
+	return setKrdsDataSource(d, data, "InstanceDatabaseName", "InstanceDatabaseName", "databases", flattenKrdsDatabase)
+}
+
+// setKrdsDataSource filters the items by name_regex and sets them to the target field,
+// the accounts and the databases have no ids, so they are identified by the names.
+func setKrdsDataSource(d *schema.ResourceData, data []interface{}, idField, nameField, targetField string,
+	flatten func(map[string]interface{}) map[string]interface{},
+) (err error) {
+	items := make([]interface{}, 0)
+	for _, v := range data {
+		item, matched, err := mergeNameRegex(d, v.(map[string]interface{}), nameField)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			item = v.(map[string]interface{})
+		}
+		if item != nil {
+			items = append(items, item)
+		}
+	}
+	_, _, err = SdkSliceMapping(d, items, SdkSliceData{
+		IdField: idField,
+		IdMappingFunc: func(idField string, item map[string]interface{}) string {
+			return item[idField].(string)
+		},
+		SliceMappingFunc: flatten,
+		TargetName:       targetField,
+	})
+	return err
+}
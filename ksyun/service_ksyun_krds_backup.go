@@ -0,0 +1,330 @@
+package ksyun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/helper"
+	"github.com/terraform-providers/terraform-provider-ksyun/logger"
+)
+
+func readKrdsBackups(meta interface{}, condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		backups interface{}
+	)
+	conn := meta.(*KsyunClient).krdsconn
+	action := "DescribeDBBackups"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err = conn.DescribeDBBackups(&condition)
+	if err != nil {
+		return data, err
+	}
+	backups, err = getSdkValue("Data.Backups", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = backups.([]interface{})
+	return data, err
+}
+
+func readKrdsBackup(meta interface{}, instanceId, backupId string) (data map[string]interface{}, err error) {
+	var backups []interface{}
+	backups, err = readKrdsBackups(meta, map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"DBBackupIdentifier":   backupId,
+	})
+	if err != nil {
+		return data, err
+	}
+	for _, v := range backups {
+		if backup := v.(map[string]interface{}); backup["DBBackupIdentifier"] == backupId {
+			data = backup
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("krds backup %s not exist in instance %s", backupId, instanceId)
+	}
+	return data, err
+}
+
+// flattenKrdsBackup converts the backup of the api to the schema of the resource and the data source
+func flattenKrdsBackup(item map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"db_backup_identifier": item["DBBackupIdentifier"],
+		"backup_name":          item["DBBackupName"],
+		"backup_mode":          item["BackupMode"],
+		"backup_type":          item["BackupType"],
+		"backup_size":          item["BackupSize"],
+		"status":               item["Status"],
+		"backup_create_time":   item["BackupCreateTime"],
+		"backup_updated_time":  item["BackupUpdatedTime"],
+	}
+}
+
+func createKrdsBackup(d *schema.ResourceData, meta interface{}) (err error) {
+	var resp *map[string]interface{}
+	instanceId := d.Get("db_instance_identifier").(string)
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+		"DBBackupName":         d.Get("backup_name"),
+	}
+	resp, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "CreateDBBackup", req)
+	if err != nil {
+		return err
+	}
+	backupId, err := getSdkValue("Data.DBBackupIdentifier", *resp)
+	if err != nil {
+		return err
+	}
+	if backupId == nil {
+		return fmt.Errorf("the backup id of krds instance %s is not returned", instanceId)
+	}
+	d.SetId(AssembleIds(instanceId, backupId.(string)))
+	_ = d.Set("db_backup_identifier", backupId)
+	return checkKrdsBackupState(d, meta, d.Timeout(schema.TimeoutCreate))
+}
+
+func readAndSetKrdsBackup(d *schema.ResourceData, meta interface{}) (err error) {
+	var data map[string]interface{}
+	data, err = readKrdsBackup(meta, d.Get("db_instance_identifier").(string), d.Get("db_backup_identifier").(string))
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for k, v := range flattenKrdsBackup(data) {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func removeKrdsBackup(d *schema.ResourceData, meta interface{}) (err error) {
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+		"DBBackupIdentifier":   d.Get("db_backup_identifier"),
+	}
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = sendActionRequest(meta.(*KsyunClient).krdsconn.Client, "GET", "DeleteDBBackup", req)
+		if err == nil || notFoundErrorNew(err) {
+			return nil
+		}
+		return krdsBusyRetryError(d, meta, err)
+	})
+}
+
+func checkKrdsBackupState(d *schema.ResourceData, meta interface{}, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{},
+		Target:     []string{"COMPLETED"},
+		Refresh:    krdsBackupStateRefreshFunc(meta, d.Get("db_instance_identifier").(string), d.Get("db_backup_identifier").(string), []string{"FAILED"}),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func krdsBackupStateRefreshFunc(meta interface{}, instanceId, backupId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := readKrdsBackup(meta, instanceId, backupId)
+		if err != nil {
+			return nil, "", err
+		}
+		status := fmt.Sprintf("%v", data["Status"])
+		for _, v := range failStates {
+			if v == status {
+				return nil, "", fmt.Errorf("krds backup %s status error, status:%v", backupId, status)
+			}
+		}
+		return data, status, nil
+	}
+}
+
+func readAndSetKrdsBackups(d *schema.ResourceData, meta interface{}) (err error) {
+	var data []interface{}
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+	}
+	if v, ok := d.GetOk("backup_mode"); ok {
+		req["BackupMode"] = v
+	}
+	data, err = readKrdsBackups(meta, req)
+	if err != nil {
+		return err
+	}
+	return setKrdsDataSource(d, data, "DBBackupIdentifier", "DBBackupName", "backups", flattenKrdsBackup)
+}
+
+func readKrdsBackupPolicy(meta interface{}, instanceId string) (data map[string]interface{}, err error) {
+	var (
+		resp   *map[string]interface{}
+		policy interface{}
+	)
+	conn := meta.(*KsyunClient).krdsconn
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": instanceId,
+	}
+	action := "DescribeDBBackupPolicy"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err = conn.DescribeDBBackupPolicy(&req)
+	if err != nil {
+		return data, err
+	}
+	policy, err = getSdkValue("Data.DBBackupPolicy", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = policy.(map[string]interface{})
+	if len(data) == 0 {
+		return data, fmt.Errorf("the backup policy of krds instance %s not exist", instanceId)
+	}
+	return data, err
+}
+
+func modifyKrdsBackupPolicy(d *schema.ResourceData, meta interface{}) (err error) {
+	conn := meta.(*KsyunClient).krdsconn
+	req := map[string]interface{}{
+		"DBInstanceIdentifier": d.Get("db_instance_identifier"),
+	}
+	if v, ok := d.GetOk("preferred_backup_time"); ok {
+		req["PreferredBackupTime"] = v
+	}
+	if v, ok := d.GetOk("backup_retention_period"); ok {
+		req["BackupRetentionPeriod"] = v
+	}
+	action := "ModifyDBBackupPolicy"
+	logger.Debug(logger.ReqFormat, action, req)
+	_, err = conn.ModifyDBBackupPolicy(&req)
+	return err
+}
+
+func readAndSetKrdsBackupPolicy(d *schema.ResourceData, meta interface{}) (err error) {
+	var data map[string]interface{}
+	data, err = readKrdsBackupPolicy(meta, d.Id())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	policy := map[string]interface{}{
+		"db_instance_identifier":  d.Id(),
+		"preferred_backup_time":   data["PreferredBackupTime"],
+		"backup_retention_period": data["BackupRetentionPeriod"],
+	}
+	for k, v := range policy {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// krdsRestorableTimeLayout is the time format of RestorableTime, in the time zone of Beijing
+const krdsRestorableTimeLayout = "2006-01-02 15:04:05"
+
+// restoreKrdsDbInstance creates the instance by restoring a backup or restoring another instance to a point in time.
+// The restore actions only take a few params, the restored instance gets the class, the engine, the network
+// and the master user of the source, so they are expected to match the source. The point in time restore
+// doesn't take the name either, the name and the master password are modified after the instance is restored.
+func restoreKrdsDbInstance(d *schema.ResourceData, meta interface{}) (call ksyunApiCallFunc, err error) {
+	restore, _ := helper.GetSchemaListHeadMap(d, "restore_from")
+	var (
+		action   string
+		sourceId string
+	)
+	req := make(map[string]interface{})
+	if v, ok := restore["backup_id"]; ok && v != "" {
+		action = "RestoreDBInstanceFromDBBackup"
+		req["DBBackupIdentifier"] = v
+		req["DBInstanceName"] = d.Get("db_instance_name")
+		req["DBInstanceType"] = d.Get("db_instance_type")
+		for field, param := range map[string]string{
+			"availability_zone_1": "AvailabilityZone",
+			"project_id":          "ProjectId",
+			"port":                "Port",
+			"bill_type":           "BillType",
+			"duration":            "Duration",
+		} {
+			if v, ok := d.GetOk(field); ok {
+				req[param] = v
+			}
+		}
+	} else {
+		action = "RestoreDBInstanceToPointInTime"
+		sourceId = restore["source_db_instance_identifier"].(string)
+		pointInTime, err := time.Parse(time.RFC3339, restore["point_in_time"].(string))
+		if err != nil {
+			return call, err
+		}
+		req["DBInstanceIdentifier"] = sourceId
+		req["RestorableTime"] = pointInTime.In(time.FixedZone("CST", 8*3600)).Format(krdsRestorableTimeLayout)
+	}
+
+	call = func(d *schema.ResourceData, meta interface{}) (err error) {
+		conn := meta.(*KsyunClient).krdsconn
+		logger.Debug(logger.ReqFormat, action, req)
+		resp, err := sendActionRequest(conn.Client, "GET", action, req)
+		if err != nil {
+			return err
+		}
+		logger.Debug(logger.AllFormat, action, req, *resp, err)
+		instanceId, err := krdsRestoredInstanceId(*resp, sourceId)
+		if err != nil {
+			return fmt.Errorf("error on reading the instance restored by %s: %s", action, err)
+		}
+		d.SetId(instanceId)
+		err = checkKrdsInstanceState(d, meta, "", d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+		data, err := readKrdsInstance(d, meta, "")
+		if err != nil {
+			return err
+		}
+		// the restored instance keeps the master password of the source, and the name too if restored to a point in time
+		modifyReq := map[string]interface{}{
+			"DBInstanceIdentifier": instanceId,
+			"MasterUserPassword":   d.Get("master_user_password"),
+		}
+		if name := d.Get("db_instance_name"); data["DBInstanceName"] != name {
+			modifyReq["DBInstanceName"] = name
+		}
+		logger.Debug(logger.ReqFormat, "ModifyDBInstance", modifyReq)
+		if _, err = conn.ModifyDBInstance(&modifyReq); err != nil {
+			return err
+		}
+		// the parameters are modified on the parameter group of the restored instance
+		return d.Set("db_parameter_group_id", data["DBParameterGroupId"])
+	}
+	return call, err
+}
+
+// krdsRestoredInstanceId returns the id of the restored instance. RestoreDBInstanceFromDBBackup returns the instance,
+// RestoreDBInstanceToPointInTime returns the instances of the restore, the source is skipped if it's in them.
+func krdsRestoredInstanceId(resp map[string]interface{}, sourceId string) (string, error) {
+	if v, err := getSdkValue("Data.DBInstance.DBInstanceIdentifier", resp); err == nil && v != nil {
+		return fmt.Sprintf("%v", v), nil
+	}
+	instances, err := getSdkValue("Data.Instances", resp)
+	if err != nil {
+		return "", err
+	}
+	list, _ := instances.([]interface{})
+	for _, item := range list {
+		instance, _ := item.(map[string]interface{})
+		if id, ok := instance["DBInstanceIdentifier"].(string); ok && id != "" && id != sourceId {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("the restored instance is not found in the response")
+}
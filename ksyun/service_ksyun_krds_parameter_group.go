@@ -2,7 +2,7 @@ package ksyun
 
 import (
 	"github.com/KscSDK/ksc-sdk-go/service/krds"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -2,7 +2,9 @@ package ksyun
 
 import (
 	"github.com/ks3sdklib/ksyun-ks3-go-sdk/ks3"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -48,3 +50,108 @@ func (s *Ks3Service) WaitForKs3BucketObject(bucket *ks3.Bucket, id string, statu
 		}
 	}
 }
+
+func (s *Ks3Service) DescribeKs3Object(bucketName, key string) (response http.Header, err error) {
+	request := map[string]string{"bucketName": bucketName, "key": key}
+	raw, err := s.client.WithKs3BucketByName(bucketName, func(bucket *ks3.Bucket) (interface{}, error) {
+		return bucket.GetObjectDetailedMeta(key)
+	})
+	if err != nil {
+		if ks3NotFoundError(err) {
+			return response, WrapErrorf(err, NotFoundMsg, KsyunKs3GoSdk)
+		}
+		return response, WrapErrorf(err, DefaultErrorMsg, key, "GetObjectDetailedMeta", KsyunKs3GoSdk)
+	}
+
+	addDebug("GetObjectDetailedMeta", raw, request)
+	response, _ = raw.(http.Header)
+	return
+}
+
+func (s *Ks3Service) DescribeKs3ObjectAcl(bucketName, key string) (acl ks3.ACLType, err error) {
+	request := map[string]string{"bucketName": bucketName, "key": key}
+	raw, err := s.client.WithKs3BucketByName(bucketName, func(bucket *ks3.Bucket) (interface{}, error) {
+		return bucket.GetObjectACL(key)
+	})
+	if err != nil {
+		return acl, WrapErrorf(err, DefaultErrorMsg, key, "GetObjectACL", KsyunKs3GoSdk)
+	}
+
+	addDebug("GetObjectACL", raw, request)
+	response, _ := raw.(ks3.GetObjectACLResult)
+	return response.GetCannedACL(), nil
+}
+
+// ListKs3Objects lists the objects and the common prefixes under the prefix page by page,
+// a maxKeys of 0 lists all of them.
+func (s *Ks3Service) ListKs3Objects(bucketName, prefix, delimiter string, maxKeys int) (objects []ks3.ObjectProperties, commonPrefixes []string, err error) {
+	marker := ""
+	for {
+		options := []ks3.Option{ks3.Prefix(prefix), ks3.MaxKeys(1000)}
+		if delimiter != "" {
+			options = append(options, ks3.Delimiter(delimiter))
+		}
+		if marker != "" {
+			options = append(options, ks3.Marker(marker))
+		}
+		raw, err := s.client.WithKs3BucketByName(bucketName, func(bucket *ks3.Bucket) (interface{}, error) {
+			return bucket.ListObjects(options...)
+		})
+		if err != nil {
+			return objects, commonPrefixes, WrapErrorf(err, DefaultErrorMsg, bucketName, "ListObjects", KsyunKs3GoSdk)
+		}
+		addDebug("ListObjects", raw, map[string]string{"bucketName": bucketName, "prefix": prefix, "marker": marker})
+		response, _ := raw.(ks3.ListObjectsResult)
+		objects = append(objects, response.Objects...)
+		commonPrefixes = append(commonPrefixes, response.CommonPrefixes...)
+
+		if maxKeys > 0 && len(objects)+len(commonPrefixes) >= maxKeys {
+			break
+		}
+		if !response.IsTruncated {
+			break
+		}
+		// the next marker is only returned with a delimiter, the last key is used otherwise
+		marker = response.NextMarker
+		if marker == "" && len(response.Objects) > 0 {
+			marker = response.Objects[len(response.Objects)-1].Key
+		}
+		if marker == "" {
+			break
+		}
+	}
+	if maxKeys > 0 && len(objects) > maxKeys {
+		objects = objects[:maxKeys]
+	}
+	return objects, commonPrefixes, nil
+}
+
+// flattenKs3ObjectHeader converts the headers of the object to the schema of the resource and the data source
+func flattenKs3ObjectHeader(header http.Header) map[string]interface{} {
+	storageClass := header.Get(ks3.HTTPHeaderKs3StorageClass)
+	if storageClass == "" {
+		storageClass = string(ks3.StorageStandard)
+	}
+	// the user metadata is stored in lowercase
+	metadata := make(map[string]interface{})
+	for k := range header {
+		if strings.HasPrefix(k, ks3.HTTPHeaderKs3MetaPrefix) {
+			metadata[strings.ToLower(strings.TrimPrefix(k, ks3.HTTPHeaderKs3MetaPrefix))] = header.Get(k)
+		}
+	}
+	contentLength, _ := strconv.Atoi(header.Get(ks3.HTTPHeaderContentLength))
+	return map[string]interface{}{
+		"content_type":           header.Get(ks3.HTTPHeaderContentType),
+		"content_length":         contentLength,
+		"etag":                   trimKs3Etag(header.Get(ks3.HTTPHeaderEtag)),
+		"last_modified":          header.Get(ks3.HTTPHeaderLastModified),
+		"storage_class":          storageClass,
+		"server_side_encryption": header.Get(ks3.HTTPHeaderKs3ServerSideEncryption),
+		"metadata":               metadata,
+	}
+}
+
+// trimKs3Etag removes the quotes around the etag
+func trimKs3Etag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
@@ -1,13 +1,14 @@
 package ksyun
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -612,7 +613,7 @@ func readMongodbInstanceCommon(d *schema.ResourceData, meta interface{}, r *sche
 	if _, ok := data["InstanceAccount"]; !ok {
 		err = d.Set("instance_account", "root")
 	}
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		err = mergeTagsData(d, &data, meta.(*KsyunClient), "mongodb-instance")
 		if err != nil {
 			return fmt.Errorf("reading tags error: %s", err)
@@ -653,7 +654,7 @@ func mongodbShardInstanceSchemaDiffSuppressFunc() schema.SchemaDiffSuppressFunc
 }
 
 func mongodbShardInstanceCustomizeDiffFunc() schema.CustomizeDiffFunc {
-	return func(diff *schema.ResourceDiff, i interface{}) (err error) {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) (err error) {
 		extra := []string{"shard_num", "mongos_num", "shard_class", "mongos_class", "storage"}
 		for _, v := range extra {
 			if diff.HasChange(v) {
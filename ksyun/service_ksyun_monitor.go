@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -274,3 +277,576 @@ func (s *MonitorService) DeleteAlarmPolicyCall(d *schema.ResourceData) (callback
 	}
 	return callback, err
 }
+
+// monitorJsonBody sends the body in json, as the monitor sdk does for its POST actions
+func monitorJsonBody(r *request.Request) {
+	r.HTTPRequest.Header.Set("Content-Type", "application/json; charset=utf-8")
+}
+
+// monitorId formats the numeric id returned by the monitor api
+func monitorId(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', 0, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// monitorIds converts the ids of the terraform resources to the numeric ids of the monitor api
+func monitorIds(ids ...string) (result []int, err error) {
+	for _, id := range ids {
+		i, err := strconv.Atoi(id)
+		if err != nil {
+			return result, fmt.Errorf("invalid monitor id %q: %s", id, err)
+		}
+		result = append(result, i)
+	}
+	return result, err
+}
+
+// ReadAlarmPolicies lists the alarm policies page by page, the PageIndex starts from 1
+func (s *MonitorService) ReadAlarmPolicies() (data []interface{}, err error) {
+	conn := s.client.monitorv4conn
+	pageSize := 100
+	for pageIndex := 1; ; pageIndex++ {
+		req := map[string]interface{}{
+			"PageIndex": strconv.Itoa(pageIndex),
+			"PageSize":  strconv.Itoa(pageSize),
+		}
+		action := "ListAlarmPolicy"
+		logger.Debug(logger.ReqFormat, action, req)
+		resp, err := conn.ListAlarmPolicy(&req)
+		if err != nil {
+			return data, err
+		}
+		results, _ := getSdkValue("Data.AlarmPolicyList", *resp)
+		items, _ := results.([]interface{})
+		data = append(data, items...)
+		if len(items) < pageSize {
+			return data, nil
+		}
+	}
+}
+
+func (s *MonitorService) ReadAndSetAlarmPolicies(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadAlarmPolicies()
+	if err != nil {
+		return err
+	}
+	var ids []string
+	if v, ok := d.GetOk("ids"); ok {
+		ids = SchemaSetToStringSlice(v)
+	}
+	var collection []interface{}
+	for _, v := range data {
+		item := v.(map[string]interface{})
+		item["id"] = monitorId(item["policyId"])
+		if len(ids) > 0 && !stringSliceContains(ids, item["id"].(string)) {
+			continue
+		}
+		collection = append(collection, item)
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  collection,
+		nameField:   "policyName",
+		idFiled:     "id",
+		targetField: "policies",
+	})
+}
+
+func (s *MonitorService) ReadAlarmContactGroup(id string) (data map[string]interface{}, err error) {
+	conn := s.client.monitorv4conn
+	req := map[string]interface{}{}
+	action := "GetUserGroup"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := conn.GetUserGroup(&req)
+	if err != nil {
+		return data, err
+	}
+	results, _ := getSdkValue("Data.UserGrpList", *resp)
+	items, _ := results.([]interface{})
+	for _, v := range items {
+		if group := v.(map[string]interface{}); monitorId(group["userGrpId"]) == id {
+			data = group
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("monitor alarm contact group %s not exist ", id)
+	}
+	return data, err
+}
+
+func (s *MonitorService) ReadAndSetAlarmContactGroup(d *schema.ResourceData) (err error) {
+	data, err := s.ReadAlarmContactGroup(d.Id())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for k, v := range map[string]interface{}{
+		"group_name": data["name"],
+		"user_count": data["userCount"],
+	} {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *MonitorService) CreateAlarmContactGroup(d *schema.ResourceData) (err error) {
+	req := map[string]interface{}{"UserGrpName": d.Get("group_name")}
+	resp, err := sendActionRequest(s.client.monitorv4conn.Client, "POST", "AddUserGroup", req, monitorJsonBody)
+	if err != nil {
+		return err
+	}
+	id, err := getSdkValue("Data.UserGrpId", *resp)
+	if err != nil {
+		return err
+	}
+	d.SetId(monitorId(id))
+	return err
+}
+
+func (s *MonitorService) ModifyAlarmContactGroup(d *schema.ResourceData) (err error) {
+	if !d.HasChange("group_name") {
+		return
+	}
+	ids, err := monitorIds(d.Id())
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{
+		"UserGrpId":   ids[0],
+		"UserGrpName": d.Get("group_name"),
+	}
+	_, err = sendActionRequest(s.client.monitorv4conn.Client, "POST", "UpdateUserGroup", req, monitorJsonBody)
+	return err
+}
+
+func (s *MonitorService) DeleteAlarmContactGroup(d *schema.ResourceData) (err error) {
+	ids, err := monitorIds(d.Id())
+	if err != nil {
+		return err
+	}
+	_, err = sendActionRequest(s.client.monitorv4conn.Client, "POST", "DeleteUserGroup", map[string]interface{}{"UserGrpId": ids}, monitorJsonBody)
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+// ReadAlarmContacts lists the alarm contacts, only the contacts of the groups are returned if the groupIds is not empty
+func (s *MonitorService) ReadAlarmContacts(groupIds []int) (data []interface{}, err error) {
+	conn := s.client.monitorv4conn
+	req := map[string]interface{}{}
+	// the params of the GET requests are flattened by hand as the sdk only accepts the string values
+	for i, id := range groupIds {
+		req[fmt.Sprintf("UserGrpId.%d", i+1)] = strconv.Itoa(id)
+	}
+	action := "GetAlertUser"
+	logger.Debug(logger.ReqFormat, action, req)
+	resp, err := conn.GetAlertUser(&req)
+	if err != nil {
+		return data, err
+	}
+	results, _ := getSdkValue("Data.UserList", *resp)
+	data, _ = results.([]interface{})
+	return data, err
+}
+
+func (s *MonitorService) ReadAlarmContact(id string) (data map[string]interface{}, err error) {
+	contacts, err := s.ReadAlarmContacts(nil)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range contacts {
+		if contact := v.(map[string]interface{}); monitorId(contact["userId"]) == id {
+			data = contact
+		}
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("monitor alarm contact %s not exist ", id)
+	}
+	return data, err
+}
+
+// flattenAlarmContactGroupIds returns the ids of the groups the contact belongs to
+func flattenAlarmContactGroupIds(contact map[string]interface{}) []string {
+	groups, _ := contact["UserGroups"].([]interface{})
+	ids := make([]string, 0, len(groups))
+	for _, v := range groups {
+		ids = append(ids, monitorId(v.(map[string]interface{})["UserGrpId"]))
+	}
+	return ids
+}
+
+func (s *MonitorService) ReadAndSetAlarmContact(d *schema.ResourceData) (err error) {
+	data, err := s.ReadAlarmContact(d.Id())
+	if err != nil {
+		if notFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	for k, v := range map[string]interface{}{
+		"contact_name":      data["userName"],
+		"email":             data["userEmail"],
+		"phone":             data["userPhone"],
+		"status":            data["userStatus"],
+		"contact_group_ids": flattenAlarmContactGroupIds(data),
+	} {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// alarmContactReq returns the params of the contact, the groups are always sent as they are replaced by the api
+func alarmContactReq(d *schema.ResourceData) (req map[string]interface{}, err error) {
+	groupIds, err := monitorIds(SchemaSetToStringSlice(d.Get("contact_group_ids"))...)
+	if err != nil {
+		return req, err
+	}
+	req = map[string]interface{}{
+		"UserName":  d.Get("contact_name"),
+		"UserEmail": d.Get("email"),
+		"UserPhone": d.Get("phone"),
+		"UserGrpId": groupIds,
+	}
+	return req, err
+}
+
+func (s *MonitorService) CreateAlarmContact(d *schema.ResourceData) (err error) {
+	req, err := alarmContactReq(d)
+	if err != nil {
+		return err
+	}
+	resp, err := sendActionRequest(s.client.monitorv4conn.Client, "POST", "AddAlertUser", req, monitorJsonBody)
+	if err != nil {
+		return err
+	}
+	id, err := getSdkValue("Data.UserId", *resp)
+	if err != nil {
+		return err
+	}
+	d.SetId(monitorId(id))
+	if status := d.Get("status").(int); status != 1 {
+		return s.modifyAlarmContactStatus(d.Id(), status)
+	}
+	return err
+}
+
+func (s *MonitorService) modifyAlarmContactStatus(id string, status int) (err error) {
+	ids, err := monitorIds(id)
+	if err != nil {
+		return err
+	}
+	req := map[string]interface{}{
+		"UserId":     ids,
+		"UserStatus": status,
+	}
+	action := "UpdateAlertUserStatus"
+	logger.Debug(logger.ReqFormat, action, req)
+	_, err = s.client.monitorv4conn.UpdateAlertUserStatus(&req)
+	return err
+}
+
+func (s *MonitorService) ModifyAlarmContact(d *schema.ResourceData) (err error) {
+	if d.HasChanges("contact_name", "email", "phone", "contact_group_ids") {
+		req, err := alarmContactReq(d)
+		if err != nil {
+			return err
+		}
+		ids, err := monitorIds(d.Id())
+		if err != nil {
+			return err
+		}
+		req["UserId"] = ids[0]
+		if _, err = sendActionRequest(s.client.monitorv4conn.Client, "POST", "UpdateAlertUser", req, monitorJsonBody); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("status") {
+		return s.modifyAlarmContactStatus(d.Id(), d.Get("status").(int))
+	}
+	return err
+}
+
+func (s *MonitorService) DeleteAlarmContact(d *schema.ResourceData) (err error) {
+	ids, err := monitorIds(d.Id())
+	if err != nil {
+		return err
+	}
+	_, err = sendActionRequest(s.client.monitorv4conn.Client, "POST", "DeleteAlertUser", map[string]interface{}{"UserId": ids}, monitorJsonBody)
+	if err != nil && notFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *MonitorService) ReadAndSetAlarmContacts(d *schema.ResourceData, r *schema.Resource) (err error) {
+	var groupIds []int
+	if v, ok := d.GetOk("contact_group_ids"); ok {
+		if groupIds, err = monitorIds(SchemaSetToStringSlice(v)...); err != nil {
+			return err
+		}
+	}
+	data, err := s.ReadAlarmContacts(groupIds)
+	if err != nil {
+		return err
+	}
+	for _, v := range data {
+		item := v.(map[string]interface{})
+		item["id"] = monitorId(item["userId"])
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "userName",
+		idFiled:     "id",
+		targetField: "contacts",
+		extra: map[string]SdkResponseMapping{
+			"userName":   {Field: "contact_name"},
+			"userEmail":  {Field: "email"},
+			"userPhone":  {Field: "phone"},
+			"userStatus": {Field: "status"},
+			"UserGroups": {
+				Field: "contact_groups",
+				FieldRespFunc: func(i interface{}) interface{} {
+					groups, _ := i.([]interface{})
+					result := make([]interface{}, 0, len(groups))
+					for _, v := range groups {
+						group := v.(map[string]interface{})
+						result = append(result, map[string]interface{}{
+							"contact_group_id": group["UserGrpId"],
+							"group_name":       group["GroupName"],
+						})
+					}
+					return result
+				},
+			},
+		},
+	})
+}
+
+func (s *MonitorService) ReadMetrics(condition map[string]interface{}) (data []interface{}, err error) {
+	conn := s.client.monitorconn
+	pageSize := 100
+	for pageIndex := 1; ; pageIndex++ {
+		req := map[string]interface{}{
+			"PageIndex": strconv.Itoa(pageIndex),
+			"PageSize":  strconv.Itoa(pageSize),
+		}
+		for k, v := range condition {
+			req[k] = v
+		}
+		action := "ListMetrics"
+		logger.Debug(logger.ReqFormat, action, req)
+		resp, err := conn.ListMetrics(&req)
+		if err != nil {
+			return data, err
+		}
+		results, _ := getSdkValue("ListMetricsResult.Metrics.Member", *resp)
+		items, _ := results.([]interface{})
+		data = append(data, items...)
+		if len(items) < pageSize {
+			return data, nil
+		}
+	}
+}
+
+func (s *MonitorService) ReadAndSetMetrics(d *schema.ResourceData, r *schema.Resource) (err error) {
+	condition := map[string]interface{}{"Namespace": d.Get("namespace")}
+	if v, ok := d.GetOk("instance_id"); ok {
+		condition["InstanceID"] = v
+	}
+	if v, ok := d.GetOk("metric_name"); ok {
+		condition["MetricName"] = v
+	}
+	data, err := s.ReadMetrics(condition)
+	if err != nil {
+		return err
+	}
+	for _, v := range data {
+		item := v.(map[string]interface{})
+		item["id"] = fmt.Sprintf("%s:%s", item["Namespace"], item["MetricName"])
+	}
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "MetricName",
+		idFiled:     "id",
+		targetField: "metrics",
+	})
+}
+
+// ReadMetricStatistics queries the datapoints of the metric of each instance,
+// the results are keyed by the instance id.
+func (s *MonitorService) ReadMetricStatistics(condition map[string]interface{}) (data map[string][]interface{}, err error) {
+	conn := s.client.monitorv2conn
+	action := "GetMetricStatisticsBatch"
+	logger.Debug(logger.ReqFormat, action, condition)
+	resp, err := conn.GetMetricStatisticsBatch(&condition)
+	if err != nil {
+		return data, err
+	}
+	if msg, _ := getSdkValue("ErrorMessage", *resp); msg != nil {
+		if messages, ok := msg.([]interface{}); ok && len(messages) > 0 {
+			return data, fmt.Errorf("error on querying the metric statistics, %v", messages)
+		}
+	}
+	results, _ := getSdkValue("GetMetricStatisticsBatchResults", *resp)
+	// a single result is returned as an object rather than a list
+	if result, ok := results.(map[string]interface{}); ok {
+		results = []interface{}{result}
+	}
+	items, _ := results.([]interface{})
+	data = make(map[string][]interface{})
+	for _, v := range items {
+		item := v.(map[string]interface{})
+		datapoints, _ := getSdkValue("Datapoints.Member", item)
+		points, _ := datapoints.([]interface{})
+		instanceId := fmt.Sprintf("%v", item["Instance"])
+		data[instanceId] = append(data[instanceId], points...)
+	}
+	return data, err
+}
+
+// aggregateMetricDatapoints returns the datapoints of the statistic and their aggregated value,
+// the average of the averages, the max of the maximums or the min of the minimums.
+func aggregateMetricDatapoints(points []interface{}, statistic string) (value float64, datapoints []map[string]interface{}, err error) {
+	for _, v := range points {
+		point := v.(map[string]interface{})
+		raw, ok := point[statistic]
+		if !ok || raw == nil {
+			continue
+		}
+		pointValue, err := strconv.ParseFloat(fmt.Sprintf("%v", raw), 64)
+		if err != nil {
+			return value, datapoints, fmt.Errorf("invalid %s value %v at %v", statistic, raw, point["Timestamp"])
+		}
+		datapoints = append(datapoints, map[string]interface{}{
+			"timestamp": point["Timestamp"],
+			"value":     pointValue,
+		})
+		switch {
+		case len(datapoints) == 1:
+			value = pointValue
+		case statistic == "Max":
+			value = math.Max(value, pointValue)
+		case statistic == "Min":
+			value = math.Min(value, pointValue)
+		default:
+			value += pointValue
+		}
+	}
+	if statistic == "Average" && len(datapoints) > 0 {
+		value = value / float64(len(datapoints))
+	}
+	return value, datapoints, err
+}
+
+func (s *MonitorService) ReadAndSetMetricData(d *schema.ResourceData) (err error) {
+	var startTime, endTime time.Time
+	if v, ok := d.GetOk("start_time"); ok {
+		startTime, _ = time.Parse(time.RFC3339, v.(string))
+		endTime = time.Now().UTC()
+		if v, ok := d.GetOk("end_time"); ok {
+			endTime, _ = time.Parse(time.RFC3339, v.(string))
+		}
+	} else if _, ok := d.GetOk("end_time"); ok {
+		return fmt.Errorf("the end_time must be set together with the start_time")
+	} else {
+		endTime = time.Now().UTC().Truncate(time.Minute)
+		startTime = endTime.Add(-time.Duration(d.Get("last_minutes").(int)) * time.Minute)
+	}
+	if !startTime.Before(endTime) {
+		return fmt.Errorf("the start_time %s must be before the end_time %s", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+
+	namespace := d.Get("namespace").(string)
+	metricName := d.Get("metric_name").(string)
+	statistic := d.Get("statistic").(string)
+	var instanceIds []string
+	for _, id := range d.Get("instance_ids").([]interface{}) {
+		instanceIds = append(instanceIds, id.(string))
+	}
+	condition := map[string]interface{}{
+		"Namespace":   namespace,
+		"StartTime":   startTime.Format(time.RFC3339),
+		"EndTime":     endTime.Format(time.RFC3339),
+		"Period":      d.Get("period"),
+		"Aggregate.1": statistic,
+	}
+	for i, id := range instanceIds {
+		condition[fmt.Sprintf("Metrics.%d.InstanceID", i+1)] = id
+		condition[fmt.Sprintf("Metrics.%d.MetricName", i+1)] = metricName
+	}
+	results, err := s.ReadMetricStatistics(condition)
+	if err != nil {
+		return err
+	}
+
+	var (
+		data    []map[string]interface{}
+		missing []string
+		values  []float64
+	)
+	for _, id := range instanceIds {
+		value, datapoints, err := aggregateMetricDatapoints(results[id], statistic)
+		if err != nil {
+			return fmt.Errorf("error on reading the metric %s of instance %s, %s", metricName, id, err)
+		}
+		if len(datapoints) == 0 {
+			missing = append(missing, id)
+			continue
+		}
+		data = append(data, map[string]interface{}{
+			"instance_id": id,
+			"value":       value,
+			"datapoints":  datapoints,
+		})
+		values = append(values, value)
+	}
+	if len(missing) > 0 && d.Get("fail_on_missing_data").(bool) {
+		return fmt.Errorf("no data of the metric %s in namespace %s between %s and %s for instances: %s",
+			metricName, namespace, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339), strings.Join(missing, ", "))
+	}
+	// 0 is a valid limit, such as for the error counts
+	if v, ok := d.GetOkExists("max_allowed_value"); ok {
+		for _, item := range data {
+			if item["value"].(float64) > v.(float64) {
+				return fmt.Errorf("the %s of the metric %s of instance %s is %v, above the max allowed value %v",
+					statistic, metricName, item["instance_id"], item["value"], v)
+			}
+		}
+	}
+
+	d.SetId(hashStringArray(append([]string{namespace, metricName, statistic,
+		startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)}, instanceIds...)))
+	result := map[string]interface{}{
+		"start_time":           startTime.Format(time.RFC3339),
+		"end_time":             endTime.Format(time.RFC3339),
+		"missing_instance_ids": missing,
+		"total_count":          len(data),
+		"data":                 data,
+	}
+	if len(values) > 0 {
+		result["max_value"], result["min_value"] = values[0], values[0]
+		for _, v := range values {
+			result["max_value"] = math.Max(result["max_value"].(float64), v)
+			result["min_value"] = math.Min(result["min_value"].(float64), v)
+		}
+	}
+	for k, v := range result {
+		if err = d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		return writeToFile(outputFile.(string), data)
+	}
+	return err
+}
@@ -7,8 +7,8 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
@@ -564,8 +564,9 @@ func (s *TagService) ReplaceResourcesTagsCall(d *schema.ResourceData, r *schema.
 }
 
 func (s *TagService) ReplaceResourcesTagsWithResourceCall(d *schema.ResourceData, r *schema.Resource, resourceType string, isUpdate bool, disableDryRun bool) (callback ApiCall, err error) {
+	// tags_all is the tags merged with the default tags of the provider
 	transform := map[string]SdkReqTransform{
-		"tags": {
+		"tags_all": {
 			FieldReqFunc: func(i interface{}, s string, m map[string]string, i2 int, s2 string, m2 *map[string]interface{}) (int, error) {
 				if tagMap, ok := i.(map[string]interface{}); ok {
 					for k, v := range tagMap {
@@ -582,7 +583,7 @@ func (s *TagService) ReplaceResourcesTagsWithResourceCall(d *schema.ResourceData
 	if err != nil {
 		return callback, err
 	}
-	if len(req) > 0 || d.HasChange("tags") {
+	if len(req) > 0 || d.HasChange("tags_all") {
 		req["ResourceType"] = resourceType
 		return s.ReplaceResourcesTagsCommonCall(req, disableDryRun)
 	}
@@ -9,9 +9,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/helper"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
@@ -894,6 +893,14 @@ func (s *VpcService) CreateRouteCall(d *schema.ResourceData, r *schema.Resource)
 	callback = ApiCall{
 		param:  &req,
 		action: "CreateRoute",
+		beforeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (bool, error) {
+			if peeringId, ok := (*call.param)["VpcPeeringConnectionId"]; ok && (*call.param)["RouteType"] == "Peering" {
+				if err := s.waitRoutePeeringActive(d, peeringId.(string)); err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		},
 		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
 			conn := client.vpcconn
 			logger.Debug(logger.RespFormat, call.action, *(call.param))
@@ -913,6 +920,16 @@ func (s *VpcService) CreateRouteCall(d *schema.ResourceData, r *schema.Resource)
 	return callback, err
 }
 
+// waitRoutePeeringActive waits for the peering connection to be active, the route to a pending one is rejected by the api.
+// The peering connection may be accepted by another account meanwhile, so it only fails on the terminal states.
+func (s *VpcService) waitRoutePeeringActive(d *schema.ResourceData, peeringId string) (err error) {
+	err = s.checkVpcPeeringConnectionState(d, peeringId, []string{"active"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("the vpc peering connection %s must be active when RouteType is Peering, %s", peeringId, err)
+	}
+	return err
+}
+
 func (s *VpcService) CreateRoute(d *schema.ResourceData, r *schema.Resource) (err error) {
 	call, err := s.CreateRouteCall(d, r)
 	if err != nil {
@@ -1007,7 +1024,7 @@ func (s *VpcService) ReadNat(d *schema.ResourceData, natId string) (data map[str
 	if err != nil {
 		return data, err
 	}
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		req["IsContainTag"] = true
 	}
 	results, err = s.ReadNats(req)
@@ -3996,7 +4013,7 @@ func (s *VpcService) ModifyNetworkInterfaceSecondaryInfraIpCall(d *schema.Resour
 					switch setVal.Kind() {
 					case reflect.Map:
 						m := setVal.Interface().(map[string]interface{})
-						return hashcode.String(m["ip"].(string))
+						return schema.HashString(m["ip"].(string))
 					}
 					return -1
 				}, itemSet.List()[:unassignCount])
@@ -4051,3 +4068,366 @@ var logCall = func(d *schema.ResourceData, client *KsyunClient, resp *map[string
 	logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
 	return err
 }
+
+func (s *VpcService) ReadVpcPeeringConnections(condition map[string]interface{}) (data []interface{}, err error) {
+	var (
+		resp    *map[string]interface{}
+		results interface{}
+	)
+	conn := s.client.vpcconn
+	action := "DescribeVpcPeeringConnections"
+	logger.Debug(logger.ReqFormat, action, condition)
+	if condition == nil {
+		resp, err = conn.DescribeVpcPeeringConnections(nil)
+		if err != nil {
+			return data, err
+		}
+	} else {
+		resp, err = conn.DescribeVpcPeeringConnections(&condition)
+		if err != nil {
+			return data, err
+		}
+	}
+
+	results, err = getSdkValue("VpcPeeringConnectionSet", *resp)
+	if err != nil {
+		return data, err
+	}
+	data, _ = results.([]interface{})
+	for _, v := range data {
+		flattenVpcPeeringConnection(v.(map[string]interface{}))
+	}
+	return data, err
+}
+
+// flattenVpcPeeringConnection lifts the vpc info of both sides to the top level,
+// so the requester side is the vpc and the accepter side is the peer vpc.
+func flattenVpcPeeringConnection(data map[string]interface{}) {
+	if requester, ok := data["RequesterVpcInfo"].(map[string]interface{}); ok {
+		data["VpcId"] = requester["VpcId"]
+		data["Region"] = requester["RegionName"]
+		data["AccountId"] = requester["AccountId"]
+	}
+	if accepter, ok := data["AccepterVpcInfo"].(map[string]interface{}); ok {
+		data["PeerVpcId"] = accepter["VpcId"]
+		data["PeerRegion"] = accepter["RegionName"]
+		data["PeerAccountId"] = accepter["AccountId"]
+	}
+}
+
+func (s *VpcService) ReadVpcPeeringConnection(d *schema.ResourceData, peeringId string) (data map[string]interface{}, err error) {
+	var results []interface{}
+	if peeringId == "" {
+		peeringId = d.Id()
+	}
+	req := map[string]interface{}{
+		"VpcPeeringConnectionId.1": peeringId,
+	}
+	results, err = s.ReadVpcPeeringConnections(req)
+	if err != nil {
+		return data, err
+	}
+	for _, v := range results {
+		data = v.(map[string]interface{})
+	}
+	if len(data) == 0 {
+		return data, fmt.Errorf("VpcPeeringConnection %s not exist ", peeringId)
+	}
+	return data, err
+}
+
+func (s *VpcService) ReadAndSetVpcPeeringConnection(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadVpcPeeringConnection(d, "")
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+func (s *VpcService) ReadAndSetVpcPeeringConnectionAccepter(d *schema.ResourceData, r *schema.Resource) (err error) {
+	data, err := s.ReadVpcPeeringConnection(d, "")
+	if err != nil {
+		if notFoundError(err) && !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	data["VpcPeeringConnectionId"] = d.Id()
+	SdkResponseAutoResourceData(d, r, data, nil)
+	return err
+}
+
+func (s *VpcService) ReadAndSetVpcPeeringConnections(d *schema.ResourceData, r *schema.Resource) (err error) {
+	transform := map[string]SdkReqTransform{
+		"ids": {
+			mapping: "VpcPeeringConnectionId",
+			Type:    TransformWithN,
+		},
+		"vpc_id": {
+			mapping: "vpc-id",
+			Type:    TransformWithFilter,
+		},
+		"peer_vpc_id": {
+			mapping: "peer-vpc-id",
+			Type:    TransformWithFilter,
+		},
+		"state": {
+			mapping: "state",
+			Type:    TransformWithFilter,
+		},
+	}
+	req, err := mergeDataSourcesReq(d, r, transform)
+	if err != nil {
+		return err
+	}
+	data, err := s.ReadVpcPeeringConnections(req)
+	if err != nil {
+		return err
+	}
+
+	return mergeDataSourcesResp(d, r, ksyunDataSource{
+		collection:  data,
+		nameField:   "PeeringName",
+		idFiled:     "VpcPeeringConnectionId",
+		targetField: "vpc_peering_connections",
+		extra: map[string]SdkResponseMapping{
+			"VpcPeeringConnectionId": {
+				Field:    "id",
+				KeepAuto: true,
+			},
+		},
+	})
+}
+
+func (s *VpcService) CreateVpcPeeringConnectionCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"auto_accept": {Ignore: true},
+	}
+	req, err := SdkRequestAutoMapping(d, r, false, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+	callback = ApiCall{
+		param:  &req,
+		action: "CreateVpcPeeringConnection",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.vpcconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.CreateVpcPeeringConnection(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			id, err := getSdkValue("VpcPeeringConnection.VpcPeeringConnectionId", *resp)
+			if err != nil {
+				if id, err = getSdkValue("VpcPeeringConnectionId", *resp); err != nil {
+					return err
+				}
+			}
+			d.SetId(id.(string))
+			return s.checkVpcPeeringConnectionState(d, d.Id(), []string{"pending-acceptance", "active"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *VpcService) AcceptVpcPeeringConnectionCall(d *schema.ResourceData, peeringId string) (callback ApiCall, err error) {
+	req := map[string]interface{}{
+		"VpcPeeringConnectionId": peeringId,
+	}
+	callback = ApiCall{
+		param:         &req,
+		action:        "AcceptVpcPeeringConnection",
+		disableDryRun: true,
+		beforeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (bool, error) {
+			data, err := s.ReadVpcPeeringConnection(d, peeringId)
+			if err != nil {
+				return false, err
+			}
+			switch data["State"] {
+			case "active":
+				return false, nil
+			case "pending-acceptance":
+				return true, nil
+			default:
+				return false, fmt.Errorf("the vpc peering connection %s can not be accepted in the state %v", peeringId, data["State"])
+			}
+		},
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			if peeringId == "" {
+				(*call.param)["VpcPeeringConnectionId"] = d.Id()
+			}
+			conn := client.vpcconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.AcceptVpcPeeringConnection(call.param)
+			return resp, err
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return s.checkVpcPeeringConnectionState(d, peeringId, []string{"active"}, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+	return callback, err
+}
+
+func (s *VpcService) CreateVpcPeeringConnection(d *schema.ResourceData, r *schema.Resource) (err error) {
+	var calls []ApiCall
+	call, err := s.CreateVpcPeeringConnectionCall(d, r)
+	if err != nil {
+		return err
+	}
+	calls = append(calls, call)
+	if d.Get("auto_accept").(bool) {
+		// the id is only known after the creation, so it's read from d on executing
+		accept, err := s.AcceptVpcPeeringConnectionCall(d, "")
+		if err != nil {
+			return err
+		}
+		calls = append(calls, accept)
+	}
+	return ksyunApiCallNew(calls, d, s.client, true)
+}
+
+func (s *VpcService) CreateVpcPeeringConnectionAccepter(d *schema.ResourceData) (err error) {
+	peeringId := d.Get("vpc_peering_connection_id").(string)
+	call, err := s.AcceptVpcPeeringConnectionCall(d, peeringId)
+	if err != nil {
+		return err
+	}
+	if err = ksyunApiCallNew([]ApiCall{call}, d, s.client, true); err != nil {
+		return err
+	}
+	d.SetId(peeringId)
+	return err
+}
+
+func (s *VpcService) ModifyVpcPeeringConnectionCall(d *schema.ResourceData, r *schema.Resource) (callback ApiCall, err error) {
+	transform := map[string]SdkReqTransform{
+		"auto_accept": {Ignore: true},
+	}
+	req, err := SdkRequestAutoMapping(d, r, true, transform, nil, SdkReqParameter{
+		onlyTransform: false,
+	})
+	if err != nil {
+		return callback, err
+	}
+	if len(req) > 0 {
+		req["VpcPeeringConnectionId"] = d.Id()
+		callback = ApiCall{
+			param:  &req,
+			action: "ModifyVpcPeeringConnection",
+			executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+				conn := client.vpcconn
+				logger.Debug(logger.RespFormat, call.action, *(call.param))
+				resp, err = conn.ModifyVpcPeeringConnection(call.param)
+				return resp, err
+			},
+			afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+				logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+				return err
+			},
+		}
+	}
+	return callback, err
+}
+
+func (s *VpcService) ModifyVpcPeeringConnection(d *schema.ResourceData, r *schema.Resource) (err error) {
+	var calls []ApiCall
+	call, err := s.ModifyVpcPeeringConnectionCall(d, r)
+	if err != nil {
+		return err
+	}
+	calls = append(calls, call)
+	if d.HasChange("auto_accept") && d.Get("auto_accept").(bool) {
+		accept, err := s.AcceptVpcPeeringConnectionCall(d, d.Id())
+		if err != nil {
+			return err
+		}
+		calls = append(calls, accept)
+	}
+	return ksyunApiCallNew(calls, d, s.client, true)
+}
+
+func (s *VpcService) RemoveVpcPeeringConnectionCall(d *schema.ResourceData) (callback ApiCall, err error) {
+	removeReq := map[string]interface{}{
+		"VpcPeeringConnectionId": d.Id(),
+	}
+	callback = ApiCall{
+		param:  &removeReq,
+		action: "DeleteVpcPeeringConnection",
+		executeCall: func(d *schema.ResourceData, client *KsyunClient, call ApiCall) (resp *map[string]interface{}, err error) {
+			conn := client.vpcconn
+			logger.Debug(logger.RespFormat, call.action, *(call.param))
+			resp, err = conn.DeleteVpcPeeringConnection(call.param)
+			return resp, err
+		},
+		callError: func(d *schema.ResourceData, client *KsyunClient, call ApiCall, baseErr error) error {
+			return resource.Retry(15*time.Minute, func() *resource.RetryError {
+				_, callErr := s.ReadVpcPeeringConnection(d, "")
+				if callErr != nil {
+					if notFoundError(callErr) {
+						return nil
+					} else {
+						return resource.NonRetryableError(fmt.Errorf("error on  reading vpc peering connection when delete %q, %s", d.Id(), callErr))
+					}
+				}
+				_, callErr = call.executeCall(d, client, call)
+				if callErr == nil {
+					return nil
+				}
+				return resource.RetryableError(callErr)
+			})
+		},
+		afterCall: func(d *schema.ResourceData, client *KsyunClient, resp *map[string]interface{}, call ApiCall) (err error) {
+			logger.Debug(logger.RespFormat, call.action, *(call.param), *resp)
+			return err
+		},
+	}
+	return callback, err
+}
+
+func (s *VpcService) RemoveVpcPeeringConnection(d *schema.ResourceData) (err error) {
+	call, err := s.RemoveVpcPeeringConnectionCall(d)
+	if err != nil {
+		return err
+	}
+	return ksyunApiCallNew([]ApiCall{call}, d, s.client, true)
+}
+
+func (s *VpcService) checkVpcPeeringConnectionState(d *schema.ResourceData, peeringId string, target []string, timeout time.Duration) (err error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{},
+		Target:       target,
+		Refresh:      s.vpcPeeringConnectionStateRefreshFunc(d, peeringId, []string{"rejected", "failed", "expired", "deleted"}),
+		Timeout:      timeout,
+		PollInterval: 2 * time.Second,
+		Delay:        1 * time.Second,
+		MinTimeout:   1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func (s *VpcService) vpcPeeringConnectionStateRefreshFunc(d *schema.ResourceData, peeringId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		data, err := s.ReadVpcPeeringConnection(d, peeringId)
+		if err != nil {
+			return nil, "", err
+		}
+		state := fmt.Sprintf("%v", data["State"])
+		for _, v := range failStates {
+			if v == state {
+				return nil, "", fmt.Errorf("vpc peering connection %s state error, state:%v", peeringId, state)
+			}
+		}
+		return data, state, nil
+	}
+}
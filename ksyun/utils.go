@@ -13,9 +13,8 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -64,7 +63,7 @@ func hashStringArray(arr []string) string {
 		buf.WriteString(fmt.Sprintf("%s-", s))
 	}
 
-	return fmt.Sprintf("%d", hashcode.String(buf.String()))
+	return fmt.Sprintf("%d", schema.HashString(buf.String()))
 }
 
 func writeToFile(filePath string, data interface{}) error {
@@ -469,6 +468,10 @@ func SdkRequestAutoMapping(d *schema.ResourceData, resource *schema.Resource, is
 		}
 	} else {
 		for k := range resource.Schema {
+			// tags_all is sent by the tag service of each resource
+			if _, ok := transform[k]; !ok && k == "tags_all" {
+				continue
+			}
 			if v, ok := transform[k]; ok {
 				if isUpdate {
 					count, err = requestUpdateMapping(d, k, v, count, nil, &req)
@@ -1,8 +1,9 @@
 package ksyun
 
 import (
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
@@ -82,7 +83,7 @@ func conflictResourceDiffSuppressForMultiple(single string, multiple string, d *
 }
 
 func conflictResourceCustomizeDiffFunc(single string, multiple string) schema.CustomizeDiffFunc {
-	return func(diff *schema.ResourceDiff, i interface{}) (err error) {
+	return func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) (err error) {
 		valid := true
 		if diff.HasChange(single) {
 			if diff.Id() != "" && strings.Contains(diff.Id(), ":"+multiple) {
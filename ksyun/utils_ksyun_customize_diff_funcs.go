@@ -1,12 +1,13 @@
 package ksyun
 
 import (
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strconv"
 )
 
-func kecNetworkInterfaceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) (err error) {
+func kecNetworkInterfaceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (err error) {
 	if d.Id() != "" && (d.HasChange("private_ip_address") || d.HasChange("subnet_id") || d.HasChange("security_group_ids")) {
 		var data []interface{}
 		vpcService := VpcService{meta.(*KsyunClient)}
@@ -45,7 +46,7 @@ func kecNetworkInterfaceCustomizeDiff(d *schema.ResourceDiff, meta interface{})
 	return err
 }
 
-func networkAclEntryCustomizeDiff(d *schema.ResourceDiff, meta interface{}) (err error) {
+func networkAclEntryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (err error) {
 	if d.HasChange("network_acl_entries") {
 		m := make(map[string]interface{})
 		for _, v := range d.Get("network_acl_entries").(*schema.Set).List() {
@@ -61,7 +62,7 @@ func networkAclEntryCustomizeDiff(d *schema.ResourceDiff, meta interface{}) (err
 	return err
 }
 
-func bareMetalCustomizeDiff(d *schema.ResourceDiff, meta interface{}) (err error) {
+func bareMetalCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) (err error) {
 
 	if d.Get("network_interface_mode") != "dual" {
 		if d.Get("extension_subnet_id") != "" ||
@@ -1,9 +1,11 @@
 package ksyun
 
 import (
+	"encoding/json"
+	"reflect"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/helper"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
@@ -503,3 +505,27 @@ func pdnsZoneRecordDiffSuppressFunc(k, old, new string, d *schema.ResourceData)
 	}
 	return false
 }
+
+// policyDocumentDiffSuppressFunc suppresses the diff of the equivalent JSON policy documents,
+// such as the documents differ in whitespace, key order, value order, or a single value and an array of it.
+func policyDocumentDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	var o, n interface{}
+	if json.Unmarshal([]byte(old), &o) != nil || json.Unmarshal([]byte(new), &n) != nil {
+		return false
+	}
+	if reflect.DeepEqual(o, n) {
+		return true
+	}
+	oldDoc, err := normalizeIamPolicyDocument(old)
+	if err != nil {
+		return false
+	}
+	newDoc, err := normalizeIamPolicyDocument(new)
+	if err != nil {
+		return false
+	}
+	return oldDoc == newDoc
+}
@@ -5,36 +5,35 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/logger"
 )
 
 func kecNetworkInterfaceHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
-	return hashcode.String(m["network_interface_id"].(string))
+	return schema.HashString(m["network_interface_id"].(string))
 }
 
 func networkAclEntryHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
 	buf := networkAclEntryHashBase(m)
 	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["description"].(string))))
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func networkAclEntrySimpleHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
 	buf := networkAclEntryHashBase(m)
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func networkAclEntryHashBase(m map[string]interface{}) (buf bytes.Buffer) {
@@ -52,28 +51,28 @@ func networkAclEntryHashBase(m map[string]interface{}) (buf bytes.Buffer) {
 
 func securityGroupEntryHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
 	buf := securityGroupEntryHashBase(m, false)
 	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["description"].(string))))
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func securityGroupEntrySimpleHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	buf := securityGroupEntryHashBase(v, false)
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func securityGroupEntrySimpleHashWithHump(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	buf := securityGroupEntryHashBase(v, true)
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func securityGroupEntryHashBase(v interface{}, isHump bool) (buf bytes.Buffer) {
@@ -142,42 +141,42 @@ func generateEntryField(protocol string) (fields []string) {
 
 func loadBalancerAclEntryHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
 	buf := loadBalancerAclEntryHashBase(m)
 	buf.WriteString(fmt.Sprintf("%d-", m["rule_number"].(int)))
 	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["rule_action"].(string))))
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func loadBalancerAclEntrySimpleHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
 	buf := loadBalancerAclEntryHashBase(m)
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func loadBalancerAclEntryNumberHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%d-", m["rule_number"].(int)))
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func loadBalancerAclEntryCidrHash(v interface{}) int {
 	if v == nil {
-		return hashcode.String("")
+		return schema.HashString("")
 	}
 	m := v.(map[string]interface{})
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["cidr_block"].(string))))
-	return hashcode.String(buf.String())
+	return schema.HashString(buf.String())
 }
 
 func loadBalancerAclEntryHashBase(m map[string]interface{}) (buf bytes.Buffer) {
@@ -189,7 +188,7 @@ func loadBalancerAclEntryHashBase(m map[string]interface{}) (buf bytes.Buffer) {
 func kceInstanceNodeHashFunc() schema.SchemaSetFunc {
 	return func(v interface{}) int {
 		if v == nil {
-			return hashcode.String("")
+			return schema.HashString("")
 		}
 		m := v.(map[string]interface{})
 
@@ -235,6 +234,6 @@ func kceInstanceNodeHashFunc() schema.SchemaSetFunc {
 			}
 		}
 
-		return hashcode.String(buf.String())
+		return schema.HashString(buf.String())
 	}
 }
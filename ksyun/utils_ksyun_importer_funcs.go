@@ -6,7 +6,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/pkg/helper"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun/internal/structor/v1/kce"
 )
@@ -549,3 +549,80 @@ func importKpfsAcl(d *schema.ResourceData, meta interface{}) ([]*schema.Resource
 	}
 	return retD, nil
 }
+
+// importImageCopy imports the copied image by destination_region:image_id:source_image_id,
+// the source image is not returned by the api of the copied image.
+func importImageCopy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var (
+		err  error
+		retD = []*schema.ResourceData{d}
+	)
+	items := strings.Split(d.Id(), ":")
+	if len(items) != 3 {
+		return retD, fmt.Errorf("import id must be in the format of destination_region:image_id:source_image_id")
+	}
+	for idx, key := range []string{"destination_region", "image_id", "source_image_id"} {
+		if err = d.Set(key, items[idx]); err != nil {
+			return retD, err
+		}
+	}
+	d.SetId(AssembleIds(items[0], items[1]))
+	return retD, nil
+}
+
+func importIamPolicy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	// the policy is read by the krn, such as krn:ksc:iam::2000000001:policy/PolicyName
+	items := strings.Split(d.Id(), ":policy/")
+	if len(items) != 2 || !strings.HasPrefix(d.Id(), "krn:") {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must be the policy krn")
+	}
+	err = d.Set("policy_krn", d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	err = d.Set("policy_name", items[1])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(items[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func importIamRelationPolicy(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) != 4 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must be name:policy_name:relation_type:policy_type")
+	}
+	relationType, err := strconv.Atoi(items[2])
+	if err != nil {
+		return []*schema.ResourceData{d}, fmt.Errorf("relation_type %s is invalid", items[2])
+	}
+	for k, v := range map[string]interface{}{
+		"name":          items[0],
+		"policy_name":   items[1],
+		"relation_type": relationType,
+		"policy_type":   items[3],
+	} {
+		err = d.Set(k, v)
+		if err != nil {
+			return []*schema.ResourceData{d}, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func importIamAccessKey(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var err error
+	items := strings.Split(d.Id(), ":")
+	if len(items) != 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("import id must split with ':'")
+	}
+	err = d.Set("user_name", items[0])
+	if err != nil {
+		return []*schema.ResourceData{d}, err
+	}
+	d.SetId(items[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -1,12 +1,14 @@
 package ksyun
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"reflect"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type LenFunc func([]interface{}) int
@@ -109,3 +111,9 @@ func albRuleSetStateFunc(lessFunc LessFunc) schema.SchemaStateFunc {
 		return ""
 	}
 }
+
+// accountPasswordStateFunc keeps the hash of the account password instead of the plain text
+func accountPasswordStateFunc(v interface{}) string {
+	sum := sha256.Sum256([]byte(v.(string)))
+	return hex.EncodeToString(sum[:])
+}
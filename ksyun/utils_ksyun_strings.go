@@ -2,7 +2,7 @@ package ksyun
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strings"
 )
 
@@ -97,3 +97,11 @@ func stringSliceContains(arr []string, str string) bool {
 	}
 	return false
 }
+
+// stringValue returns the value of an optional string field of the sdk response, it's empty if the field is nil.
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
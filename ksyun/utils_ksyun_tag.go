@@ -1,8 +1,11 @@
 package ksyun
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func tagsSchema() *schema.Schema {
@@ -13,13 +16,96 @@ func tagsSchema() *schema.Schema {
 	}
 }
 
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Description: "all tags of the resource, including the default_tags of the provider.",
+	}
+}
+
+// withDefaultTags makes the taggable resource apply the default_tags of the provider.
+// The tags sent to the api are tags_all, which is tags merged with the default tags and planned by CustomizeDiff,
+// the default tags are excluded from tags after read, so they don't cause diffs on tags.
+func withDefaultTags(r *schema.Resource) *schema.Resource {
+	r.Schema["tags_all"] = tagsAllSchema()
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = tagsAllCustomizeDiff
+	} else {
+		r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, tagsAllCustomizeDiff)
+	}
+	r.Create = withTagsAll(r.Create)
+	r.Read = withTagsAll(r.Read)
+	r.Update = withTagsAll(r.Update)
+	return r
+}
+
+func tagsAllCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	client, ok := meta.(*KsyunClient)
+	if !ok {
+		return nil
+	}
+	tagsAll := client.mergeDefaultTags(d.Get("tags").(map[string]interface{}))
+	if o, _ := d.GetChange("tags_all"); len(tagsAll) == 0 && len(o.(map[string]interface{})) == 0 {
+		return nil
+	}
+	return d.SetNew("tags_all", tagsAll)
+}
+
+// withTagsAll sets tags_all to the tags that read from the api, and removes the default tags from tags
+func withTagsAll(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		configured := d.Get("tags").(map[string]interface{})
+		if err := f(d, meta); err != nil || d.Id() == "" {
+			return err
+		}
+		tagsAll := d.Get("tags").(map[string]interface{})
+		if err := d.Set("tags_all", tagsAll); err != nil {
+			return err
+		}
+		return d.Set("tags", meta.(*KsyunClient).ignoreDefaultTags(tagsAll, configured))
+	}
+}
+
+// mergeDefaultTags returns the tags merged with the default tags, the tags of the resource win on conflict
+func (client *KsyunClient) mergeDefaultTags(tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(client.config.DefaultTags)+len(tags))
+	for k, v := range client.config.DefaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	return result
+}
+
+// ignoreDefaultTags returns the tags that are not the default tags, unless they are configured in the resource
+func (client *KsyunClient) ignoreDefaultTags(tagsAll, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tagsAll))
+	for k, v := range tagsAll {
+		if dv, ok := client.config.DefaultTags[k]; ok && dv == v {
+			if _, ok = configured[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
 func mergeTagsData(d *schema.ResourceData, data *map[string]interface{}, client *KsyunClient, resourceType string) (err error) {
 	var tags []interface{}
 	tagService := TagService{client}
 	tags, err = tagService.ReadTagByResourceId(d, d.Id(), resourceType)
 	if err != nil {
 		//此处暂时兼容如果没有更改tags可以忽略listTags的权限检查。做到最大兼容性
-		if !d.HasChange("tags") {
+		if !d.HasChange("tags_all") {
 			errMessage := strings.ToLower(err.Error())
 			if strings.Contains(errMessage, "lack of policy") {
 				return nil
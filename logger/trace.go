@@ -0,0 +1,123 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TraceFileEnv is the environment variable of the file that api traces are written to,
+// tracing is disabled if it's not set.
+const TraceFileEnv = "KSYUN_TRACE_FILE"
+
+const redactedValue = "******"
+
+// redactedKeys is the parameter keys that contain sensitive data, matched case-insensitively
+// after removing the underscores, e.g. InstancePassword, SecretKey, UserData, user_data.
+var redactedKeys = []string{"password", "secret", "userdata", "privatekey", "securitytoken", "accesskey", "credential"}
+
+// TraceRecord is a record of an api attempt
+type TraceRecord struct {
+	Time         time.Time   `json:"time"`
+	Service      string      `json:"service"`
+	Action       string      `json:"action"`
+	RequestId    string      `json:"request_id,omitempty"`
+	Retry        int         `json:"retry"`
+	LatencyMs    int64       `json:"latency_ms"`
+	HttpStatus   int         `json:"http_status,omitempty"`
+	ErrorCode    string      `json:"error_code,omitempty"`
+	ErrorMessage string      `json:"error_message,omitempty"`
+	Params       interface{} `json:"params,omitempty"`
+}
+
+// Tracer writes a json line for each trace record
+type Tracer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewTracer(w io.Writer) *Tracer {
+	return &Tracer{w: w}
+}
+
+// Trace writes the record with sensitive params redacted
+func (t *Tracer) Trace(record TraceRecord) error {
+	record.Params = Redact(record.Params)
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, err = t.w.Write(append(b, '\n'))
+	return err
+}
+
+var envTracer *Tracer
+var envTracerErr error
+var envTracerOnce sync.Once
+
+// TracerFromEnv returns the tracer writing to the file of KSYUN_TRACE_FILE, it returns nil if it's not set.
+// The tracer is shared by all provider instances in the process.
+func TracerFromEnv() (*Tracer, error) {
+	envTracerOnce.Do(func() {
+		filename := os.Getenv(TraceFileEnv)
+		if filename == "" {
+			return
+		}
+		f, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			envTracerErr = fmt.Errorf("error opening trace file %s: %s", filename, err)
+			return
+		}
+		envTracer = NewTracer(f)
+	})
+	return envTracer, envTracerErr
+}
+
+// Redact returns a copy of v with the values of sensitive keys replaced
+func Redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if isRedactedKey(key) {
+				m[key] = redactedValue
+			} else {
+				m[key] = Redact(value)
+			}
+		}
+		return m
+	case *map[string]interface{}:
+		if v == nil {
+			return nil
+		}
+		return Redact(*v)
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, value := range v {
+			s[i] = Redact(value)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+func isRedactedKey(key string) bool {
+	// flattened keys such as DataDisk.1.Password are checked by the last part
+	if index := strings.LastIndex(key, "."); index >= 0 {
+		key = key[index+1:]
+	}
+	key = strings.ToLower(strings.Replace(key, "_", "", -1))
+	for _, k := range redactedKeys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
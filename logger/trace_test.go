@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	params := &map[string]interface{}{
+		"InstanceType":     "S6.1A",
+		"InstancePassword": "Passw0rd",
+		"UserData":         "IyEvYmluL2Jhc2g=",
+		"DataDisk.1.Type":  "SSD3.0",
+		"DataDisk":         []interface{}{map[string]interface{}{"secret_key": "sk", "size": 20}},
+	}
+	redacted := Redact(params).(map[string]interface{})
+	if redacted["InstanceType"] != "S6.1A" {
+		t.Errorf("unexpected InstanceType %v", redacted["InstanceType"])
+	}
+	for _, key := range []string{"InstancePassword", "UserData"} {
+		if redacted[key] != redactedValue {
+			t.Errorf("expected %s to be redacted, got %v", key, redacted[key])
+		}
+	}
+	disk := redacted["DataDisk"].([]interface{})[0].(map[string]interface{})
+	if disk["secret_key"] != redactedValue || disk["size"] != 20 {
+		t.Errorf("unexpected nested values %v", disk)
+	}
+	// the original params are not modified
+	if (*params)["InstancePassword"] != "Passw0rd" {
+		t.Errorf("the original params are modified")
+	}
+}
+
+func TestTracerTrace(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewTracer(&buf)
+	for _, action := range []string{"RunInstances", "DescribeInstances"} {
+		err := tracer.Trace(TraceRecord{
+			Service: "kec",
+			Action:  action,
+			Params:  &map[string]interface{}{"InstancePassword": "Passw0rd"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	if strings.Contains(buf.String(), "Passw0rd") {
+		t.Errorf("password is written to trace: %s", buf.String())
+	}
+	record := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record["service"] != "kec" || record["action"] != "RunInstances" {
+		t.Errorf("unexpected record %v", record)
+	}
+}
@@ -1,8 +1,12 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"log"
+
 	"github.com/KscSDK/ksc-sdk-go/ksc"
-	"github.com/hashicorp/terraform-plugin-sdk/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/terraform-providers/terraform-provider-ksyun/ksyun"
 )
 
@@ -11,9 +15,26 @@ var (
 )
 
 func main() {
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
 	ksc.SDKName = "terraform-provider-ksyun"
 	ksc.SDKVersion = version
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: ksyun.Provider,
-	})
+
+	// the sdk provider and the framework provider are served by one mux server
+	serverFactory, err := ksyun.ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+	err = tf5server.Serve("registry.terraform.io/kingsoftcloud/ksyun", serverFactory, serveOpts...)
+	ksyun.LogRateLimitMetrics()
+	if err != nil {
+		log.Fatal(err)
+	}
 }